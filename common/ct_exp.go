@@ -0,0 +1,209 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package common
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// The exponentiation below is used for secret exponents such as the Paillier LambdaN. big.Int.Exp takes time that
+// depends on the exponent, so this uses Montgomery multiplication on fixed-size limbs with a fixed 4-bit window and
+// constant-time table lookups. The running time only depends on the sizes of the modulus and of the exponent in words.
+
+const (
+	ctExpWindow = 4
+)
+
+// montgomeryModulus holds an odd modulus m of n words with R = 2^(n*W)
+type montgomeryModulus struct {
+	m     []uint
+	m0inv uint   // -m^-1 mod 2^W
+	rr    []uint // R^2 mod m
+	t     []uint // scratch space for mul and sqr
+}
+
+func newMontgomeryModulus(m *big.Int) *montgomeryModulus {
+	n := len(m.Bits())
+	mm := &montgomeryModulus{m: wordsOf(m, n), t: make([]uint, 2*n)}
+	// Newton's iteration for m^-1 mod 2^W; each step doubles the number of correct low bits
+	inv := uint(1)
+	for i := 0; i < 7; i++ {
+		inv *= 2 - mm.m[0]*inv
+	}
+	mm.m0inv = -inv
+	R := new(big.Int).Lsh(one, uint(n*bits.UintSize))
+	rr := new(big.Int).Mul(R, R)
+	mm.rr = wordsOf(rr.Mod(rr, m), n)
+	return mm
+}
+
+// mul sets z = a*b*R^-1 mod m for a, b < m; z may alias a or b
+func (mm *montgomeryModulus) mul(z, a, b []uint) {
+	n := len(mm.m)
+	t := mm.t[:2*n]
+	for i := range t {
+		t[i] = 0
+	}
+	for i := 0; i < n; i++ {
+		t[i+n] = mulAddWords(t[i:i+n], a[:n], b[i])
+	}
+	mm.reduce(z, t)
+}
+
+// sqr sets z = a*a*R^-1 mod m for a < m; z may alias a. The cross products are only computed once.
+func (mm *montgomeryModulus) sqr(z, a []uint) {
+	n := len(mm.m)
+	t := mm.t[:2*n]
+	for i := range t {
+		t[i] = 0
+	}
+	for i := 0; i < n-1; i++ {
+		t[i+n] = mulAddWords(t[2*i+1:i+n], a[i+1:n], a[i])
+	}
+	// double the cross products and add the squares
+	var c uint
+	for i := range t {
+		t[i], c = t[i]<<1|c, t[i]>>(bits.UintSize-1)
+	}
+	c = 0
+	for i := 0; i < n; i++ {
+		hi, lo := bits.Mul(a[i], a[i])
+		t[2*i], c = bits.Add(t[2*i], lo, c)
+		t[2*i+1], c = bits.Add(t[2*i+1], hi, c)
+	}
+	mm.reduce(z, t)
+}
+
+// reduce sets z = t*R^-1 mod m for t < m*R in constant time (Montgomery reduction); t is overwritten
+func (mm *montgomeryModulus) reduce(z, t []uint) {
+	n := len(mm.m)
+	m := mm.m[:n]
+	var top uint
+	for i := 0; i < n; i++ {
+		// adding u*m makes the word i zero
+		c := mulAddWords(t[i:i+n], m, t[i]*mm.m0inv)
+		t[i+n], top = bits.Add(t[i+n], c, top)
+	}
+	// the result t[n:] + top*R is less than 2m: subtract m and keep the difference unless that borrowed past top
+	r := t[n : 2*n]
+	z = z[:n]
+	var borrow uint
+	for j := 0; j < n; j++ {
+		z[j], borrow = bits.Sub(r[j], m[j], borrow)
+	}
+	_, borrow = bits.Sub(top, 0, borrow)
+	keep := -borrow // all ones if the result is already less than m
+	for j := 0; j < n; j++ {
+		z[j] = (r[j] & keep) | (z[j] &^ keep)
+	}
+}
+
+// mulAddWords sets z += x*y for len(z) == len(x) and returns the carry word
+func mulAddWords(z, x []uint, y uint) (carry uint) {
+	z = z[:len(x)]
+	j := 0
+	for ; j+4 <= len(x); j += 4 {
+		x4, z4 := x[j:j+4:j+4], z[j:j+4:j+4]
+		z4[0], carry = mulAddWord(x4[0], y, z4[0], carry)
+		z4[1], carry = mulAddWord(x4[1], y, z4[1], carry)
+		z4[2], carry = mulAddWord(x4[2], y, z4[2], carry)
+		z4[3], carry = mulAddWord(x4[3], y, z4[3], carry)
+	}
+	for ; j < len(x); j++ {
+		z[j], carry = mulAddWord(x[j], y, z[j], carry)
+	}
+	return
+}
+
+// mulAddWord returns x*y + z + c as two words; it cannot overflow
+func mulAddWord(x, y, z, c uint) (lo, hi uint) {
+	hi, lo = bits.Mul(x, y)
+	var cc uint
+	lo, cc = bits.Add(lo, z, 0)
+	hi, _ = bits.Add(hi, 0, cc)
+	lo, cc = bits.Add(lo, c, 0)
+	hi, _ = bits.Add(hi, 0, cc)
+	return
+}
+
+// ExpSecret returns x^y mod the modulus in time that does not depend on the value of the secret exponent y,
+// only on its length in words. A negative y inverts x first, so only the sign of y may leak, and the result is nil
+// if x is not invertible. The modulus must be odd; otherwise this falls back to `Exp`.
+func (mi *modInt) ExpSecret(x, y *big.Int) *big.Int {
+	m := mi.i()
+	if y.Sign() < 0 {
+		if x = new(big.Int).ModInverse(x, m); x == nil {
+			return nil
+		}
+		y = new(big.Int).Neg(y)
+	}
+	if m.Bit(0) == 0 {
+		return mi.Exp(x, y)
+	}
+	mm := newMontgomeryModulus(m)
+	n := len(mm.m)
+
+	// the table holds x^i * R mod m for i in [0, 2^window)
+	xm := new(big.Int).Mod(x, m)
+	oneR := new(big.Int).Lsh(one, uint(n*bits.UintSize))
+	oneR.Mod(oneR, m)
+	table := make([][]uint, 1<<ctExpWindow)
+	table[0] = wordsOf(oneR, n)
+	table[1] = wordsOf(xm, n)
+	mm.mul(table[1], table[1], mm.rr)
+	for i := 2; i < len(table); i++ {
+		table[i] = make([]uint, n)
+		mm.mul(table[i], table[i-1], table[1])
+	}
+
+	exp := wordsOf(y, len(y.Bits()))
+	acc := wordsOf(oneR, n)
+	selected := make([]uint, n)
+	for i := len(exp) - 1; i >= 0; i-- {
+		for shift := bits.UintSize - ctExpWindow; shift >= 0; shift -= ctExpWindow {
+			for k := 0; k < ctExpWindow; k++ {
+				mm.sqr(acc, acc)
+			}
+			window := (exp[i] >> uint(shift)) & (1<<ctExpWindow - 1)
+			for j := range selected {
+				selected[j] = 0
+			}
+			for k, entry := range table {
+				// all ones if k == window
+				mask := uint(0) - ((uint(k) ^ window - 1) >> (bits.UintSize - 1) & 1)
+				for j := range selected {
+					selected[j] |= entry[j] & mask
+				}
+			}
+			mm.mul(acc, acc, selected)
+		}
+	}
+
+	// convert out of the Montgomery domain
+	unit := make([]uint, n)
+	unit[0] = 1
+	mm.mul(acc, acc, unit)
+	return intOf(acc)
+}
+
+// wordsOf returns the little-endian words of a non-negative x, zero-padded to n words
+func wordsOf(x *big.Int, n int) []uint {
+	w := make([]uint, n)
+	for i, word := range x.Bits() {
+		w[i] = uint(word)
+	}
+	return w
+}
+
+func intOf(w []uint) *big.Int {
+	words := make([]big.Word, len(w))
+	for i := range w {
+		words[i] = big.Word(w[i])
+	}
+	return new(big.Int).SetBits(words)
+}
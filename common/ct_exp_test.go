@@ -0,0 +1,36 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package common_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/binance-chain/tss-lib/common"
+)
+
+func TestExpSecret(t *testing.T) {
+	for _, bits := range []int{64, 255, 1024, 2048} {
+		m := GetRandomPositiveInt(new(big.Int).Lsh(big.NewInt(1), uint(bits)))
+		m.SetBit(m, 0, 1)
+		modM := ModInt(m)
+		for i := 0; i < 20; i++ {
+			x := GetRandomPositiveInt(m)
+			y := GetRandomPositiveInt(new(big.Int).Lsh(big.NewInt(1), uint(2*bits)))
+			assert.Equal(t, modM.Exp(x, y), modM.ExpSecret(x, y), "%d bits", bits)
+		}
+		x := GetRandomPositiveInt(m)
+		assert.Equal(t, big.NewInt(1), modM.ExpSecret(x, big.NewInt(0)))
+		assert.Equal(t, modM.Exp(x, big.NewInt(-3)), modM.ExpSecret(x, big.NewInt(-3)))
+		assert.Equal(t, modM.Exp(new(big.Int).Neg(x), big.NewInt(3)), modM.ExpSecret(new(big.Int).Neg(x), big.NewInt(3)))
+	}
+	// even moduli fall back to Exp
+	modEven := ModInt(big.NewInt(1 << 20))
+	assert.Equal(t, modEven.Exp(big.NewInt(3), big.NewInt(12345)), modEven.ExpSecret(big.NewInt(3), big.NewInt(12345)))
+}
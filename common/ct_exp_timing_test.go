@@ -0,0 +1,38 @@
+//go:build timing
+// +build timing
+
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package common_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/test"
+)
+
+func TestExpSecretTiming(t *testing.T) {
+	m := GetRandomPositiveInt(new(big.Int).Lsh(big.NewInt(1), 1024))
+	m.SetBit(m, 0, 1).SetBit(m, 1023, 1)
+	x := GetRandomPositiveInt(m)
+	// a fixed exponent with a single bit set against random exponents of the same length
+	fixed := new(big.Int).Lsh(big.NewInt(1), 511)
+	tStat := test.TimingLeak(2000, func(class int) func() {
+		y := fixed
+		if class == 1 {
+			y = GetRandomPositiveInt(fixed)
+			y.SetBit(y, 511, 1)
+		}
+		return func() { ModInt(m).ExpSecret(x, y) }
+	})
+	t.Log(tStat)
+	assert.True(t, tStat < test.TimingLeakThreshold, "t = %f", tStat)
+}
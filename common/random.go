@@ -67,6 +67,17 @@ func GetRandomPrimeInt(bits int) *big.Int {
 	return try
 }
 
+// GetRandomSymmetricInt returns a uniform random integer in [-bound, bound]
+func GetRandomSymmetricInt(bound *big.Int) *big.Int {
+	width := new(big.Int).Lsh(bound, 1)
+	width.Add(width, one)
+	v, err := rand.Int(rand.Reader, width)
+	if err != nil {
+		panic(errors.Wrap(err, "rand.Int failure in GetRandomSymmetricInt!"))
+	}
+	return v.Sub(v, bound)
+}
+
 // Generate a random element in the group of all the elements in Z/nZ that
 // has a multiplicative inverse.
 func GetRandomPositiveRelativelyPrimeInt(n *big.Int) *big.Int {
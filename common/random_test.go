@@ -45,3 +45,14 @@ func TestGetRandomPrimeInt(t *testing.T) {
 	assert.NotZero(t, prime, "rand prime should not be zero")
 	assert.True(t, prime.ProbablyPrime(50), "rand prime should be prime")
 }
+
+func TestGetRandomSymmetricInt(t *testing.T) {
+	bound := big.NewInt(3)
+	seen := make(map[int64]bool)
+	for i := 0; i < 200; i++ {
+		rnd := common.GetRandomSymmetricInt(bound)
+		assert.True(t, new(big.Int).Abs(rnd).Cmp(bound) <= 0, "rand int should be within the bound")
+		seen[rnd.Int64()] = true
+	}
+	assert.Equal(t, 7, len(seen), "every value in [-bound, bound] should be drawn")
+}
@@ -122,12 +122,27 @@ var smallPrimesProduct = new(big.Int).SetUint64(16294579238595022365)
 // generated safe prime, the two most significant bits are always set to `1`
 // - we don't want the generated number to be too small.
 func GetRandomSafePrimesConcurrent(bitLen, numPrimes int, timeout time.Duration, concurrency int) ([]*GermainSafePrime, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	primes, err := GetRandomSafePrimesConcurrentContext(ctx, bitLen, numPrimes, concurrency)
+	if err == context.DeadlineExceeded {
+		return nil, fmt.Errorf("generator timed out after %v", timeout)
+	}
+	return primes, err
+}
+
+// GetRandomSafePrimesConcurrentContext is like GetRandomSafePrimesConcurrent but stops when `ctx` is done, in which
+// case `ctx.Err()` is returned. All of the search routines have exited by the time it returns.
+func GetRandomSafePrimesConcurrentContext(ctx context.Context, bitLen, numPrimes int, concurrency int) ([]*GermainSafePrime, error) {
 	if bitLen < 6 {
 		return nil, errors.New("safe prime size must be at least 6 bits")
 	}
 	if numPrimes < 1 {
 		return nil, errors.New("numPrimes should be > 0")
 	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
 
 	primeCh := make(chan *GermainSafePrime, concurrency*numPrimes)
 	errCh := make(chan error, concurrency*numPrimes)
@@ -139,7 +154,8 @@ func GetRandomSafePrimesConcurrent(bitLen, numPrimes int, timeout time.Duration,
 	defer close(errCh)
 	defer waitGroup.Wait()
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
 	for i := 0; i < concurrency; i++ {
 		waitGroup.Add(1)
@@ -148,26 +164,18 @@ func GetRandomSafePrimesConcurrent(bitLen, numPrimes int, timeout time.Duration,
 		)
 	}
 
-	// Cancel after the specified timeout.
-	go func() {
-		time.Sleep(timeout)
-		cancel()
-	}()
-
 	needed := int32(numPrimes)
 	for {
 		select {
 		case result := <-primeCh:
 			primes = append(primes, result)
 			if atomic.AddInt32(&needed, -1) <= 0 {
-				cancel()
 				return primes[:numPrimes], nil
 			}
 		case err := <-errCh:
-			cancel()
 			return nil, err
 		case <-ctx.Done():
-			return nil, fmt.Errorf("generator timed out after %v", timeout)
+			return nil, ctx.Err()
 		}
 	}
 }
@@ -237,7 +245,10 @@ func runGenPrimeRoutine(
 			default:
 				_, err := io.ReadFull(rand, bytes)
 				if err != nil {
-					errCh <- err
+					select {
+					case errCh <- err:
+					case <-ctx.Done():
+					}
 					return
 				}
 
@@ -321,7 +332,11 @@ func runGenPrimeRoutine(
 					q.BitLen() == qBitLen {
 
 					if sgp := (&GermainSafePrime{p: p, q: q}); sgp.Validate() {
-						primeCh <- &GermainSafePrime{p: p, q: q}
+						select {
+						case primeCh <- sgp:
+						case <-ctx.Done():
+							return
+						}
 					}
 					p, q = new(big.Int), new(big.Int)
 				}
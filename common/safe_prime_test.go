@@ -7,6 +7,7 @@
 package common
 
 import (
+	"context"
 	"math/big"
 	"runtime"
 	"testing"
@@ -50,3 +51,21 @@ func TestGetRandomGermainPrimeConcurrent(t *testing.T) {
 		assert.True(t, sgp.Validate())
 	}
 }
+
+func TestGetRandomSafePrimesConcurrentContextCancel(t *testing.T) {
+	before := runtime.NumGoroutine()
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+	start := time.Now()
+	// 4096-bit safe primes take far longer than the test
+	sgps, err := GetRandomSafePrimesConcurrentContext(ctx, 4096, 2, 4)
+	assert.Equal(t, context.Canceled, err)
+	assert.Nil(t, sgps)
+	assert.True(t, time.Since(start) < 5*time.Second, "took %s to cancel", time.Since(start))
+	assert.True(t, runtime.NumGoroutine() <= before, "the search routines should have exited")
+}
+
+func TestGetRandomSafePrimesConcurrentTimeout(t *testing.T) {
+	_, err := GetRandomSafePrimesConcurrent(4096, 1, 100*time.Millisecond, 1)
+	assert.EqualError(t, err, "generator timed out after 100ms")
+}
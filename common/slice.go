@@ -7,6 +7,7 @@
 package common
 
 import (
+	"errors"
 	"math/big"
 )
 
@@ -29,6 +30,31 @@ func MultiBytesToBigInts(bytes [][]byte) []*big.Int {
 	return ints
 }
 
+// SignedIntToBytes encodes v as its absolute value in big-endian order prefixed with a sign byte, 1 if v is negative.
+// A nil v is encoded as an empty slice, which fails to decode.
+func SignedIntToBytes(v *big.Int) []byte {
+	if v == nil {
+		return []byte{}
+	}
+	sign := byte(0)
+	if v.Sign() < 0 {
+		sign = 1
+	}
+	return append([]byte{sign}, v.Bytes()...)
+}
+
+// SignedBytesToInt decodes an integer encoded with SignedIntToBytes
+func SignedBytesToInt(bz []byte) (*big.Int, error) {
+	if len(bz) == 0 || bz[0] > 1 {
+		return nil, errors.New("malformed signed integer")
+	}
+	v := new(big.Int).SetBytes(bz[1:])
+	if bz[0] == 1 {
+		v.Neg(v)
+	}
+	return v, nil
+}
+
 // Returns true when the byte slice is non-nil and non-empty
 func NonEmptyBytes(bz []byte) bool {
 	return bz != nil && 0 < len(bz)
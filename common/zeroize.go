@@ -0,0 +1,28 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package common
+
+import (
+	"math/big"
+)
+
+// Zeroize overwrites the words backing each of the given integers, including any spare capacity, and sets them to 0.
+// Setting a *big.Int to 0 with `SetInt64` only truncates it, which leaves its previous value in memory until it is
+// reused or collected. nil values are skipped.
+func Zeroize(ints ...*big.Int) {
+	for _, i := range ints {
+		if i == nil {
+			continue
+		}
+		words := i.Bits()
+		words = words[:cap(words)]
+		for j := range words {
+			words[j] = 0
+		}
+		i.SetInt64(0)
+	}
+}
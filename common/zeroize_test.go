@@ -0,0 +1,35 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package common_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/binance-chain/tss-lib/common"
+)
+
+func TestZeroize(t *testing.T) {
+	x := GetRandomPositiveInt(new(big.Int).Lsh(big.NewInt(1), 512))
+	words := x.Bits()
+	// a value that was shrunk keeps its old words in its spare capacity
+	y := new(big.Int).Set(x)
+	y.Rsh(y, 448)
+	spare := y.Bits()[:cap(y.Bits())]
+
+	Zeroize(x, nil, y)
+	assert.Equal(t, 0, x.Sign())
+	assert.Equal(t, 0, y.Sign())
+	for _, w := range words {
+		assert.Zero(t, w)
+	}
+	for _, w := range spare {
+		assert.Zero(t, w)
+	}
+}
@@ -0,0 +1,233 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+// Paillier affine operation with group commitment in range proof (Πaff-g) from CGGMP21, figure 15, made
+// non-interactive with Fiat-Shamir.
+
+// It proves that D = C^x * (1 + N0)^y * rho^N0 mod N0^2 is an affine operation on the ciphertext C of the verifier,
+// with x in ±2^(ℓ+ε) the discrete logarithm of X = x*G, and y in ±2^(ℓ'+ε) the plaintext of Y = enc1(y; rhoY) under
+// the prover's own key. The proof is computed against the verifier's ring-Pedersen parameters (NHat, s, t), so a
+// prover produces one proof per verifier.
+
+package affgproof
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+	"github.com/binance-chain/tss-lib/crypto/paillier"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+const (
+	l   = 256 // ℓ
+	eps = 512 // ε
+
+	// LPrime is ℓ', the bit length of the additive term y of the affine operation
+	LPrime = 5 * l
+
+	// ProofBytesParts is the length of a serialized Proof
+	ProofBytesParts = 14
+)
+
+type (
+	Proof struct {
+		A              *big.Int
+		Bx             *crypto.ECPoint
+		By, E, S, F, T *big.Int
+		Z1, Z2, Z3, Z4 *big.Int
+		W, Wy          *big.Int
+	}
+)
+
+var one = big.NewInt(1)
+
+// NewProof proves that D = C^x * (1 + N0)^y * rho^N0 mod N0^2, where C is a ciphertext under `pk0`, that
+// Y = enc(y; rhoY) under `pk1` and that X = x*G, to the verifier with the ring-Pedersen parameters (NHat, s, t).
+// `session` binds the proof to its context and must be given to Verify as well.
+func NewProof(pk0, pk1 *paillier.PublicKey, C, D, Y *big.Int, X *crypto.ECPoint, x, y, rho, rhoY, NHat, s, t, session *big.Int) (*Proof, error) {
+	if pk0 == nil || pk1 == nil || C == nil || D == nil || Y == nil || X == nil || x == nil || y == nil || rho == nil ||
+		rhoY == nil || NHat == nil || s == nil || t == nil {
+		return nil, errors.New("affgproof.NewProof received nil value(s)")
+	}
+	N0, N1 := pk0.N, pk1.N
+	q := tss.EC().Params().N
+	lN := new(big.Int).Lsh(NHat, l)
+	lEpsN := new(big.Int).Lsh(lN, eps)
+
+	alpha := common.GetRandomSymmetricInt(new(big.Int).Lsh(one, l+eps))
+	beta := common.GetRandomSymmetricInt(new(big.Int).Lsh(one, LPrime+eps))
+	r := common.GetRandomPositiveRelativelyPrimeInt(N0)
+	rY := common.GetRandomPositiveRelativelyPrimeInt(N1)
+	gamma := common.GetRandomSymmetricInt(lEpsN)
+	m := common.GetRandomSymmetricInt(lN)
+	delta := common.GetRandomSymmetricInt(lEpsN)
+	mu := common.GetRandomSymmetricInt(lN)
+	defer common.Zeroize(alpha, beta, r, rY, gamma, m, delta, mu)
+
+	modNHat := common.ModInt(NHat)
+	modN0Square, modN1Square := common.ModInt(pk0.NSquare()), common.ModInt(pk1.NSquare())
+	pedersen := func(a, b *big.Int) *big.Int { // s^a t^b
+		return modNHat.Mul(modNHat.ExpSecret(s, a), modNHat.ExpSecret(t, b))
+	}
+	CAlpha := modN0Square.ExpSecret(C, alpha)
+	if CAlpha == nil {
+		return nil, errors.New("the ciphertext C is not invertible")
+	}
+	pf := &Proof{
+		A:  modN0Square.Mul(modN0Square.Mul(CAlpha, pk0.ExpGamma(beta)), modN0Square.ExpSecret(r, N0)),
+		Bx: crypto.ScalarBaseMultSecret(tss.EC(), new(big.Int).Mod(alpha, q)),
+		By: modN1Square.Mul(pk1.ExpGamma(beta), modN1Square.ExpSecret(rY, N1)),
+		E:  pedersen(alpha, gamma),
+		S:  pedersen(x, m),
+		F:  pedersen(beta, delta),
+		T:  pedersen(y, mu),
+	}
+	e := challenge(pk0, pk1, C, D, Y, X, NHat, s, t, session, pf)
+	pf.Z1 = new(big.Int).Add(alpha, new(big.Int).Mul(e, x))
+	pf.Z2 = new(big.Int).Add(beta, new(big.Int).Mul(e, y))
+	pf.Z3 = new(big.Int).Add(gamma, new(big.Int).Mul(e, m))
+	pf.Z4 = new(big.Int).Add(delta, new(big.Int).Mul(e, mu))
+	pf.W = common.ModInt(N0).Mul(r, common.ModInt(N0).ExpSecret(rho, e))
+	pf.Wy = common.ModInt(N1).Mul(rY, common.ModInt(N1).ExpSecret(rhoY, e))
+	return pf, nil
+}
+
+// Verify checks the proof for C and D under `pk0`, Y under `pk1` and X with the verifier's own ring-Pedersen
+// parameters (NHat, s, t)
+func (pf *Proof) Verify(pk0, pk1 *paillier.PublicKey, C, D, Y *big.Int, X *crypto.ECPoint, NHat, s, t, session *big.Int) bool {
+	if pf == nil || pk0 == nil || pk0.N == nil || pk1 == nil || pk1.N == nil || C == nil || D == nil || Y == nil ||
+		X == nil || NHat == nil || s == nil || t == nil {
+		return false
+	}
+	if pf.Bx == nil || !pf.Bx.ValidateBasic() || pf.Z1 == nil || pf.Z2 == nil || pf.Z3 == nil || pf.Z4 == nil {
+		return false
+	}
+	N0, N1 := pk0.N, pk1.N
+	N0Square, N1Square := pk0.NSquare(), pk1.NSquare()
+	for _, v := range []*big.Int{pf.E, pf.S, pf.F, pf.T} {
+		if !common.IsNumberInMultiplicativeGroup(NHat, v) {
+			return false
+		}
+	}
+	if !common.IsNumberInMultiplicativeGroup(N0Square, pf.A) || !common.IsNumberInMultiplicativeGroup(N0Square, C) ||
+		!common.IsNumberInMultiplicativeGroup(N0Square, D) || !common.IsNumberInMultiplicativeGroup(N0, pf.W) ||
+		!common.IsNumberInMultiplicativeGroup(N1Square, pf.By) || !common.IsNumberInMultiplicativeGroup(N1Square, Y) ||
+		!common.IsNumberInMultiplicativeGroup(N1, pf.Wy) {
+		return false
+	}
+	// range checks: z1 in ±2^(ℓ+ε), z2 in ±2^(ℓ'+ε)
+	if new(big.Int).Abs(pf.Z1).Cmp(new(big.Int).Lsh(one, l+eps)) > 0 ||
+		new(big.Int).Abs(pf.Z2).Cmp(new(big.Int).Lsh(one, LPrime+eps)) > 0 {
+		return false
+	}
+	q := tss.EC().Params().N
+	e := challenge(pk0, pk1, C, D, Y, X, NHat, s, t, session, pf)
+
+	// C^z1 * (1 + N0)^z2 * w^N0 = A * D^e mod N0^2
+	modN0Square := common.ModInt(N0Square)
+	CZ1 := modN0Square.Exp(C, pf.Z1)
+	if CZ1 == nil {
+		return false
+	}
+	lhs := modN0Square.Mul(modN0Square.Mul(CZ1, pk0.ExpGamma(pf.Z2)), modN0Square.Exp(pf.W, N0))
+	if lhs.Cmp(modN0Square.Mul(pf.A, modN0Square.Exp(D, e))) != 0 {
+		return false
+	}
+	// z1*G = Bx + e*X
+	rhs, err := pf.Bx.Add(X.ScalarMult(e))
+	if err != nil || !crypto.ScalarBaseMult(tss.EC(), new(big.Int).Mod(pf.Z1, q)).Equals(rhs) {
+		return false
+	}
+	// (1 + N1)^z2 * wy^N1 = By * Y^e mod N1^2
+	modN1Square := common.ModInt(N1Square)
+	lhs = modN1Square.Mul(pk1.ExpGamma(pf.Z2), modN1Square.Exp(pf.Wy, N1))
+	if lhs.Cmp(modN1Square.Mul(pf.By, modN1Square.Exp(Y, e))) != 0 {
+		return false
+	}
+	// s^z1 t^z3 = E * S^e and s^z2 t^z4 = F * T^e mod NHat
+	modNHat := common.ModInt(NHat)
+	pedersen := func(a, b *big.Int) *big.Int {
+		sA, tB := modNHat.Exp(s, a), modNHat.Exp(t, b)
+		if sA == nil || tB == nil {
+			return nil
+		}
+		return modNHat.Mul(sA, tB)
+	}
+	if lhs := pedersen(pf.Z1, pf.Z3); lhs == nil || lhs.Cmp(modNHat.Mul(pf.E, modNHat.Exp(pf.S, e))) != 0 {
+		return false
+	}
+	lhs = pedersen(pf.Z2, pf.Z4)
+	return lhs != nil && lhs.Cmp(modNHat.Mul(pf.F, modNHat.Exp(pf.T, e))) == 0
+}
+
+// Serialize encodes the proof's values in order; the signed ones are prefixed with a sign byte.
+// Missing values are encoded as empty parts, which fail to validate.
+func (pf *Proof) Serialize() [][]byte {
+	var bxX, bxY *big.Int
+	if pf.Bx != nil {
+		bxX, bxY = pf.Bx.X(), pf.Bx.Y()
+	}
+	return [][]byte{
+		bytesOrEmpty(pf.A), bytesOrEmpty(bxX), bytesOrEmpty(bxY), bytesOrEmpty(pf.By),
+		bytesOrEmpty(pf.E), bytesOrEmpty(pf.S), bytesOrEmpty(pf.F), bytesOrEmpty(pf.T),
+		common.SignedIntToBytes(pf.Z1), common.SignedIntToBytes(pf.Z2),
+		common.SignedIntToBytes(pf.Z3), common.SignedIntToBytes(pf.Z4),
+		bytesOrEmpty(pf.W), bytesOrEmpty(pf.Wy),
+	}
+}
+
+func UnmarshalProof(bzs [][]byte) (*Proof, error) {
+	if len(bzs) != ProofBytesParts {
+		return nil, fmt.Errorf("UnmarshalProof expected %d parts but got %d", ProofBytesParts, len(bzs))
+	}
+	Bx, err := crypto.NewECPoint(tss.EC(), new(big.Int).SetBytes(bzs[1]), new(big.Int).SetBytes(bzs[2]))
+	if err != nil {
+		return nil, err
+	}
+	zs := make([]*big.Int, 4)
+	for i := range zs {
+		if zs[i], err = common.SignedBytesToInt(bzs[8+i]); err != nil {
+			return nil, err
+		}
+	}
+	return &Proof{
+		A:  new(big.Int).SetBytes(bzs[0]),
+		Bx: Bx,
+		By: new(big.Int).SetBytes(bzs[3]),
+		E:  new(big.Int).SetBytes(bzs[4]),
+		S:  new(big.Int).SetBytes(bzs[5]),
+		F:  new(big.Int).SetBytes(bzs[6]),
+		T:  new(big.Int).SetBytes(bzs[7]),
+		Z1: zs[0],
+		Z2: zs[1],
+		Z3: zs[2],
+		Z4: zs[3],
+		W:  new(big.Int).SetBytes(bzs[12]),
+		Wy: new(big.Int).SetBytes(bzs[13]),
+	}, nil
+}
+
+// ----- utils
+
+func challenge(pk0, pk1 *paillier.PublicKey, C, D, Y *big.Int, X *crypto.ECPoint, NHat, s, t, session *big.Int, pf *Proof) *big.Int {
+	if session == nil {
+		session = big.NewInt(0)
+	}
+	eHash := common.SHA512_256i(pk0.N, pk1.N, C, D, Y, X.X(), X.Y(), NHat, s, t, session,
+		pf.A, pf.Bx.X(), pf.Bx.Y(), pf.By, pf.E, pf.S, pf.F, pf.T)
+	return common.RejectionSample(tss.EC().Params().N, eHash)
+}
+
+func bytesOrEmpty(v *big.Int) []byte {
+	if v == nil {
+		return []byte{}
+	}
+	return v.Bytes()
+}
@@ -0,0 +1,86 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package affgproof_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+	. "github.com/binance-chain/tss-lib/crypto/affgproof"
+	"github.com/binance-chain/tss-lib/crypto/paillier"
+	"github.com/binance-chain/tss-lib/ecdsa/keygen"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+func TestAffGProof(t *testing.T) {
+	q := tss.EC().Params().N
+	keys, _, err := keygen.LoadKeygenTestFixtures(2)
+	assert.NoError(t, err)
+	NHat, s, tt, err := keygen.LoadNTildeH1H2FromTestFixture(0)
+	assert.NoError(t, err)
+	sk0 := keys[0].PaillierSK
+	pk0, pk1 := &sk0.PublicKey, &keys[1].PaillierSK.PublicKey
+	session := big.NewInt(42)
+
+	c := common.GetRandomPositiveInt(q)
+	C, err := pk0.Encrypt(c)
+	assert.NoError(t, err)
+	x := common.GetRandomPositiveInt(q)
+	y := common.GetRandomSymmetricInt(new(big.Int).Lsh(big.NewInt(1), LPrime))
+	D, rho := affine(pk0, C, x, y)
+	Y, rhoY, err := pk1.EncryptAndReturnRandomness(new(big.Int).Mod(y, pk1.N))
+	assert.NoError(t, err)
+	X := crypto.ScalarBaseMult(tss.EC(), x)
+
+	// D decrypts to c*x + y
+	plain, err := sk0.Decrypt(D)
+	assert.NoError(t, err)
+	expected := new(big.Int).Add(new(big.Int).Mul(c, x), y)
+	assert.Equal(t, 0, new(big.Int).Mod(expected, pk0.N).Cmp(plain))
+
+	pf, err := NewProof(pk0, pk1, C, D, Y, X, x, y, rho, rhoY, NHat, s, tt, session)
+	assert.NoError(t, err)
+	assert.True(t, pf.Verify(pk0, pk1, C, D, Y, X, NHat, s, tt, session))
+	assert.False(t, pf.Verify(pk0, pk1, C, D, Y, X, NHat, s, tt, big.NewInt(43)), "the proof is bound to the session")
+
+	pf2, err := UnmarshalProof(pf.Serialize())
+	assert.NoError(t, err)
+	assert.True(t, pf2.Verify(pk0, pk1, C, D, Y, X, NHat, s, tt, session))
+
+	// X does not match the multiplier of D
+	X2 := crypto.ScalarBaseMult(tss.EC(), new(big.Int).Add(x, big.NewInt(1)))
+	pf3, err := NewProof(pk0, pk1, C, D, Y, X2, x, y, rho, rhoY, NHat, s, tt, session)
+	assert.NoError(t, err)
+	assert.False(t, pf3.Verify(pk0, pk1, C, D, Y, X2, NHat, s, tt, session))
+
+	// Y encrypts another additive term
+	Y2, rhoY2, err := pk1.EncryptAndReturnRandomness(big.NewInt(5))
+	assert.NoError(t, err)
+	pf4, err := NewProof(pk0, pk1, C, D, Y2, X, x, y, rho, rhoY2, NHat, s, tt, session)
+	assert.NoError(t, err)
+	assert.False(t, pf4.Verify(pk0, pk1, C, D, Y2, X, NHat, s, tt, session))
+
+	// the additive term is out of range
+	yBig := new(big.Int).Lsh(big.NewInt(1), LPrime+600)
+	D3, rho3 := affine(pk0, C, x, yBig)
+	Y3, rhoY3, err := pk1.EncryptAndReturnRandomness(yBig)
+	assert.NoError(t, err)
+	pf5, err := NewProof(pk0, pk1, C, D3, Y3, X, x, yBig, rho3, rhoY3, NHat, s, tt, session)
+	assert.NoError(t, err)
+	assert.False(t, pf5.Verify(pk0, pk1, C, D3, Y3, X, NHat, s, tt, session))
+}
+
+// affine returns C^x * (1 + N)^y * rho^N mod N^2 and rho
+func affine(pk *paillier.PublicKey, C, x, y *big.Int) (*big.Int, *big.Int) {
+	modNSquare := common.ModInt(pk.NSquare())
+	rho, rhoN := pk.Randomness()
+	return modNSquare.Mul(modNSquare.Mul(modNSquare.Exp(C, x), pk.ExpGamma(y)), rhoN), rho
+}
@@ -0,0 +1,216 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package ckd
+
+import (
+	"bytes"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/base58"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+)
+
+// ExtendedKey is a BIP32 extended public key.
+// Only the public (non-hardened) derivation is supported because no party holds the full private key.
+type ExtendedKey struct {
+	PublicKey  *crypto.ECPoint
+	Depth      uint8
+	ChildIndex uint32
+	ChainCode  []byte // 32 bytes
+	ParentFP   []byte // parent key fingerprint, 4 bytes
+	Version    []byte // serialization version bytes, 4 bytes
+}
+
+const (
+	// HardenedKeyStart is the index of the first hardened child key; hardened keys cannot be derived from a public key
+	HardenedKeyStart = 0x80000000
+
+	// the length of a serialized extended key without the base58 checksum
+	serializedKeyLen = 4 + 1 + 4 + 4 + 32 + 33
+)
+
+var (
+	// XPubVersion is the version of a Bitcoin mainnet extended public key ("xpub")
+	XPubVersion = []byte{0x04, 0x88, 0xb2, 0x1e}
+
+	ErrHardenedDerivation   = errors.New("hardened child keys cannot be derived from a public key")
+	ErrInvalidChild         = errors.New("the derived child key is invalid; use the next index")
+	ErrDeriveBeyondMaxDepth = errors.New("cannot derive a key with more than 255 indices in its path")
+)
+
+// NewExtendedKey builds the master extended key from a public key and a 32-byte chain code
+func NewExtendedKey(pk *crypto.ECPoint, chainCode []byte) (*ExtendedKey, error) {
+	if pk == nil || !pk.IsOnCurve() {
+		return nil, errors.New("NewExtendedKey: the public key is not a valid point")
+	}
+	if len(chainCode) != 32 {
+		return nil, fmt.Errorf("NewExtendedKey: expected a 32-byte chain code, got %d bytes", len(chainCode))
+	}
+	return &ExtendedKey{
+		PublicKey: pk,
+		ChainCode: chainCode,
+		ParentFP:  []byte{0, 0, 0, 0},
+		Version:   XPubVersion,
+	}, nil
+}
+
+// NewExtendedKeyFromString parses a base58 serialized extended public key (e.g. "xpub...") whose key is a point of `curve`
+func NewExtendedKeyFromString(key string, curve elliptic.Curve) (*ExtendedKey, error) {
+	decoded, version, err := base58.CheckDecode(key)
+	if err != nil {
+		return nil, err
+	}
+	// CheckDecode strips the first byte of the payload as the "version"
+	payload := append([]byte{version}, decoded...)
+	if len(payload) != serializedKeyLen {
+		return nil, errors.New("NewExtendedKeyFromString: the serialized key has an invalid length")
+	}
+	point, err := decompressPublicKey(curve, payload[45:])
+	if err != nil {
+		return nil, err
+	}
+	return &ExtendedKey{
+		PublicKey:  point,
+		Depth:      payload[4],
+		ParentFP:   payload[5:9],
+		ChildIndex: binary.BigEndian.Uint32(payload[9:13]),
+		ChainCode:  payload[13:45],
+		Version:    payload[:4],
+	}, nil
+}
+
+// String returns the base58 serialization of the extended key
+func (k *ExtendedKey) String() string {
+	serialized := make([]byte, 0, serializedKeyLen)
+	serialized = append(serialized, k.Version...)
+	serialized = append(serialized, k.Depth)
+	serialized = append(serialized, k.ParentFP...)
+	serialized = append(serialized, uint32Bytes(k.ChildIndex)...)
+	serialized = append(serialized, k.ChainCode...)
+	serialized = append(serialized, compressPublicKey(k.PublicKey)...)
+	return base58.CheckEncode(serialized[1:], serialized[0])
+}
+
+// DeriveChildKey derives the non-hardened child at `index` (BIP32 CKDpub).
+// It returns the additive tweak `il` such that the child public key is `pk + il*G`.
+func DeriveChildKey(index uint32, pk *ExtendedKey) (*big.Int, *ExtendedKey, error) {
+	if index >= HardenedKeyStart {
+		return nil, nil, ErrHardenedDerivation
+	}
+	if pk.Depth == 255 {
+		return nil, nil, ErrDeriveBeyondMaxDepth
+	}
+	curve := pk.PublicKey.Curve()
+	compressed := compressPublicKey(pk.PublicKey)
+
+	// I = HMAC-SHA512(c_par, serP(K_par) || ser32(i))
+	mac := hmac.New(sha512.New, pk.ChainCode)
+	mac.Write(compressed)
+	mac.Write(uint32Bytes(index))
+	ilr := mac.Sum(nil)
+	il, childChainCode := new(big.Int).SetBytes(ilr[:32]), ilr[32:]
+	if il.Cmp(curve.Params().N) >= 0 || il.Sign() == 0 {
+		return nil, nil, ErrInvalidChild
+	}
+
+	// K_i = point(il) + K_par
+	childPk, err := crypto.ScalarBaseMult(curve, il).Add(pk.PublicKey)
+	if err != nil {
+		// the child is the point at infinity
+		return nil, nil, ErrInvalidChild
+	}
+	return il, &ExtendedKey{
+		PublicKey:  childPk,
+		Depth:      pk.Depth + 1,
+		ChildIndex: index,
+		ChainCode:  childChainCode,
+		ParentFP:   btcutil.Hash160(compressed)[:4],
+		Version:    pk.Version,
+	}, nil
+}
+
+// DeriveChildKeyFromHierarchy derives the child key at the end of the path `indicesHierarchy`.
+// It returns the sum of the tweaks along the path modulo `mod`, which each party adds to its share to sign for the child key.
+func DeriveChildKeyFromHierarchy(indicesHierarchy []uint32, pk *ExtendedKey, mod *big.Int) (*big.Int, *ExtendedKey, error) {
+	modN := common.ModInt(mod)
+	delta, k := big.NewInt(0), pk
+	for _, index := range indicesHierarchy {
+		il, child, err := DeriveChildKey(index, k)
+		if err != nil {
+			return nil, nil, err
+		}
+		delta = modN.Add(delta, il)
+		k = child
+	}
+	return delta, k, nil
+}
+
+// ----- //
+
+func compressPublicKey(pk *crypto.ECPoint) []byte {
+	var buf bytes.Buffer
+	if pk.Y().Bit(0) == 0 {
+		buf.WriteByte(0x02)
+	} else {
+		buf.WriteByte(0x03)
+	}
+	buf.Write(paddedBytes(pk.X(), 32))
+	return buf.Bytes()
+}
+
+// decompressPublicKey decodes a point in the SEC 1 compressed form. The curves of this library are of the form
+// y^2 = x^3 + ax + b with a = 0 (secp256k1) or a = -3 (the NIST curves), and elliptic.CurveParams does not carry a,
+// so both are tried and the on-curve check of `curve` decides.
+func decompressPublicKey(curve elliptic.Curve, bz []byte) (*crypto.ECPoint, error) {
+	if len(bz) != 33 || (bz[0] != 0x02 && bz[0] != 0x03) {
+		return nil, errors.New("the public key is not a compressed point")
+	}
+	P := curve.Params().P
+	x := new(big.Int).SetBytes(bz[1:])
+	if x.Cmp(P) >= 0 {
+		return nil, errors.New("the x coordinate of the public key is not reduced")
+	}
+	x3b := new(big.Int).Exp(x, big.NewInt(3), P)
+	x3b.Add(x3b, curve.Params().B)
+	for _, a := range []int64{0, -3} {
+		ySq := new(big.Int).Add(x3b, new(big.Int).Mul(big.NewInt(a), x))
+		y := new(big.Int).ModSqrt(ySq.Mod(ySq, P), P)
+		if y == nil {
+			continue
+		}
+		if y.Bit(0) != uint(bz[0]&1) {
+			y.Sub(P, y)
+		}
+		if curve.IsOnCurve(x, y) {
+			return crypto.NewECPoint(curve, x, y)
+		}
+	}
+	return nil, errors.New("the public key is not on the curve")
+}
+
+func paddedBytes(n *big.Int, size int) []byte {
+	bz := n.Bytes()
+	if len(bz) >= size {
+		return bz
+	}
+	return append(make([]byte, size-len(bz)), bz...)
+}
+
+func uint32Bytes(i uint32) []byte {
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, i)
+	return bz
+}
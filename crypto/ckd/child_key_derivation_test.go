@@ -0,0 +1,96 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package ckd_test
+
+import (
+	"crypto/elliptic"
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+	. "github.com/binance-chain/tss-lib/crypto/ckd"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+// non-hardened steps of the BIP32 test vectors
+// https://github.com/bitcoin/bips/blob/master/bip-0032.mediawiki#test-vectors
+var publicDerivationVectors = []struct {
+	parent, child string
+	index         uint32
+}{
+	{ // vector 1: m/0H -> m/0H/1
+		"xpub68Gmy5EdvgibQVfPdqkBBCHxA5htiqg55crXYuXoQRKfDBFA1WEjWgP6LHhwBZeNK1VTsfTFUHCdrfp1bgwQ9xv5ski8PX9rL2dZXvgGDnw",
+		"xpub6ASuArnXKPbfEwhqN6e3mwBcDTgzisQN1wXN9BJcM47sSikHjJf3UFHKkNAWbWMiGj7Wf5uMash7SyYq527Hqck2AxYysAA7xmALppuCkwQ",
+		1,
+	},
+	{ // vector 2: m -> m/0
+		"xpub661MyMwAqRbcFW31YEwpkMuc5THy2PSt5bDMsktWQcFF8syAmRUapSCGu8ED9W6oDMSgv6Zz8idoc4a6mr8BDzTJY47LJhkJ8UB7WEGuduB",
+		"xpub69H7F5d8KSRgmmdJg2KhpAK8SR3DjMwAdkxj3ZuxV27CprR9LgpeyGmXUbC6wb7ERfvrnKZjXoUmmDznezpbZb7ap6r1D3tgFxHmwMkQTPH",
+		0,
+	},
+}
+
+func TestDeriveChildKeyVectors(t *testing.T) {
+	for _, v := range publicDerivationVectors {
+		parent, err := NewExtendedKeyFromString(v.parent, tss.EC())
+		assert.NoError(t, err)
+		assert.Equal(t, v.parent, parent.String())
+
+		_, child, err := DeriveChildKey(v.index, parent)
+		assert.NoError(t, err)
+		assert.Equal(t, v.child, child.String())
+	}
+}
+
+func TestNewExtendedKeyFromStringCurve(t *testing.T) {
+	for _, curve := range []elliptic.Curve{tss.EC(), elliptic.P256()} {
+		chainCode := make([]byte, 32)
+		_, _ = rand.Read(chainCode)
+		for k := 0; k < 8; k++ {
+			pk := crypto.ScalarBaseMult(curve, common.GetRandomPositiveInt(curve.Params().N))
+			master, err := NewExtendedKey(pk, chainCode)
+			assert.NoError(t, err)
+			parsed, err := NewExtendedKeyFromString(master.String(), curve)
+			if assert.NoError(t, err) {
+				assert.True(t, pk.Equals(parsed.PublicKey), "the key must be decoded on %s", curve.Params().Name)
+			}
+		}
+	}
+}
+
+func TestDeriveChildKeyFromHierarchy(t *testing.T) {
+	x := common.GetRandomPositiveInt(tss.EC().Params().N)
+	pk := crypto.ScalarBaseMult(tss.EC(), x)
+	chainCode := make([]byte, 32)
+	_, _ = rand.Read(chainCode)
+	master, err := NewExtendedKey(pk, chainCode)
+	assert.NoError(t, err)
+
+	delta, child, err := DeriveChildKeyFromHierarchy([]uint32{44, 60, 0, 7}, master, tss.EC().Params().N)
+	assert.NoError(t, err)
+	assert.Equal(t, uint8(4), child.Depth)
+	assert.Equal(t, uint32(7), child.ChildIndex)
+
+	// the child private key is x + delta
+	childX := new(big.Int).Mod(new(big.Int).Add(x, delta), tss.EC().Params().N)
+	assert.True(t, crypto.ScalarBaseMult(tss.EC(), childX).Equals(child.PublicKey))
+}
+
+func TestDeriveChildKeyHardened(t *testing.T) {
+	x := common.GetRandomPositiveInt(tss.EC().Params().N)
+	master, err := NewExtendedKey(crypto.ScalarBaseMult(tss.EC(), x), make([]byte, 32))
+	assert.NoError(t, err)
+
+	_, _, err = DeriveChildKey(HardenedKeyStart, master)
+	assert.Equal(t, ErrHardenedDerivation, err)
+	_, _, err = DeriveChildKeyFromHierarchy([]uint32{0, HardenedKeyStart + 1}, master, tss.EC().Params().N)
+	assert.Equal(t, ErrHardenedDerivation, err)
+}
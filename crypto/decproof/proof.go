@@ -0,0 +1,182 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+// Paillier decryption modulo q proof (Πdec) from CGGMP21, figure 28, made non-interactive with Fiat-Shamir.
+
+// It proves that the Paillier ciphertext C = enc(y; rho) has a plaintext y with x = y mod q, without revealing y.
+// Unlike the figure, z1 is range checked: |y| must be below 2^YBits, which keeps every plaintext that passes below
+// N0/2, so that y is the centered plaintext of C rather than any of its representatives mod N0. The proof is computed
+// against the verifier's ring-Pedersen parameters (NHat, s, t), so a prover produces one proof per verifier.
+
+package decproof
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto/paillier"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+const (
+	l   = 256 // ℓ
+	eps = 512 // ε
+
+	// YBits bounds the plaintext: |y| < 2^YBits
+	YBits = 1600
+	// slack is the statistical distance of z1 from the uniform, 2^-slack; it is smaller than ε so that the range of
+	// z1 stays well below N0/2
+	slack = 128
+	// z1Bits bounds z1 = alpha + e*y, whose mask alpha hides e*y < 2^(ℓ+YBits)
+	z1Bits = YBits + l + slack
+
+	// ProofBytesParts is the length of a serialized Proof
+	ProofBytesParts = 7
+)
+
+type (
+	Proof struct {
+		S, T, A, Gamma *big.Int
+		Z1, Z2, W      *big.Int
+	}
+)
+
+var one = big.NewInt(1)
+
+// NewProof proves that C encrypts y under `pk` with randomness rho and that x = y mod q, to the verifier with the
+// ring-Pedersen parameters (NHat, s, t). `session` binds the proof to its context and must be given to Verify as well.
+func NewProof(pk *paillier.PublicKey, C, x, y, rho, NHat, s, t, session *big.Int) (*Proof, error) {
+	if pk == nil || C == nil || x == nil || y == nil || rho == nil || NHat == nil || s == nil || t == nil {
+		return nil, errors.New("decproof.NewProof received nil value(s)")
+	}
+	q := tss.EC().Params().N
+	if new(big.Int).Abs(y).Cmp(new(big.Int).Lsh(one, YBits)) >= 0 {
+		return nil, fmt.Errorf("the plaintext is %d bits long, more than the %d bits that the proof allows", y.BitLen(), YBits)
+	}
+	if new(big.Int).Mod(y, q).Cmp(x) != 0 {
+		return nil, errors.New("x is not the plaintext mod q")
+	}
+	N0 := pk.N
+	if N0.BitLen() < z1Bits+2 {
+		return nil, fmt.Errorf("the %d-bit Paillier modulus is too short for the proof", N0.BitLen())
+	}
+	lN := new(big.Int).Lsh(NHat, l)
+
+	alpha := common.GetRandomSymmetricInt(new(big.Int).Lsh(one, z1Bits))
+	mu := common.GetRandomSymmetricInt(lN)
+	nu := common.GetRandomSymmetricInt(new(big.Int).Lsh(lN, eps))
+	r := common.GetRandomPositiveRelativelyPrimeInt(N0)
+	defer common.Zeroize(alpha, mu, nu, r)
+
+	modNHat, modNSquare := common.ModInt(NHat), common.ModInt(pk.NSquare())
+	pf := &Proof{
+		S:     modNHat.Mul(modNHat.ExpSecret(s, y), modNHat.ExpSecret(t, mu)),
+		T:     modNHat.Mul(modNHat.ExpSecret(s, alpha), modNHat.ExpSecret(t, nu)),
+		A:     modNSquare.Mul(pk.ExpGamma(alpha), modNSquare.ExpSecret(r, N0)),
+		Gamma: new(big.Int).Mod(alpha, q),
+	}
+	e := challenge(pk, C, x, NHat, s, t, session, pf)
+	pf.Z1 = new(big.Int).Add(alpha, new(big.Int).Mul(e, y))
+	pf.Z2 = new(big.Int).Add(nu, new(big.Int).Mul(e, mu))
+	pf.W = common.ModInt(N0).Mul(r, common.ModInt(N0).ExpSecret(rho, e))
+	return pf, nil
+}
+
+// Verify checks the proof for C under `pk` and x with the verifier's own ring-Pedersen parameters (NHat, s, t)
+func (pf *Proof) Verify(pk *paillier.PublicKey, C, x, NHat, s, t, session *big.Int) bool {
+	if pf == nil || pk == nil || pk.N == nil || C == nil || x == nil || NHat == nil || s == nil || t == nil {
+		return false
+	}
+	if pf.Gamma == nil || pf.Z1 == nil || pf.Z2 == nil {
+		return false
+	}
+	N0 := pk.N
+	NSquare := pk.NSquare()
+	if N0.BitLen() < z1Bits+2 {
+		return false
+	}
+	if !common.IsNumberInMultiplicativeGroup(NHat, pf.S) || !common.IsNumberInMultiplicativeGroup(NHat, pf.T) ||
+		!common.IsNumberInMultiplicativeGroup(NSquare, pf.A) || !common.IsNumberInMultiplicativeGroup(NSquare, C) ||
+		!common.IsNumberInMultiplicativeGroup(N0, pf.W) {
+		return false
+	}
+	// range check: z1 in ±2^(YBits+ℓ+slack)
+	if new(big.Int).Abs(pf.Z1).Cmp(new(big.Int).Lsh(one, z1Bits)) > 0 {
+		return false
+	}
+	q := tss.EC().Params().N
+	e := challenge(pk, C, x, NHat, s, t, session, pf)
+
+	// (1 + N0)^z1 * w^N0 = A * C^e mod N0^2
+	modNSquare := common.ModInt(NSquare)
+	lhs := modNSquare.Mul(pk.ExpGamma(pf.Z1), modNSquare.Exp(pf.W, N0))
+	if lhs.Cmp(modNSquare.Mul(pf.A, modNSquare.Exp(C, e))) != 0 {
+		return false
+	}
+	// z1 = gamma + e*x mod q
+	modQ := common.ModInt(q)
+	if new(big.Int).Mod(pf.Z1, q).Cmp(modQ.Add(pf.Gamma, modQ.Mul(e, x))) != 0 {
+		return false
+	}
+	// s^z1 t^z2 = T * S^e mod NHat
+	modNHat := common.ModInt(NHat)
+	sZ1, tZ2 := modNHat.Exp(s, pf.Z1), modNHat.Exp(t, pf.Z2)
+	if sZ1 == nil || tZ2 == nil {
+		return false
+	}
+	return modNHat.Mul(sZ1, tZ2).Cmp(modNHat.Mul(pf.T, modNHat.Exp(pf.S, e))) == 0
+}
+
+// Serialize encodes the proof's values in order; the signed ones are prefixed with a sign byte.
+// Missing values are encoded as empty parts, which fail to validate.
+func (pf *Proof) Serialize() [][]byte {
+	return [][]byte{
+		bytesOrEmpty(pf.S), bytesOrEmpty(pf.T), bytesOrEmpty(pf.A), bytesOrEmpty(pf.Gamma),
+		common.SignedIntToBytes(pf.Z1), common.SignedIntToBytes(pf.Z2), bytesOrEmpty(pf.W),
+	}
+}
+
+func UnmarshalProof(bzs [][]byte) (*Proof, error) {
+	if len(bzs) != ProofBytesParts {
+		return nil, fmt.Errorf("UnmarshalProof expected %d parts but got %d", ProofBytesParts, len(bzs))
+	}
+	Z1, err := common.SignedBytesToInt(bzs[4])
+	if err != nil {
+		return nil, err
+	}
+	Z2, err := common.SignedBytesToInt(bzs[5])
+	if err != nil {
+		return nil, err
+	}
+	return &Proof{
+		S:     new(big.Int).SetBytes(bzs[0]),
+		T:     new(big.Int).SetBytes(bzs[1]),
+		A:     new(big.Int).SetBytes(bzs[2]),
+		Gamma: new(big.Int).SetBytes(bzs[3]),
+		Z1:    Z1,
+		Z2:    Z2,
+		W:     new(big.Int).SetBytes(bzs[6]),
+	}, nil
+}
+
+// ----- utils
+
+func challenge(pk *paillier.PublicKey, C, x, NHat, s, t, session *big.Int, pf *Proof) *big.Int {
+	if session == nil {
+		session = big.NewInt(0)
+	}
+	eHash := common.SHA512_256i(pk.N, C, x, NHat, s, t, session, pf.S, pf.T, pf.A, pf.Gamma)
+	return common.RejectionSample(tss.EC().Params().N, eHash)
+}
+
+func bytesOrEmpty(v *big.Int) []byte {
+	if v == nil {
+		return []byte{}
+	}
+	return v.Bytes()
+}
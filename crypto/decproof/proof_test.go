@@ -0,0 +1,55 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package decproof_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/binance-chain/tss-lib/common"
+	. "github.com/binance-chain/tss-lib/crypto/decproof"
+	"github.com/binance-chain/tss-lib/ecdsa/keygen"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+func TestDecProof(t *testing.T) {
+	q := tss.EC().Params().N
+	keys, _, err := keygen.LoadKeygenTestFixtures(1)
+	assert.NoError(t, err)
+	NHat, s, tt, err := keygen.LoadNTildeH1H2FromTestFixture(1)
+	assert.NoError(t, err)
+	pk := &keys[0].PaillierSK.PublicKey
+	session := big.NewInt(42)
+
+	// a negative plaintext is encrypted as its representative mod N
+	y := common.GetRandomSymmetricInt(new(big.Int).Lsh(big.NewInt(1), YBits-1))
+	C, rho, err := pk.EncryptAndReturnRandomness(new(big.Int).Mod(y, pk.N))
+	assert.NoError(t, err)
+	x := new(big.Int).Mod(y, q)
+
+	pf, err := NewProof(pk, C, x, y, rho, NHat, s, tt, session)
+	assert.NoError(t, err)
+	assert.True(t, pf.Verify(pk, C, x, NHat, s, tt, session))
+	assert.False(t, pf.Verify(pk, C, x, NHat, s, tt, big.NewInt(43)), "the proof is bound to the session")
+
+	pf2, err := UnmarshalProof(pf.Serialize())
+	assert.NoError(t, err)
+	assert.True(t, pf2.Verify(pk, C, x, NHat, s, tt, session))
+
+	// x is not the plaintext mod q
+	x2 := new(big.Int).Mod(new(big.Int).Add(x, big.NewInt(1)), q)
+	assert.False(t, pf.Verify(pk, C, x2, NHat, s, tt, session))
+	_, err = NewProof(pk, C, x2, y, rho, NHat, s, tt, session)
+	assert.Error(t, err)
+
+	// another representative of the plaintext mod N gives another x, but is out of range
+	y2 := new(big.Int).Add(y, pk.N)
+	_, err = NewProof(pk, C, new(big.Int).Mod(y2, q), y2, rho, NHat, s, tt, session)
+	assert.Error(t, err)
+}
@@ -35,7 +35,7 @@ func NewDLNProof(h1, h2, x, p, q, N *big.Int) *Proof {
 	alpha := [Iterations]*big.Int{}
 	for i := range alpha {
 		a[i] = common.GetRandomPositiveInt(pMulQ)
-		alpha[i] = modN.Exp(h1, a[i])
+		alpha[i] = modN.ExpSecret(h1, a[i])
 	}
 	msg := append([]*big.Int{h1, h2, N}, alpha[:]...)
 	c := common.SHA512_256i(msg...)
@@ -17,6 +17,7 @@ import (
 
 	"github.com/decred/dcrd/dcrec/edwards/v2"
 
+	"github.com/binance-chain/tss-lib/crypto/group"
 	"github.com/binance-chain/tss-lib/tss"
 )
 
@@ -45,6 +46,10 @@ func NewECPointNoCurveCheck(curve elliptic.Curve, X, Y *big.Int) *ECPoint {
 	return &ECPoint{curve, [2]*big.Int{X, Y}}
 }
 
+func (p *ECPoint) Curve() elliptic.Curve {
+	return p.curve
+}
+
 func (p *ECPoint) X() *big.Int {
 	return new(big.Int).Set(p.coords[0])
 }
@@ -58,12 +63,24 @@ func (p *ECPoint) Add(p1 *ECPoint) (*ECPoint, error) {
 	return NewECPoint(p.curve, x, y)
 }
 
+// ScalarMult is variable-time; use ScalarMultSecret when k is a secret share or nonce.
 func (p *ECPoint) ScalarMult(k *big.Int) *ECPoint {
 	x, y := p.curve.ScalarMult(p.X(), p.Y(), k.Bytes())
 	newP, _ := NewECPoint(p.curve, x, y) // it must be on the curve, no need to check.
 	return newP
 }
 
+// ScalarMultSecret runs in constant time with respect to k when the curve has a `group` backend and the point is an
+// element of its group; otherwise, e.g. for edwards25519 points with a torsion component, it falls back to ScalarMult.
+func (p *ECPoint) ScalarMultSecret(k *big.Int) *ECPoint {
+	if g, err := group.FromCurve(p.curve); err == nil && k.Sign() >= 0 {
+		if gp, err := p.ToGroupPoint(g); err == nil {
+			return newECPointFromGroupResult(p.curve, gp.ScalarMultSecret(g.NewScalar(k)))
+		}
+	}
+	return p.ScalarMult(k)
+}
+
 func (p *ECPoint) IsOnCurve() bool {
 	return isOnCurve(p.curve, p.coords[0], p.coords[1])
 }
@@ -88,12 +105,49 @@ func (p *ECPoint) EightInvEight() *ECPoint {
 	return p.ScalarMult(eight).ScalarMult(eightInv)
 }
 
+// ScalarBaseMult is variable-time; use ScalarBaseMultSecret when k is a secret share or nonce.
 func ScalarBaseMult(curve elliptic.Curve, k *big.Int) *ECPoint {
 	x, y := curve.ScalarBaseMult(k.Bytes())
 	p, _ := NewECPoint(curve, x, y) // it must be on the curve, no need to check.
 	return p
 }
 
+// ScalarBaseMultSecret runs in constant time with respect to k when the curve has a `group` backend
+func ScalarBaseMultSecret(curve elliptic.Curve, k *big.Int) *ECPoint {
+	if g, err := group.FromCurve(curve); err == nil && k.Sign() >= 0 {
+		return newECPointFromGroupResult(curve, g.ScalarBaseMultSecret(g.NewScalar(k)))
+	}
+	return ScalarBaseMult(curve, k)
+}
+
+// NewECPointFromGroup converts a point of the group of `curve` to an ECPoint
+func NewECPointFromGroup(curve elliptic.Curve, p group.Point) (*ECPoint, error) {
+	x, y, err := p.XY()
+	if err != nil {
+		return nil, err
+	}
+	return NewECPoint(curve, x, y)
+}
+
+// ToGroupPoint converts the point to a point of `g`, which must be the group of the point's curve
+func (p *ECPoint) ToGroupPoint(g group.Group) (group.Point, error) {
+	if p == nil {
+		return nil, errors.New("ToGroupPoint() received a nil point")
+	}
+	return g.NewPoint(p.coords[0], p.coords[1])
+}
+
+// newECPointFromGroupResult returns what the `elliptic.Curve` methods would: the identity of a Weierstrass curve
+// comes out of them as (0, 0), which is not on the curve, so it is returned as nil.
+func newECPointFromGroupResult(curve elliptic.Curve, p group.Point) *ECPoint {
+	x, y, err := p.XY()
+	if err != nil {
+		return nil
+	}
+	newP, _ := NewECPoint(curve, x, y)
+	return newP
+}
+
 func isOnCurve(c elliptic.Curve, x, y *big.Int) bool {
 	if x == nil || y == nil {
 		return false
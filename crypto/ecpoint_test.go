@@ -7,10 +7,16 @@
 package crypto_test
 
 import (
+	"crypto/elliptic"
 	"math/big"
 	"reflect"
 	"testing"
 
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/decred/dcrd/dcrec/edwards/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/binance-chain/tss-lib/common"
 	. "github.com/binance-chain/tss-lib/crypto"
 	"github.com/binance-chain/tss-lib/tss"
 )
@@ -113,3 +119,28 @@ func TestUnFlattenECPoints(t *testing.T) {
 		})
 	}
 }
+
+func TestScalarMultMatchesCurve(t *testing.T) {
+	for _, curve := range []elliptic.Curve{btcec.S256(), elliptic.P256(), edwards.Edwards()} {
+		N := curve.Params().N
+		for _, k := range []*big.Int{big.NewInt(1), common.GetRandomPositiveInt(N), new(big.Int).Add(N, big.NewInt(5))} {
+			x, y := curve.ScalarBaseMult(k.Bytes())
+			P := ScalarBaseMult(curve, k)
+			assert.Equal(t, x, P.X())
+			assert.Equal(t, y, P.Y())
+			assert.True(t, ScalarBaseMultSecret(curve, k).Equals(P))
+
+			k2 := common.GetRandomPositiveInt(N)
+			x, y = curve.ScalarMult(x, y, k2.Bytes())
+			Q := P.ScalarMult(k2)
+			assert.Equal(t, x, Q.X())
+			assert.Equal(t, y, Q.Y())
+			assert.True(t, P.ScalarMultSecret(k2).Equals(Q))
+		}
+	}
+	// the identity of a Weierstrass curve is not a valid ECPoint
+	for _, curve := range []elliptic.Curve{btcec.S256(), elliptic.P256()} {
+		assert.Nil(t, ScalarBaseMult(curve, curve.Params().N))
+		assert.Nil(t, ScalarBaseMultSecret(curve, curve.Params().N))
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+// Paillier encryption in range proof (Πenc) from CGGMP21, figure 14, made non-interactive with Fiat-Shamir.
+
+// It proves that the Paillier ciphertext K = enc(k; rho) has a plaintext k in ±2^(ℓ+ε). The proof is computed
+// against the verifier's ring-Pedersen parameters (NHat, s, t), i.e. its NTilde, h1 and h2, so a prover produces
+// one proof per verifier.
+
+package encproof
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto/paillier"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+const (
+	l   = 256 // ℓ
+	eps = 512 // ε
+
+	// ProofBytesParts is the length of a serialized Proof
+	ProofBytesParts = 6
+)
+
+type (
+	Proof struct {
+		S, A, C, Z1, Z2, Z3 *big.Int
+	}
+)
+
+var one = big.NewInt(1)
+
+// NewProof proves that K encrypts k under `pk` with randomness rho, to the verifier with the ring-Pedersen
+// parameters (NHat, s, t). `session` binds the proof to its context and must be given to Verify as well.
+func NewProof(pk *paillier.PublicKey, K, k, rho, NHat, s, t, session *big.Int) (*Proof, error) {
+	if pk == nil || K == nil || k == nil || rho == nil || NHat == nil || s == nil || t == nil {
+		return nil, errors.New("encproof.NewProof received nil value(s)")
+	}
+	N0 := pk.N
+	NSquare := pk.NSquare()
+	lN := new(big.Int).Lsh(NHat, l)
+
+	alpha := common.GetRandomSymmetricInt(new(big.Int).Lsh(one, l+eps))
+	mu := common.GetRandomSymmetricInt(lN)
+	r := common.GetRandomPositiveRelativelyPrimeInt(N0)
+	gamma := common.GetRandomSymmetricInt(new(big.Int).Lsh(lN, eps))
+	defer common.Zeroize(alpha, mu, r, gamma)
+
+	modNHat, modNSquare := common.ModInt(NHat), common.ModInt(NSquare)
+	pf := &Proof{
+		S: modNHat.Mul(modNHat.ExpSecret(s, k), modNHat.ExpSecret(t, mu)),
+		A: modNSquare.Mul(pk.ExpGamma(alpha), modNSquare.ExpSecret(r, N0)),
+		C: modNHat.Mul(modNHat.ExpSecret(s, alpha), modNHat.ExpSecret(t, gamma)),
+	}
+	e := challenge(pk, K, NHat, s, t, session, pf)
+	pf.Z1 = new(big.Int).Add(alpha, new(big.Int).Mul(e, k))
+	pf.Z2 = common.ModInt(N0).Mul(r, common.ModInt(N0).ExpSecret(rho, e))
+	pf.Z3 = new(big.Int).Add(gamma, new(big.Int).Mul(e, mu))
+	return pf, nil
+}
+
+// Verify checks the proof for K under `pk` with the verifier's own ring-Pedersen parameters (NHat, s, t)
+func (pf *Proof) Verify(pk *paillier.PublicKey, K, NHat, s, t, session *big.Int) bool {
+	if pf == nil || pk == nil || pk.N == nil || K == nil || NHat == nil || s == nil || t == nil {
+		return false
+	}
+	if pf.Z1 == nil || pf.Z3 == nil {
+		return false
+	}
+	N0 := pk.N
+	NSquare := pk.NSquare()
+	if !common.IsNumberInMultiplicativeGroup(NHat, pf.S) || !common.IsNumberInMultiplicativeGroup(NHat, pf.C) ||
+		!common.IsNumberInMultiplicativeGroup(NSquare, pf.A) || !common.IsNumberInMultiplicativeGroup(NSquare, K) ||
+		!common.IsNumberInMultiplicativeGroup(N0, pf.Z2) {
+		return false
+	}
+	// range check: z1 in ±2^(ℓ+ε)
+	if new(big.Int).Abs(pf.Z1).Cmp(new(big.Int).Lsh(one, l+eps)) > 0 {
+		return false
+	}
+	e := challenge(pk, K, NHat, s, t, session, pf)
+
+	// (1 + N0)^z1 * z2^N0 = A * K^e mod N0^2
+	modNSquare := common.ModInt(NSquare)
+	lhs := modNSquare.Mul(pk.ExpGamma(pf.Z1), modNSquare.Exp(pf.Z2, N0))
+	if lhs.Cmp(modNSquare.Mul(pf.A, modNSquare.Exp(K, e))) != 0 {
+		return false
+	}
+	// s^z1 t^z3 = C * S^e mod NHat
+	modNHat := common.ModInt(NHat)
+	sZ1, tZ3 := modNHat.Exp(s, pf.Z1), modNHat.Exp(t, pf.Z3)
+	if sZ1 == nil || tZ3 == nil {
+		return false
+	}
+	return modNHat.Mul(sZ1, tZ3).Cmp(modNHat.Mul(pf.C, modNHat.Exp(pf.S, e))) == 0
+}
+
+// Serialize encodes the proof's values in order; the signed ones are prefixed with a sign byte.
+// Missing values are encoded as empty parts, which fail to validate.
+func (pf *Proof) Serialize() [][]byte {
+	return [][]byte{
+		bytesOrEmpty(pf.S), bytesOrEmpty(pf.A), bytesOrEmpty(pf.C),
+		common.SignedIntToBytes(pf.Z1), bytesOrEmpty(pf.Z2), common.SignedIntToBytes(pf.Z3),
+	}
+}
+
+func UnmarshalProof(bzs [][]byte) (*Proof, error) {
+	if len(bzs) != ProofBytesParts {
+		return nil, fmt.Errorf("UnmarshalProof expected %d parts but got %d", ProofBytesParts, len(bzs))
+	}
+	Z1, err := common.SignedBytesToInt(bzs[3])
+	if err != nil {
+		return nil, err
+	}
+	Z3, err := common.SignedBytesToInt(bzs[5])
+	if err != nil {
+		return nil, err
+	}
+	return &Proof{
+		S:  new(big.Int).SetBytes(bzs[0]),
+		A:  new(big.Int).SetBytes(bzs[1]),
+		C:  new(big.Int).SetBytes(bzs[2]),
+		Z1: Z1,
+		Z2: new(big.Int).SetBytes(bzs[4]),
+		Z3: Z3,
+	}, nil
+}
+
+// ----- utils
+
+func challenge(pk *paillier.PublicKey, K, NHat, s, t, session *big.Int, pf *Proof) *big.Int {
+	if session == nil {
+		session = big.NewInt(0)
+	}
+	eHash := common.SHA512_256i(pk.N, K, NHat, s, t, session, pf.S, pf.A, pf.C)
+	return common.RejectionSample(tss.EC().Params().N, eHash)
+}
+
+func bytesOrEmpty(v *big.Int) []byte {
+	if v == nil {
+		return []byte{}
+	}
+	return v.Bytes()
+}
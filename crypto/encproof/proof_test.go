@@ -0,0 +1,55 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package encproof_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/binance-chain/tss-lib/common"
+	. "github.com/binance-chain/tss-lib/crypto/encproof"
+	"github.com/binance-chain/tss-lib/ecdsa/keygen"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+func TestEncProof(t *testing.T) {
+	q := tss.EC().Params().N
+	pk, _, err := keygen.LoadKeygenTestFixtures(1)
+	assert.NoError(t, err)
+	NHat, s, tt, err := keygen.LoadNTildeH1H2FromTestFixture(1)
+	assert.NoError(t, err)
+	paiPK := &pk[0].PaillierSK.PublicKey
+	session := big.NewInt(42)
+
+	k := common.GetRandomPositiveInt(q)
+	K, rho, err := paiPK.EncryptAndReturnRandomness(k)
+	assert.NoError(t, err)
+
+	pf, err := NewProof(paiPK, K, k, rho, NHat, s, tt, session)
+	assert.NoError(t, err)
+	assert.True(t, pf.Verify(paiPK, K, NHat, s, tt, session))
+	assert.False(t, pf.Verify(paiPK, K, NHat, s, tt, big.NewInt(43)), "the proof is bound to the session")
+
+	pf2, err := UnmarshalProof(pf.Serialize())
+	assert.NoError(t, err)
+	assert.True(t, pf2.Verify(paiPK, K, NHat, s, tt, session))
+
+	// the plaintext is out of range
+	big1 := new(big.Int).Lsh(big.NewInt(1), 1000)
+	K2, rho2, err := paiPK.EncryptAndReturnRandomness(big1)
+	assert.NoError(t, err)
+	pf3, err := NewProof(paiPK, K2, big1, rho2, NHat, s, tt, session)
+	assert.NoError(t, err)
+	assert.False(t, pf3.Verify(paiPK, K2, NHat, s, tt, session))
+
+	// the proof is for another ciphertext
+	K3, err := paiPK.Encrypt(k)
+	assert.NoError(t, err)
+	assert.False(t, pf.Verify(paiPK, K3, NHat, s, tt, session))
+}
@@ -0,0 +1,48 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package group
+
+import (
+	"crypto/elliptic"
+	"errors"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/decred/dcrd/dcrec/edwards/v2"
+)
+
+// FromCurve returns the group of a curve that can be passed to `tss.SetCurve`: secp256k1, P-256 or edwards25519
+func FromCurve(curve elliptic.Curve) (Group, error) {
+	if curve == nil {
+		return nil, errors.New("FromCurve() received a nil curve")
+	}
+	if _, ok := curve.(*edwards.TwistedEdwardsCurve); ok {
+		return Ed25519(), nil
+	}
+	params := curve.Params()
+	for _, g := range []struct {
+		curve elliptic.Curve
+		group Group
+	}{
+		{btcec.S256(), Secp256k1()},
+		{elliptic.P256(), P256()},
+	} {
+		if curve == g.curve || (params.P.Cmp(g.curve.Params().P) == 0 && params.N.Cmp(g.curve.Params().N) == 0) {
+			return g.group, nil
+		}
+	}
+	return nil, errors.New("FromCurve() received an unsupported curve")
+}
+
+// ForCurve returns the group of `curve` from FromCurve, or else a group over its `elliptic.Curve` methods (see
+// NewCurveGroup), so that the code that runs over groups keeps working with the other curves of `tss.SetCurve`
+func ForCurve(curve elliptic.Curve) (Group, error) {
+	g, err := FromCurve(curve)
+	if err != nil && curve != nil {
+		return NewCurveGroup(curve), nil
+	}
+	return g, err
+}
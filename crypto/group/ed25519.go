@@ -0,0 +1,233 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package group
+
+import (
+	"errors"
+	"math/big"
+
+	"filippo.io/edwards25519"
+	"filippo.io/edwards25519/field"
+)
+
+type (
+	ed25519Group struct{}
+
+	ed25519Scalar struct {
+		s edwards25519.Scalar
+	}
+
+	ed25519Point struct {
+		p edwards25519.Point
+	}
+)
+
+const (
+	ed25519ScalarLen = 32
+)
+
+var (
+	// the order of the prime-order subgroup, 2^252 + 27742317777372353535851937790883648493
+	ed25519Order, _ = new(big.Int).SetString("7237005577332262213973186563042994240857116359379907606001950938285454250989", 10)
+
+	// 8^-1 mod L, used to check that a point has no torsion component
+	ed25519EightInv = new(ed25519Group).NewScalar(new(big.Int).ModInverse(big.NewInt(8), ed25519Order)).(*ed25519Scalar)
+)
+
+// Ed25519 returns the prime-order subgroup of edwards25519. Points with a torsion component are not elements of it,
+// so the cofactor does not need to be cleared with `ECPoint.EightInvEight`.
+func Ed25519() Group {
+	return ed25519Group{}
+}
+
+func (ed25519Group) Name() string {
+	return "edwards25519"
+}
+
+func (ed25519Group) Order() *big.Int {
+	return new(big.Int).Set(ed25519Order)
+}
+
+func (ed25519Group) NewScalar(v *big.Int) Scalar {
+	bz := new(big.Int).Mod(v, ed25519Order).FillBytes(make([]byte, ed25519ScalarLen))
+	r := new(ed25519Scalar)
+	if _, err := r.s.SetCanonicalBytes(reverse(bz)); err != nil {
+		panic(err) // unreachable: the value is reduced
+	}
+	return r
+}
+
+func (ed25519Group) DecodeScalar(bz []byte) (Scalar, error) {
+	r := new(ed25519Scalar)
+	if _, err := r.s.SetCanonicalBytes(bz); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (ed25519Group) Identity() Point {
+	r := new(ed25519Point)
+	r.p.Set(edwards25519.NewIdentityPoint())
+	return r
+}
+
+func (ed25519Group) Generator() Point {
+	r := new(ed25519Point)
+	r.p.Set(edwards25519.NewGeneratorPoint())
+	return r
+}
+
+// ScalarBaseMult is the constant-time edwards25519 multiplication, which is already fast enough for public scalars
+func (ed25519Group) ScalarBaseMult(k Scalar) Point {
+	r := new(ed25519Point)
+	r.p.ScalarBaseMult(&k.(*ed25519Scalar).s)
+	return r
+}
+
+func (g ed25519Group) ScalarBaseMultSecret(k Scalar) Point {
+	return g.ScalarBaseMult(k)
+}
+
+func (g ed25519Group) NewPoint(x, y *big.Int) (Point, error) {
+	if x == nil || y == nil || x.Sign() < 0 || y.Sign() < 0 || x.BitLen() > 255 || y.BitLen() > 255 {
+		return nil, errors.New("edwards25519: invalid coordinates")
+	}
+	// the RFC 8032 encoding is y with the sign of x in the top bit
+	bz := reverse(y.FillBytes(make([]byte, 32)))
+	bz[31] |= byte(x.Bit(0)) << 7
+	p, err := g.DecodePoint(bz)
+	if err != nil {
+		return nil, err
+	}
+	if px, _, _ := p.XY(); px.Cmp(x) != 0 {
+		return nil, errors.New("edwards25519: the point is not on the curve")
+	}
+	return p, nil
+}
+
+func (ed25519Group) DecodePoint(bz []byte) (Point, error) {
+	r := new(ed25519Point)
+	if _, err := r.p.SetBytes(bz); err != nil {
+		return nil, err
+	}
+	// P has no torsion component iff 8^-1 * (8 * P) == P
+	var check edwards25519.Point
+	check.MultByCofactor(&r.p)
+	check.ScalarMult(&ed25519EightInv.s, &check)
+	if check.Equal(&r.p) != 1 {
+		return nil, errors.New("edwards25519: the point is not in the prime-order subgroup")
+	}
+	return r, nil
+}
+
+// ----- //
+
+func (a *ed25519Scalar) Add(b Scalar) Scalar {
+	r := new(ed25519Scalar)
+	r.s.Add(&a.s, &b.(*ed25519Scalar).s)
+	return r
+}
+
+func (a *ed25519Scalar) Sub(b Scalar) Scalar {
+	r := new(ed25519Scalar)
+	r.s.Subtract(&a.s, &b.(*ed25519Scalar).s)
+	return r
+}
+
+func (a *ed25519Scalar) Mul(b Scalar) Scalar {
+	r := new(ed25519Scalar)
+	r.s.Multiply(&a.s, &b.(*ed25519Scalar).s)
+	return r
+}
+
+func (a *ed25519Scalar) Negate() Scalar {
+	r := new(ed25519Scalar)
+	r.s.Negate(&a.s)
+	return r
+}
+
+func (a *ed25519Scalar) Invert() Scalar {
+	r := new(ed25519Scalar)
+	r.s.Invert(&a.s)
+	return r
+}
+
+func (a *ed25519Scalar) Equal(b Scalar) bool {
+	return a.s.Equal(&b.(*ed25519Scalar).s) == 1
+}
+
+func (a *ed25519Scalar) IsZero() bool {
+	return a.s.Equal(edwards25519.NewScalar()) == 1
+}
+
+func (a *ed25519Scalar) BigInt() *big.Int {
+	return new(big.Int).SetBytes(reverse(a.s.Bytes()))
+}
+
+func (a *ed25519Scalar) Bytes() []byte {
+	return a.s.Bytes()
+}
+
+// ----- //
+
+func (p *ed25519Point) Add(q Point) Point {
+	r := new(ed25519Point)
+	r.p.Add(&p.p, &q.(*ed25519Point).p)
+	return r
+}
+
+func (p *ed25519Point) Sub(q Point) Point {
+	r := new(ed25519Point)
+	r.p.Subtract(&p.p, &q.(*ed25519Point).p)
+	return r
+}
+
+func (p *ed25519Point) Negate() Point {
+	r := new(ed25519Point)
+	r.p.Negate(&p.p)
+	return r
+}
+
+func (p *ed25519Point) ScalarMult(k Scalar) Point {
+	r := new(ed25519Point)
+	r.p.ScalarMult(&k.(*ed25519Scalar).s, &p.p)
+	return r
+}
+
+func (p *ed25519Point) ScalarMultSecret(k Scalar) Point {
+	return p.ScalarMult(k)
+}
+
+func (p *ed25519Point) Equal(q Point) bool {
+	return p.p.Equal(&q.(*ed25519Point).p) == 1
+}
+
+func (p *ed25519Point) IsIdentity() bool {
+	return p.p.Equal(edwards25519.NewIdentityPoint()) == 1
+}
+
+// XY returns the affine coordinates; the identity is (0, 1)
+func (p *ed25519Point) XY() (x, y *big.Int, err error) {
+	X, Y, Z, _ := p.p.ExtendedCoordinates()
+	var zInv, ax, ay field.Element
+	zInv.Invert(Z)
+	ax.Multiply(X, &zInv)
+	ay.Multiply(Y, &zInv)
+	return new(big.Int).SetBytes(reverse(ax.Bytes())), new(big.Int).SetBytes(reverse(ay.Bytes())), nil
+}
+
+func (p *ed25519Point) Bytes() []byte {
+	return p.p.Bytes()
+}
+
+// reverse reverses a byte slice in place and returns it; it converts between big- and little-endian
+func reverse(bz []byte) []byte {
+	for i, j := 0, len(bz)-1; i < j; i, j = i+1, j-1 {
+		bz[i], bz[j] = bz[j], bz[i]
+	}
+	return bz
+}
@@ -0,0 +1,223 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package group
+
+import (
+	"crypto/elliptic"
+	"errors"
+	"math/big"
+
+	"github.com/binance-chain/tss-lib/common"
+)
+
+// The generic group runs over the `elliptic.Curve` methods of any other curve, as `crypto.ECPoint` does. It is only
+// as constant-time as those methods, and it assumes a curve of prime order N whose methods return (0, 0) for the point
+// at infinity, as crypto/elliptic and btcec do.
+
+type (
+	genericGroup struct {
+		curve elliptic.Curve
+	}
+
+	genericScalar struct {
+		g *genericGroup
+		v *big.Int
+	}
+
+	// genericPoint has nil coordinates for the identity
+	genericPoint struct {
+		g    *genericGroup
+		x, y *big.Int
+	}
+)
+
+// NewCurveGroup returns a group over a prime-order Weierstrass curve that has no backend in this package
+func NewCurveGroup(curve elliptic.Curve) Group {
+	return &genericGroup{curve}
+}
+
+func (g *genericGroup) Name() string {
+	return g.curve.Params().Name
+}
+
+func (g *genericGroup) Order() *big.Int {
+	return new(big.Int).Set(g.curve.Params().N)
+}
+
+func (g *genericGroup) scalarLen() int {
+	return (g.curve.Params().N.BitLen() + 7) / 8
+}
+
+func (g *genericGroup) coordLen() int {
+	return (g.curve.Params().P.BitLen() + 7) / 8
+}
+
+func (g *genericGroup) NewScalar(v *big.Int) Scalar {
+	return &genericScalar{g, new(big.Int).Mod(v, g.curve.Params().N)}
+}
+
+func (g *genericGroup) DecodeScalar(bz []byte) (Scalar, error) {
+	if len(bz) != g.scalarLen() {
+		return nil, errors.New("generic group: the scalar has an invalid length")
+	}
+	v := new(big.Int).SetBytes(bz)
+	if v.Cmp(g.curve.Params().N) >= 0 {
+		return nil, errors.New("generic group: the scalar is not reduced")
+	}
+	return &genericScalar{g, v}, nil
+}
+
+func (g *genericGroup) Identity() Point {
+	return &genericPoint{g: g}
+}
+
+func (g *genericGroup) Generator() Point {
+	params := g.curve.Params()
+	return &genericPoint{g, new(big.Int).Set(params.Gx), new(big.Int).Set(params.Gy)}
+}
+
+func (g *genericGroup) ScalarBaseMult(k Scalar) Point {
+	return g.newPoint(g.curve.ScalarBaseMult(k.Bytes()))
+}
+
+// ScalarBaseMultSecret is ScalarBaseMult: the generic group is only as constant-time as the curve's own methods
+func (g *genericGroup) ScalarBaseMultSecret(k Scalar) Point {
+	return g.ScalarBaseMult(k)
+}
+
+func (g *genericGroup) NewPoint(x, y *big.Int) (Point, error) {
+	if x == nil || y == nil || !g.curve.IsOnCurve(x, y) {
+		return nil, errors.New("generic group: the point is not on the curve")
+	}
+	return &genericPoint{g, new(big.Int).Set(x), new(big.Int).Set(y)}, nil
+}
+
+// DecodePoint decodes the uncompressed SEC 1 encoding, as the compressed one needs the coefficient a of the curve
+func (g *genericGroup) DecodePoint(bz []byte) (Point, error) {
+	if len(bz) == 1 && bz[0] == 0 {
+		return g.Identity(), nil
+	}
+	coordLen := g.coordLen()
+	if len(bz) != 1+2*coordLen || bz[0] != 4 {
+		return nil, errors.New("generic group: invalid uncompressed point")
+	}
+	return g.NewPoint(new(big.Int).SetBytes(bz[1:1+coordLen]), new(big.Int).SetBytes(bz[1+coordLen:]))
+}
+
+func (g *genericGroup) newPoint(x, y *big.Int) *genericPoint {
+	if x.Sign() == 0 && y.Sign() == 0 {
+		return &genericPoint{g: g}
+	}
+	return &genericPoint{g, x, y}
+}
+
+// ----- //
+
+func (a *genericScalar) Add(b Scalar) Scalar {
+	return a.g.NewScalar(new(big.Int).Add(a.v, b.(*genericScalar).v))
+}
+
+func (a *genericScalar) Sub(b Scalar) Scalar {
+	return a.g.NewScalar(new(big.Int).Sub(a.v, b.(*genericScalar).v))
+}
+
+func (a *genericScalar) Mul(b Scalar) Scalar {
+	return a.g.NewScalar(new(big.Int).Mul(a.v, b.(*genericScalar).v))
+}
+
+func (a *genericScalar) Negate() Scalar {
+	return a.g.NewScalar(new(big.Int).Neg(a.v))
+}
+
+func (a *genericScalar) Invert() Scalar {
+	// a^(n-2) is the inverse, and zero for zero
+	N := a.g.curve.Params().N
+	return &genericScalar{a.g, common.ModInt(N).ExpSecret(a.v, new(big.Int).Sub(N, big.NewInt(2)))}
+}
+
+func (a *genericScalar) Equal(b Scalar) bool {
+	return a.v.Cmp(b.(*genericScalar).v) == 0
+}
+
+func (a *genericScalar) IsZero() bool {
+	return a.v.Sign() == 0
+}
+
+func (a *genericScalar) BigInt() *big.Int {
+	return new(big.Int).Set(a.v)
+}
+
+func (a *genericScalar) Bytes() []byte {
+	return a.v.FillBytes(make([]byte, a.g.scalarLen()))
+}
+
+// ----- //
+
+func (p *genericPoint) Add(q Point) Point {
+	q2 := q.(*genericPoint)
+	switch {
+	case p.IsIdentity():
+		return q2
+	case q2.IsIdentity():
+		return p
+	}
+	return p.g.newPoint(p.g.curve.Add(p.x, p.y, q2.x, q2.y))
+}
+
+func (p *genericPoint) Sub(q Point) Point {
+	return p.Add(q.Negate())
+}
+
+func (p *genericPoint) Negate() Point {
+	if p.IsIdentity() {
+		return p
+	}
+	return &genericPoint{p.g, p.x, new(big.Int).Sub(p.g.curve.Params().P, p.y)}
+}
+
+func (p *genericPoint) ScalarMult(k Scalar) Point {
+	if p.IsIdentity() {
+		return p
+	}
+	return p.g.newPoint(p.g.curve.ScalarMult(p.x, p.y, k.Bytes()))
+}
+
+func (p *genericPoint) ScalarMultSecret(k Scalar) Point {
+	return p.ScalarMult(k)
+}
+
+func (p *genericPoint) Equal(q Point) bool {
+	q2 := q.(*genericPoint)
+	if p.IsIdentity() || q2.IsIdentity() {
+		return p.IsIdentity() && q2.IsIdentity()
+	}
+	return p.x.Cmp(q2.x) == 0 && p.y.Cmp(q2.y) == 0
+}
+
+func (p *genericPoint) IsIdentity() bool {
+	return p.x == nil
+}
+
+func (p *genericPoint) XY() (x, y *big.Int, err error) {
+	if p.IsIdentity() {
+		return nil, nil, errors.New("generic group: the identity has no affine coordinates")
+	}
+	return new(big.Int).Set(p.x), new(big.Int).Set(p.y), nil
+}
+
+// Bytes returns the uncompressed SEC 1 encoding, or a single zero byte for the identity
+func (p *genericPoint) Bytes() []byte {
+	if p.IsIdentity() {
+		return []byte{0}
+	}
+	coordLen := p.g.coordLen()
+	bz := make([]byte, 1+2*coordLen)
+	bz[0] = 4
+	p.x.FillBytes(bz[1 : 1+coordLen])
+	p.y.FillBytes(bz[1+coordLen:])
+	return bz
+}
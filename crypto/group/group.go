@@ -0,0 +1,77 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+// Package group defines the prime-order groups that the protocols run over without going through `elliptic.Curve`,
+// whose generic `Add`/`ScalarMult` are variable-time and deprecated for custom curves.
+// The backends are secp256k1, NIST P-256 and the prime-order subgroup of edwards25519; other curves fall back to a
+// generic group over their `elliptic.Curve` methods, see ForCurve.
+//
+// Scalars and points are immutable: every operation returns a new value. Combining values of different groups panics.
+package group
+
+import (
+	"math/big"
+)
+
+type (
+	// Group is a cyclic group of prime order q with a fixed generator
+	Group interface {
+		// Name returns the name of the group, e.g. "secp256k1"
+		Name() string
+		// Order returns the prime order q of the group
+		Order() *big.Int
+
+		// NewScalar returns v mod q
+		NewScalar(v *big.Int) Scalar
+		// DecodeScalar decodes the canonical encoding returned by `Scalar.Bytes`
+		DecodeScalar(bz []byte) (Scalar, error)
+
+		Identity() Point
+		Generator() Point
+		// ScalarBaseMult returns k*G in variable time; k must be public
+		ScalarBaseMult(k Scalar) Point
+		// ScalarBaseMultSecret returns k*G in constant time with respect to k, for secret shares and nonces
+		ScalarBaseMultSecret(k Scalar) Point
+		// NewPoint returns the point with the affine coordinates (x, y), which must be an element of the group
+		NewPoint(x, y *big.Int) (Point, error)
+		// DecodePoint decodes the canonical encoding returned by `Point.Bytes`, which must be an element of the group
+		DecodePoint(bz []byte) (Point, error)
+	}
+
+	// Scalar is an element of Z_q
+	Scalar interface {
+		Add(b Scalar) Scalar
+		Sub(b Scalar) Scalar
+		Mul(b Scalar) Scalar
+		Negate() Scalar
+		// Invert returns the multiplicative inverse; the inverse of zero is zero
+		Invert() Scalar
+		Equal(b Scalar) bool
+		IsZero() bool
+		BigInt() *big.Int
+		// Bytes returns the canonical fixed-length encoding: big-endian for the Weierstrass curves and little-endian
+		// as in RFC 8032 for edwards25519
+		Bytes() []byte
+	}
+
+	// Point is an element of the group
+	Point interface {
+		Add(q Point) Point
+		Sub(q Point) Point
+		Negate() Point
+		// ScalarMult returns k*P in variable time; k must be public
+		ScalarMult(k Scalar) Point
+		// ScalarMultSecret returns k*P in constant time with respect to k, for secret shares and nonces
+		ScalarMultSecret(k Scalar) Point
+		Equal(q Point) bool
+		IsIdentity() bool
+		// XY returns the affine coordinates; the identity of a Weierstrass curve has none and returns an error
+		XY() (x, y *big.Int, err error)
+		// Bytes returns the canonical encoding: the compressed SEC 1 encoding (a single zero byte for the identity)
+		// for the Weierstrass curves and the RFC 8032 encoding for edwards25519
+		Bytes() []byte
+	}
+)
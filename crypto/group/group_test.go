@@ -0,0 +1,165 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package group_test
+
+import (
+	"crypto/elliptic"
+	"math/big"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/decred/dcrd/dcrec/edwards/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+	. "github.com/binance-chain/tss-lib/crypto/group"
+)
+
+var testCurves = []elliptic.Curve{btcec.S256(), elliptic.P256(), edwards.Edwards()}
+
+// the curves with a backend, and one that falls back to the generic group
+var arithmeticCurves = append(testCurves[:len(testCurves):len(testCurves)], elliptic.P384())
+
+func TestFromCurve(t *testing.T) {
+	for _, curve := range testCurves {
+		g, err := FromCurve(curve)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, g.Order().Cmp(curve.Params().N), g.Name())
+	}
+	_, err := FromCurve(elliptic.P384())
+	assert.Error(t, err)
+
+	g, err := ForCurve(elliptic.P384())
+	assert.NoError(t, err)
+	assert.Equal(t, "P-384", g.Name())
+	g, err = ForCurve(btcec.S256())
+	assert.NoError(t, err)
+	assert.Equal(t, Secp256k1(), g)
+}
+
+func TestScalarArithmetic(t *testing.T) {
+	for _, curve := range arithmeticCurves {
+		g, _ := ForCurve(curve)
+		q := g.Order()
+		modQ := common.ModInt(q)
+		a, b := common.GetRandomPositiveInt(q), common.GetRandomPositiveInt(q)
+		sa, sb := g.NewScalar(a), g.NewScalar(b)
+
+		assert.Equal(t, modQ.Add(a, b), sa.Add(sb).BigInt(), g.Name())
+		assert.Equal(t, modQ.Sub(a, b), sa.Sub(sb).BigInt(), g.Name())
+		assert.Equal(t, modQ.Mul(a, b), sa.Mul(sb).BigInt(), g.Name())
+		assert.Equal(t, modQ.Sub(big.NewInt(0), a), sa.Negate().BigInt(), g.Name())
+		assert.Equal(t, modQ.ModInverse(a), sa.Invert().BigInt(), g.Name())
+		assert.True(t, sa.Sub(sa).IsZero(), g.Name())
+		assert.True(t, g.NewScalar(q).IsZero(), g.Name())
+
+		decoded, err := g.DecodeScalar(sa.Bytes())
+		assert.NoError(t, err, g.Name())
+		assert.True(t, decoded.Equal(sa), g.Name())
+	}
+}
+
+func TestPointArithmetic(t *testing.T) {
+	for _, curve := range arithmeticCurves {
+		g, _ := ForCurve(curve)
+		q := g.Order()
+		a, b := common.GetRandomPositiveInt(q), common.GetRandomPositiveInt(q)
+		sa, sb := g.NewScalar(a), g.NewScalar(b)
+
+		// the points match those computed through elliptic.Curve
+		A := g.ScalarBaseMult(sa)
+		ecA := crypto.ScalarBaseMult(curve, a)
+		x, y, err := A.XY()
+		assert.NoError(t, err, g.Name())
+		assert.Equal(t, ecA.X(), x, g.Name())
+		assert.Equal(t, ecA.Y(), y, g.Name())
+		assert.True(t, g.Generator().ScalarMult(sa).Equal(A), g.Name())
+
+		// (a + b)G == aG + bG and b(aG) == (ab)G
+		B := g.ScalarBaseMult(sb)
+		assert.True(t, g.ScalarBaseMult(sa.Add(sb)).Equal(A.Add(B)), g.Name())
+		assert.True(t, A.ScalarMult(sb).Equal(g.ScalarBaseMult(sa.Mul(sb))), g.Name())
+		assert.True(t, g.ScalarBaseMultSecret(sa).Equal(A), g.Name())
+		assert.True(t, A.ScalarMultSecret(sb).Equal(A.ScalarMult(sb)), g.Name())
+		assert.True(t, A.Sub(A).IsIdentity(), g.Name())
+		assert.True(t, A.Add(A.Negate()).Equal(g.Identity()), g.Name())
+		assert.True(t, A.Add(g.Identity()).Equal(A), g.Name())
+		assert.True(t, g.ScalarBaseMult(g.NewScalar(q)).IsIdentity(), g.Name())
+
+		// encodings round trip
+		decoded, err := g.DecodePoint(A.Bytes())
+		assert.NoError(t, err, g.Name())
+		assert.True(t, decoded.Equal(A), g.Name())
+		decoded, err = g.DecodePoint(g.Identity().Bytes())
+		assert.NoError(t, err, g.Name())
+		assert.True(t, decoded.IsIdentity(), g.Name())
+
+		// and so do ECPoints
+		P, err := ecA.ToGroupPoint(g)
+		assert.NoError(t, err, g.Name())
+		assert.True(t, P.Equal(A), g.Name())
+		ecP, err := crypto.NewECPointFromGroup(curve, P)
+		assert.NoError(t, err, g.Name())
+		assert.True(t, ecP.Equals(ecA), g.Name())
+
+		_, err = g.NewPoint(x, new(big.Int).Add(y, big.NewInt(1)))
+		assert.Error(t, err, g.Name())
+	}
+}
+
+func TestVarTimeMultiScalarMult(t *testing.T) {
+	for _, curve := range arithmeticCurves {
+		g, _ := ForCurve(curve)
+		q := g.Order()
+		// random points, a repeated point, the identity and a zero scalar
+		var scalars []Scalar
+		var points []Point
+		for k := 0; k < 6; k++ {
+			scalars = append(scalars, g.NewScalar(common.GetRandomPositiveInt(q)))
+			points = append(points, g.ScalarBaseMult(g.NewScalar(common.GetRandomPositiveInt(q))))
+		}
+		scalars = append(scalars, g.NewScalar(common.GetRandomPositiveInt(q)), g.NewScalar(big.NewInt(3)), g.NewScalar(big.NewInt(0)))
+		points = append(points, points[0], g.Identity(), points[1])
+
+		expected := g.Identity()
+		for k := range scalars {
+			expected = expected.Add(points[k].ScalarMult(scalars[k]))
+		}
+		sum, err := VarTimeMultiScalarMult(g, scalars, points)
+		assert.NoError(t, err, g.Name())
+		assert.True(t, sum.Equal(expected), g.Name())
+
+		// the terms may cancel out
+		sum, err = VarTimeMultiScalarMult(g, []Scalar{scalars[0], scalars[0].Negate()}, []Point{points[0], points[0]})
+		assert.NoError(t, err, g.Name())
+		assert.True(t, sum.IsIdentity(), g.Name())
+
+		sum, err = VarTimeMultiScalarMult(g, nil, nil)
+		assert.NoError(t, err, g.Name())
+		assert.True(t, sum.IsIdentity(), g.Name())
+
+		_, err = VarTimeMultiScalarMult(g, scalars, points[1:])
+		assert.Error(t, err, g.Name())
+	}
+}
+
+func TestEd25519RejectsTorsion(t *testing.T) {
+	g := Ed25519()
+	// (0, -1) has order 2
+	p := edwards.Edwards().Params().P
+	_, err := g.NewPoint(big.NewInt(0), new(big.Int).Sub(p, big.NewInt(1)))
+	assert.Error(t, err)
+
+	// a point of the prime-order subgroup plus a torsion point is rejected too
+	A := crypto.ScalarBaseMult(edwards.Edwards(), big.NewInt(5))
+	x, y := edwards.Edwards().Add(A.X(), A.Y(), big.NewInt(0), new(big.Int).Sub(p, big.NewInt(1)))
+	_, err = g.NewPoint(x, y)
+	assert.Error(t, err)
+	_, err = g.NewPoint(A.X(), A.Y())
+	assert.NoError(t, err)
+}
@@ -0,0 +1,90 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package group
+
+import (
+	"errors"
+
+	"filippo.io/edwards25519"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+// varTimeMultiScalarMulter is implemented by the groups that have a faster multi-scalar multiplication than a sum of
+// scalar multiplications
+type varTimeMultiScalarMulter interface {
+	varTimeMultiScalarMult(scalars []Scalar, points []Point) Point
+}
+
+// VarTimeMultiScalarMult returns scalars[0]*points[0] + ... + scalars[n-1]*points[n-1].
+// It runs in variable time and must only be used with public values, e.g. to verify proofs in a batch.
+func VarTimeMultiScalarMult(g Group, scalars []Scalar, points []Point) (Point, error) {
+	if len(scalars) != len(points) {
+		return nil, errors.New("VarTimeMultiScalarMult: the numbers of scalars and points differ")
+	}
+	if m, ok := g.(varTimeMultiScalarMulter); ok {
+		return m.varTimeMultiScalarMult(scalars, points), nil
+	}
+	sum := g.Identity()
+	for k := range scalars {
+		sum = sum.Add(points[k].ScalarMult(scalars[k]))
+	}
+	return sum, nil
+}
+
+// ----- //
+
+// secp256k1MSMWindow is the window width in bits of the Straus multi-scalar multiplication
+const secp256k1MSMWindow = 4
+
+// varTimeMultiScalarMult interleaves the scalar multiplications (Straus' method) so that all the points share one
+// chain of doublings, with a table of the first 2^w - 1 multiples of each point
+func (secp256k1Group) varTimeMultiScalarMult(scalars []Scalar, points []Point) Point {
+	const tableLen = 1<<secp256k1MSMWindow - 1
+	tables := make([][tableLen]secp256k1.JacobianPoint, len(points))
+	digits := make([][32]byte, len(scalars))
+	for k := range points {
+		tables[k][0].Set(&points[k].(*secp256k1Point).p)
+		for d := 1; d < tableLen; d++ {
+			secp256k1.AddNonConst(&tables[k][d-1], &tables[k][0], &tables[k][d])
+		}
+		digits[k] = scalars[k].(*secp256k1Scalar).s.Bytes()
+	}
+	// the dcrd formulas do not document whether the result may alias an input, so acc and next alternate
+	var acc, next secp256k1.JacobianPoint
+	for pos := 0; pos < 64; pos++ {
+		for d := 0; d < secp256k1MSMWindow; d++ {
+			secp256k1.DoubleNonConst(&acc, &next)
+			acc.Set(&next)
+		}
+		for k := range digits {
+			nibble := digits[k][pos/2]
+			if pos%2 == 0 {
+				nibble >>= 4
+			}
+			if nibble &= 0x0f; nibble != 0 {
+				secp256k1.AddNonConst(&acc, &tables[k][nibble-1], &next)
+				acc.Set(&next)
+			}
+		}
+	}
+	return (&secp256k1Point{acc}).affine()
+}
+
+func (ed25519Group) varTimeMultiScalarMult(scalars []Scalar, points []Point) Point {
+	ss := make([]*edwards25519.Scalar, len(scalars))
+	ps := make([]*edwards25519.Point, len(points))
+	for k := range scalars {
+		ss[k], ps[k] = &scalars[k].(*ed25519Scalar).s, &points[k].(*ed25519Point).p
+	}
+	r := new(ed25519Point)
+	if len(ps) == 0 {
+		r.p.Set(edwards25519.NewIdentityPoint())
+		return r
+	}
+	r.p.VarTimeMultiScalarMult(ss, ps)
+	return r
+}
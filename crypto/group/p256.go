@@ -0,0 +1,207 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package group
+
+import (
+	"crypto/elliptic"
+	"errors"
+	"math/big"
+
+	"github.com/binance-chain/tss-lib/common"
+)
+
+// The standard library's P-256 is backed by a constant-time implementation; its `elliptic.Curve` methods are
+// only deprecated for custom curves, so they are used directly here. Scalars are inverted with `ExpSecret`.
+
+type (
+	p256Group struct{}
+
+	p256Scalar struct {
+		v *big.Int
+	}
+
+	// p256Point has nil coordinates for the identity
+	p256Point struct {
+		x, y *big.Int
+	}
+)
+
+const (
+	p256ScalarLen = 32
+)
+
+// P256 returns the group of the NIST P-256 curve
+func P256() Group {
+	return p256Group{}
+}
+
+func (p256Group) Name() string {
+	return "P-256"
+}
+
+func (p256Group) Order() *big.Int {
+	return new(big.Int).Set(elliptic.P256().Params().N)
+}
+
+func (p256Group) NewScalar(v *big.Int) Scalar {
+	return &p256Scalar{new(big.Int).Mod(v, elliptic.P256().Params().N)}
+}
+
+func (p256Group) DecodeScalar(bz []byte) (Scalar, error) {
+	if len(bz) != p256ScalarLen {
+		return nil, errors.New("P-256: a scalar must be 32 bytes")
+	}
+	v := new(big.Int).SetBytes(bz)
+	if v.Cmp(elliptic.P256().Params().N) >= 0 {
+		return nil, errors.New("P-256: the scalar is not reduced")
+	}
+	return &p256Scalar{v}, nil
+}
+
+func (p256Group) Identity() Point {
+	return new(p256Point)
+}
+
+func (p256Group) Generator() Point {
+	params := elliptic.P256().Params()
+	return &p256Point{new(big.Int).Set(params.Gx), new(big.Int).Set(params.Gy)}
+}
+
+func (p256Group) ScalarBaseMult(k Scalar) Point {
+	return newP256Point(elliptic.P256().ScalarBaseMult(k.Bytes()))
+}
+
+// ScalarBaseMultSecret is ScalarBaseMult, as the standard library's P-256 is constant-time
+func (g p256Group) ScalarBaseMultSecret(k Scalar) Point {
+	return g.ScalarBaseMult(k)
+}
+
+func (p256Group) NewPoint(x, y *big.Int) (Point, error) {
+	if x == nil || y == nil || !elliptic.P256().IsOnCurve(x, y) {
+		return nil, errors.New("P-256: the point is not on the curve")
+	}
+	return &p256Point{new(big.Int).Set(x), new(big.Int).Set(y)}, nil
+}
+
+func (p256Group) DecodePoint(bz []byte) (Point, error) {
+	if len(bz) == 1 && bz[0] == 0 {
+		return new(p256Point), nil
+	}
+	x, y := elliptic.UnmarshalCompressed(elliptic.P256(), bz)
+	if x == nil {
+		return nil, errors.New("P-256: invalid compressed point")
+	}
+	return &p256Point{x, y}, nil
+}
+
+// ----- //
+
+func (a *p256Scalar) Add(b Scalar) Scalar {
+	return P256().NewScalar(new(big.Int).Add(a.v, b.(*p256Scalar).v))
+}
+
+func (a *p256Scalar) Sub(b Scalar) Scalar {
+	return P256().NewScalar(new(big.Int).Sub(a.v, b.(*p256Scalar).v))
+}
+
+func (a *p256Scalar) Mul(b Scalar) Scalar {
+	return P256().NewScalar(new(big.Int).Mul(a.v, b.(*p256Scalar).v))
+}
+
+func (a *p256Scalar) Negate() Scalar {
+	return P256().NewScalar(new(big.Int).Neg(a.v))
+}
+
+func (a *p256Scalar) Invert() Scalar {
+	// a^(n-2) is the inverse, and zero for zero
+	N := elliptic.P256().Params().N
+	return &p256Scalar{common.ModInt(N).ExpSecret(a.v, new(big.Int).Sub(N, big.NewInt(2)))}
+}
+
+func (a *p256Scalar) Equal(b Scalar) bool {
+	return a.v.Cmp(b.(*p256Scalar).v) == 0
+}
+
+func (a *p256Scalar) IsZero() bool {
+	return a.v.Sign() == 0
+}
+
+func (a *p256Scalar) BigInt() *big.Int {
+	return new(big.Int).Set(a.v)
+}
+
+func (a *p256Scalar) Bytes() []byte {
+	return a.v.FillBytes(make([]byte, p256ScalarLen))
+}
+
+// ----- //
+
+func newP256Point(x, y *big.Int) *p256Point {
+	if x.Sign() == 0 && y.Sign() == 0 {
+		return new(p256Point)
+	}
+	return &p256Point{x, y}
+}
+
+func (p *p256Point) Add(q Point) Point {
+	q2 := q.(*p256Point)
+	switch {
+	case p.IsIdentity():
+		return q2
+	case q2.IsIdentity():
+		return p
+	}
+	return newP256Point(elliptic.P256().Add(p.x, p.y, q2.x, q2.y))
+}
+
+func (p *p256Point) Sub(q Point) Point {
+	return p.Add(q.Negate())
+}
+
+func (p *p256Point) Negate() Point {
+	if p.IsIdentity() {
+		return p
+	}
+	return &p256Point{p.x, new(big.Int).Sub(elliptic.P256().Params().P, p.y)}
+}
+
+func (p *p256Point) ScalarMult(k Scalar) Point {
+	if p.IsIdentity() {
+		return p
+	}
+	return newP256Point(elliptic.P256().ScalarMult(p.x, p.y, k.Bytes()))
+}
+
+func (p *p256Point) ScalarMultSecret(k Scalar) Point {
+	return p.ScalarMult(k)
+}
+
+func (p *p256Point) Equal(q Point) bool {
+	q2 := q.(*p256Point)
+	if p.IsIdentity() || q2.IsIdentity() {
+		return p.IsIdentity() && q2.IsIdentity()
+	}
+	return p.x.Cmp(q2.x) == 0 && p.y.Cmp(q2.y) == 0
+}
+
+func (p *p256Point) IsIdentity() bool {
+	return p.x == nil
+}
+
+func (p *p256Point) XY() (x, y *big.Int, err error) {
+	if p.IsIdentity() {
+		return nil, nil, errors.New("P-256: the identity has no affine coordinates")
+	}
+	return new(big.Int).Set(p.x), new(big.Int).Set(p.y), nil
+}
+
+func (p *p256Point) Bytes() []byte {
+	if p.IsIdentity() {
+		return []byte{0}
+	}
+	return elliptic.MarshalCompressed(elliptic.P256(), p.x, p.y)
+}
@@ -0,0 +1,231 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package group
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+type (
+	secp256k1Group struct{}
+
+	secp256k1Scalar struct {
+		s secp256k1.ModNScalar
+	}
+
+	// secp256k1Point is kept normalized in affine coordinates (Z = 1), or all zero for the identity
+	secp256k1Point struct {
+		p secp256k1.JacobianPoint
+	}
+)
+
+var (
+	secp256k1Order = secp256k1.S256().N
+
+	secp256k1Generator = func() (g secp256k1.JacobianPoint) {
+		params := secp256k1.S256()
+		g.X.SetByteSlice(params.Gx.Bytes())
+		g.Y.SetByteSlice(params.Gy.Bytes())
+		g.Z.SetInt(1)
+		return
+	}()
+)
+
+// Secp256k1 returns the group of the secp256k1 curve. Scalar multiplication and inversion run in constant time.
+func Secp256k1() Group {
+	return secp256k1Group{}
+}
+
+func (secp256k1Group) Name() string {
+	return "secp256k1"
+}
+
+func (secp256k1Group) Order() *big.Int {
+	return new(big.Int).Set(secp256k1Order)
+}
+
+func (secp256k1Group) NewScalar(v *big.Int) Scalar {
+	r := new(secp256k1Scalar)
+	r.s.SetByteSlice(new(big.Int).Mod(v, secp256k1Order).Bytes())
+	return r
+}
+
+func (secp256k1Group) DecodeScalar(bz []byte) (Scalar, error) {
+	if len(bz) != 32 {
+		return nil, errors.New("secp256k1: a scalar must be 32 bytes")
+	}
+	r := new(secp256k1Scalar)
+	if overflow := r.s.SetByteSlice(bz); overflow {
+		return nil, errors.New("secp256k1: the scalar is not reduced")
+	}
+	return r, nil
+}
+
+func (secp256k1Group) Identity() Point {
+	return new(secp256k1Point)
+}
+
+func (secp256k1Group) Generator() Point {
+	return &secp256k1Point{secp256k1Generator}
+}
+
+func (secp256k1Group) ScalarBaseMult(k Scalar) Point {
+	r := new(secp256k1Point)
+	secp256k1.ScalarBaseMultNonConst(&k.(*secp256k1Scalar).s, &r.p)
+	return r.affine()
+}
+
+func (secp256k1Group) ScalarBaseMultSecret(k Scalar) Point {
+	return &secp256k1Point{ladderScalarMult(&k.(*secp256k1Scalar).s, &secp256k1Generator)}
+}
+
+func (secp256k1Group) NewPoint(x, y *big.Int) (Point, error) {
+	if x == nil || y == nil || x.Sign() < 0 || y.Sign() < 0 || x.BitLen() > 256 || y.BitLen() > 256 {
+		return nil, errors.New("secp256k1: invalid coordinates")
+	}
+	r := new(secp256k1Point)
+	if r.p.X.SetByteSlice(x.Bytes()) || r.p.Y.SetByteSlice(y.Bytes()) {
+		return nil, errors.New("secp256k1: the coordinates are not reduced")
+	}
+	r.p.Z.SetInt(1)
+	if !secp256k1.NewPublicKey(&r.p.X, &r.p.Y).IsOnCurve() {
+		return nil, errors.New("secp256k1: the point is not on the curve")
+	}
+	return r, nil
+}
+
+func (secp256k1Group) DecodePoint(bz []byte) (Point, error) {
+	if len(bz) == 1 && bz[0] == 0 {
+		return new(secp256k1Point), nil
+	}
+	if len(bz) != secp256k1.PubKeyBytesLenCompressed {
+		return nil, errors.New("secp256k1: a point must be compressed")
+	}
+	pk, err := secp256k1.ParsePubKey(bz)
+	if err != nil {
+		return nil, err
+	}
+	r := new(secp256k1Point)
+	pk.AsJacobian(&r.p)
+	return r, nil
+}
+
+// ----- //
+
+func (a *secp256k1Scalar) Add(b Scalar) Scalar {
+	r := new(secp256k1Scalar)
+	r.s.Add2(&a.s, &b.(*secp256k1Scalar).s)
+	return r
+}
+
+func (a *secp256k1Scalar) Sub(b Scalar) Scalar {
+	return a.Add(b.Negate())
+}
+
+func (a *secp256k1Scalar) Mul(b Scalar) Scalar {
+	r := new(secp256k1Scalar)
+	r.s.Mul2(&a.s, &b.(*secp256k1Scalar).s)
+	return r
+}
+
+func (a *secp256k1Scalar) Negate() Scalar {
+	r := new(secp256k1Scalar)
+	r.s.NegateVal(&a.s)
+	return r
+}
+
+func (a *secp256k1Scalar) Invert() Scalar {
+	return &secp256k1Scalar{invertScalar(&a.s)}
+}
+
+func (a *secp256k1Scalar) Equal(b Scalar) bool {
+	return a.s.Equals(&b.(*secp256k1Scalar).s)
+}
+
+func (a *secp256k1Scalar) IsZero() bool {
+	return a.s.IsZero()
+}
+
+func (a *secp256k1Scalar) BigInt() *big.Int {
+	bz := a.s.Bytes()
+	return new(big.Int).SetBytes(bz[:])
+}
+
+func (a *secp256k1Scalar) Bytes() []byte {
+	bz := a.s.Bytes()
+	return bz[:]
+}
+
+// ----- //
+
+func (p *secp256k1Point) Add(q Point) Point {
+	r := new(secp256k1Point)
+	secp256k1.AddNonConst(&p.p, &q.(*secp256k1Point).p, &r.p)
+	return r.affine()
+}
+
+func (p *secp256k1Point) Sub(q Point) Point {
+	return p.Add(q.Negate())
+}
+
+func (p *secp256k1Point) Negate() Point {
+	r := new(secp256k1Point)
+	r.p.Set(&p.p)
+	if !p.IsIdentity() {
+		r.p.Y.Negate(1).Normalize()
+	}
+	return r
+}
+
+func (p *secp256k1Point) ScalarMult(k Scalar) Point {
+	r := new(secp256k1Point)
+	secp256k1.ScalarMultNonConst(&k.(*secp256k1Scalar).s, &p.p, &r.p)
+	return r.affine()
+}
+
+func (p *secp256k1Point) ScalarMultSecret(k Scalar) Point {
+	return &secp256k1Point{ladderScalarMult(&k.(*secp256k1Scalar).s, &p.p)}
+}
+
+func (p *secp256k1Point) Equal(q Point) bool {
+	q2 := q.(*secp256k1Point)
+	if p.IsIdentity() || q2.IsIdentity() {
+		return p.IsIdentity() && q2.IsIdentity()
+	}
+	return p.p.X.Equals(&q2.p.X) && p.p.Y.Equals(&q2.p.Y)
+}
+
+func (p *secp256k1Point) IsIdentity() bool {
+	return p.p.Z.IsZero() || (p.p.X.IsZero() && p.p.Y.IsZero())
+}
+
+func (p *secp256k1Point) XY() (x, y *big.Int, err error) {
+	if p.IsIdentity() {
+		return nil, nil, errors.New("secp256k1: the identity has no affine coordinates")
+	}
+	return new(big.Int).SetBytes(p.p.X.Bytes()[:]), new(big.Int).SetBytes(p.p.Y.Bytes()[:]), nil
+}
+
+func (p *secp256k1Point) Bytes() []byte {
+	if p.IsIdentity() {
+		return []byte{0}
+	}
+	return secp256k1.NewPublicKey(&p.p.X, &p.p.Y).SerializeCompressed()
+}
+
+// affine converts the point to affine coordinates in place
+func (p *secp256k1Point) affine() *secp256k1Point {
+	if p.IsIdentity() {
+		p.p = secp256k1.JacobianPoint{}
+		return p
+	}
+	p.p.ToAffine()
+	return p
+}
@@ -0,0 +1,137 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package group
+
+import (
+	"crypto/subtle"
+	"math/big"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+// The secp256k1 package only offers variable-time scalar multiplication, so secret scalars go through the
+// Montgomery ladder below. It uses the complete addition formulas for a = 0 of Renes, Costello and Batina
+// ("Complete addition formulas for prime order elliptic curves", algorithm 7), which also double and handle the
+// identity, so that every step runs the same field operations whatever the bits of the scalar.
+
+// secp256k1B3 is 3*b for y^2 = x^3 + 7
+const secp256k1B3 = 21
+
+var secp256k1OrderMinusTwo = new(big.Int).Sub(secp256k1Order, big.NewInt(2)).Bytes()
+
+// projectivePoint is a point in projective coordinates (X : Y : Z); the identity is (0 : 1 : 0).
+// The coordinates are kept normalized.
+type projectivePoint struct {
+	x, y, z secp256k1.FieldVal
+}
+
+// add sets r = p + q and returns r; r may alias p or q
+func (r *projectivePoint) add(p, q *projectivePoint) *projectivePoint {
+	var t0, t1, t2, t3, t4, x3, y3, z3 secp256k1.FieldVal
+	t0.Mul2(&p.x, &q.x)
+	t1.Mul2(&p.y, &q.y)
+	t2.Mul2(&p.z, &q.z)
+	t3.Add2(&p.x, &p.y)
+	t4.Add2(&q.x, &q.y)
+	t3.Mul(&t4)
+	t4.Add2(&t0, &t1)
+	fieldSub(&t3, &t3, &t4)
+	t4.Add2(&p.y, &p.z)
+	x3.Add2(&q.y, &q.z)
+	t4.Mul(&x3)
+	x3.Add2(&t1, &t2)
+	fieldSub(&t4, &t4, &x3)
+	x3.Add2(&p.x, &p.z)
+	y3.Add2(&q.x, &q.z)
+	x3.Mul(&y3)
+	y3.Add2(&t0, &t2)
+	fieldSub(&y3, &x3, &y3)
+	x3.Add2(&t0, &t0)
+	t0.Add(&x3).Normalize()
+	t2.MulInt(secp256k1B3).Normalize()
+	z3.Add2(&t1, &t2).Normalize()
+	fieldSub(&t1, &t1, &t2)
+	y3.MulInt(secp256k1B3).Normalize()
+	x3.Mul2(&t4, &y3)
+	t2.Mul2(&t3, &t1)
+	fieldSub(&x3, &t2, &x3)
+	y3.Mul(&t0)
+	t1.Mul(&z3)
+	y3.Add(&t1).Normalize()
+	t0.Mul(&t3)
+	z3.Mul(&t4)
+	z3.Add(&t0).Normalize()
+	r.x, r.y, r.z = x3, y3, z3
+	return r
+}
+
+// fieldSub sets r = a - b for normalized a and b, and normalizes r
+func fieldSub(r, a, b *secp256k1.FieldVal) {
+	var nb secp256k1.FieldVal
+	nb.NegateVal(b.Normalize(), 1)
+	r.Add2(a.Normalize(), &nb).Normalize()
+}
+
+// conditionalSwap swaps p and q if swap is 1 and leaves them untouched if it is 0, in constant time
+func conditionalSwap(p, q *projectivePoint, swap int) {
+	for _, pair := range [][2]*secp256k1.FieldVal{{&p.x, &q.x}, {&p.y, &q.y}, {&p.z, &q.z}} {
+		a, b := pair[0].Bytes(), pair[1].Bytes()
+		a2, b2 := *a, *b
+		subtle.ConstantTimeCopy(swap, a2[:], b[:])
+		subtle.ConstantTimeCopy(swap, b2[:], a[:])
+		pair[0].SetBytes(&a2)
+		pair[1].SetBytes(&b2)
+	}
+}
+
+// ladderScalarMult returns k*P in constant time with respect to k
+func ladderScalarMult(k *secp256k1.ModNScalar, P *secp256k1.JacobianPoint) secp256k1.JacobianPoint {
+	var r0, r1 projectivePoint
+	r0.y.SetInt(1)
+	// the input is either the identity or affine, so (X : Y : Z) is also its projective form
+	r1.x.Set(&P.X).Normalize()
+	r1.y.Set(&P.Y).Normalize()
+	r1.z.Set(&P.Z).Normalize()
+	if r1.z.IsZero() {
+		r1 = r0
+	}
+
+	bz := k.Bytes()
+	for i := 0; i < 256; i++ {
+		bit := int(bz[i/8]>>(7-uint(i%8))) & 1
+		conditionalSwap(&r0, &r1, bit)
+		r1.add(&r0, &r1)
+		r0.add(&r0, &r0)
+		conditionalSwap(&r0, &r1, bit)
+	}
+
+	var result secp256k1.JacobianPoint
+	if r0.z.IsZero() {
+		return result
+	}
+	var zInv secp256k1.FieldVal
+	zInv.Set(&r0.z).Inverse()
+	result.X.Mul2(&r0.x, &zInv).Normalize()
+	result.Y.Mul2(&r0.y, &zInv).Normalize()
+	result.Z.SetInt(1)
+	return result
+}
+
+// invertScalar returns k^-1 = k^(n-2) mod n; the exponent is public, so its bits may be branched on
+func invertScalar(k *secp256k1.ModNScalar) secp256k1.ModNScalar {
+	var r secp256k1.ModNScalar
+	r.SetInt(1)
+	for _, b := range secp256k1OrderMinusTwo {
+		for i := 7; i >= 0; i-- {
+			r.Square()
+			if (b>>uint(i))&1 == 1 {
+				r.Mul(k)
+			}
+		}
+	}
+	return r
+}
@@ -0,0 +1,45 @@
+//go:build timing
+// +build timing
+
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package group_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/binance-chain/tss-lib/common"
+	. "github.com/binance-chain/tss-lib/crypto/group"
+	"github.com/binance-chain/tss-lib/test"
+)
+
+func TestScalarMultTiming(t *testing.T) {
+	for _, curve := range testCurves {
+		g, _ := FromCurve(curve)
+		P := g.ScalarBaseMult(g.NewScalar(common.GetRandomPositiveInt(g.Order())))
+		// the scalar one against random scalars
+		prepare := func(class int) Scalar {
+			if class == 1 {
+				return g.NewScalar(common.GetRandomPositiveInt(g.Order()))
+			}
+			return g.NewScalar(big.NewInt(1))
+		}
+		tStat := test.TimingLeak(1000, func(class int) func() {
+			k := prepare(class)
+			return func() { g.ScalarBaseMultSecret(k) }
+		})
+		assert.True(t, tStat < test.TimingLeakThreshold, "%s ScalarBaseMultSecret: t = %f", g.Name(), tStat)
+		tStat = test.TimingLeak(1000, func(class int) func() {
+			k := prepare(class)
+			return func() { P.ScalarMultSecret(k) }
+		})
+		assert.True(t, tStat < test.TimingLeakThreshold, "%s ScalarMultSecret: t = %f", g.Name(), tStat)
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+// Knowledge of exponent vs Paillier encryption proof (Πlog*) from CGGMP21, figure 25, made non-interactive with
+// Fiat-Shamir.
+
+// It proves that the Paillier ciphertext C = enc(x; rho) and the point X = x*G share the same plaintext x, which
+// lies in ±2^(ℓ+ε). G may be any point. The proof is computed against the verifier's ring-Pedersen parameters
+// (NHat, s, t), i.e. its NTilde, h1 and h2, so a prover produces one proof per verifier.
+
+package logstarproof
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+	"github.com/binance-chain/tss-lib/crypto/paillier"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+const (
+	l   = 256 // ℓ
+	eps = 512 // ε
+
+	// ProofBytesParts is the length of a serialized Proof
+	ProofBytesParts = 8
+)
+
+type (
+	Proof struct {
+		S, A          *big.Int
+		Y             *crypto.ECPoint
+		D, Z1, Z2, Z3 *big.Int
+	}
+)
+
+var one = big.NewInt(1)
+
+// NewProof proves that C encrypts x under `pk` with randomness rho and that X = x*G, to the verifier with the
+// ring-Pedersen parameters (NHat, s, t). `session` binds the proof to its context and must be given to Verify as well.
+func NewProof(pk *paillier.PublicKey, C *big.Int, X, G *crypto.ECPoint, x, rho, NHat, s, t, session *big.Int) (*Proof, error) {
+	if pk == nil || C == nil || X == nil || G == nil || x == nil || rho == nil || NHat == nil || s == nil || t == nil {
+		return nil, errors.New("logstarproof.NewProof received nil value(s)")
+	}
+	N0 := pk.N
+	NSquare := pk.NSquare()
+	q := tss.EC().Params().N
+	lN := new(big.Int).Lsh(NHat, l)
+
+	alpha := common.GetRandomSymmetricInt(new(big.Int).Lsh(one, l+eps))
+	mu := common.GetRandomSymmetricInt(lN)
+	r := common.GetRandomPositiveRelativelyPrimeInt(N0)
+	gamma := common.GetRandomSymmetricInt(new(big.Int).Lsh(lN, eps))
+	defer common.Zeroize(alpha, mu, r, gamma)
+
+	modNHat, modNSquare := common.ModInt(NHat), common.ModInt(NSquare)
+	pedersen := func(a, b *big.Int) *big.Int { // s^a t^b
+		return modNHat.Mul(modNHat.ExpSecret(s, a), modNHat.ExpSecret(t, b))
+	}
+	pf := &Proof{
+		S: pedersen(x, mu),
+		A: modNSquare.Mul(pk.ExpGamma(alpha), modNSquare.ExpSecret(r, N0)),
+		Y: G.ScalarMultSecret(new(big.Int).Mod(alpha, q)),
+		D: pedersen(alpha, gamma),
+	}
+	e := challenge(pk, C, X, G, NHat, s, t, session, pf)
+	pf.Z1 = new(big.Int).Add(alpha, new(big.Int).Mul(e, x))
+	pf.Z2 = common.ModInt(N0).Mul(r, common.ModInt(N0).ExpSecret(rho, e))
+	pf.Z3 = new(big.Int).Add(gamma, new(big.Int).Mul(e, mu))
+	return pf, nil
+}
+
+// Verify checks the proof for C under `pk`, X and G with the verifier's own ring-Pedersen parameters (NHat, s, t)
+func (pf *Proof) Verify(pk *paillier.PublicKey, C *big.Int, X, G *crypto.ECPoint, NHat, s, t, session *big.Int) bool {
+	if pf == nil || pk == nil || pk.N == nil || C == nil || X == nil || G == nil || NHat == nil || s == nil || t == nil {
+		return false
+	}
+	if pf.Y == nil || !pf.Y.ValidateBasic() || pf.Z1 == nil || pf.Z3 == nil {
+		return false
+	}
+	N0 := pk.N
+	NSquare := pk.NSquare()
+	if !common.IsNumberInMultiplicativeGroup(NHat, pf.S) || !common.IsNumberInMultiplicativeGroup(NHat, pf.D) ||
+		!common.IsNumberInMultiplicativeGroup(NSquare, pf.A) || !common.IsNumberInMultiplicativeGroup(NSquare, C) ||
+		!common.IsNumberInMultiplicativeGroup(N0, pf.Z2) {
+		return false
+	}
+	// range check: z1 in ±2^(ℓ+ε)
+	if new(big.Int).Abs(pf.Z1).Cmp(new(big.Int).Lsh(one, l+eps)) > 0 {
+		return false
+	}
+	q := tss.EC().Params().N
+	e := challenge(pk, C, X, G, NHat, s, t, session, pf)
+
+	// (1 + N0)^z1 * z2^N0 = A * C^e mod N0^2
+	modNSquare := common.ModInt(NSquare)
+	lhs := modNSquare.Mul(pk.ExpGamma(pf.Z1), modNSquare.Exp(pf.Z2, N0))
+	if lhs.Cmp(modNSquare.Mul(pf.A, modNSquare.Exp(C, e))) != 0 {
+		return false
+	}
+	// z1*G = Y + e*X
+	rhs, err := pf.Y.Add(X.ScalarMult(e))
+	if err != nil || !G.ScalarMult(new(big.Int).Mod(pf.Z1, q)).Equals(rhs) {
+		return false
+	}
+	// s^z1 t^z3 = D * S^e mod NHat
+	modNHat := common.ModInt(NHat)
+	sZ1, tZ3 := modNHat.Exp(s, pf.Z1), modNHat.Exp(t, pf.Z3)
+	if sZ1 == nil || tZ3 == nil {
+		return false
+	}
+	return modNHat.Mul(sZ1, tZ3).Cmp(modNHat.Mul(pf.D, modNHat.Exp(pf.S, e))) == 0
+}
+
+// Serialize encodes the proof's values in order; the signed ones are prefixed with a sign byte.
+// Missing values are encoded as empty parts, which fail to validate.
+func (pf *Proof) Serialize() [][]byte {
+	var yX, yY *big.Int
+	if pf.Y != nil {
+		yX, yY = pf.Y.X(), pf.Y.Y()
+	}
+	return [][]byte{
+		bytesOrEmpty(pf.S), bytesOrEmpty(pf.A), bytesOrEmpty(yX), bytesOrEmpty(yY), bytesOrEmpty(pf.D),
+		common.SignedIntToBytes(pf.Z1), bytesOrEmpty(pf.Z2), common.SignedIntToBytes(pf.Z3),
+	}
+}
+
+func UnmarshalProof(bzs [][]byte) (*Proof, error) {
+	if len(bzs) != ProofBytesParts {
+		return nil, fmt.Errorf("UnmarshalProof expected %d parts but got %d", ProofBytesParts, len(bzs))
+	}
+	Y, err := crypto.NewECPoint(tss.EC(), new(big.Int).SetBytes(bzs[2]), new(big.Int).SetBytes(bzs[3]))
+	if err != nil {
+		return nil, err
+	}
+	Z1, err := common.SignedBytesToInt(bzs[5])
+	if err != nil {
+		return nil, err
+	}
+	Z3, err := common.SignedBytesToInt(bzs[7])
+	if err != nil {
+		return nil, err
+	}
+	return &Proof{
+		S:  new(big.Int).SetBytes(bzs[0]),
+		A:  new(big.Int).SetBytes(bzs[1]),
+		Y:  Y,
+		D:  new(big.Int).SetBytes(bzs[4]),
+		Z1: Z1,
+		Z2: new(big.Int).SetBytes(bzs[6]),
+		Z3: Z3,
+	}, nil
+}
+
+// ----- utils
+
+func challenge(pk *paillier.PublicKey, C *big.Int, X, G *crypto.ECPoint, NHat, s, t, session *big.Int, pf *Proof) *big.Int {
+	if session == nil {
+		session = big.NewInt(0)
+	}
+	eHash := common.SHA512_256i(pk.N, C, X.X(), X.Y(), G.X(), G.Y(), NHat, s, t, session,
+		pf.S, pf.A, pf.Y.X(), pf.Y.Y(), pf.D)
+	return common.RejectionSample(tss.EC().Params().N, eHash)
+}
+
+func bytesOrEmpty(v *big.Int) []byte {
+	if v == nil {
+		return []byte{}
+	}
+	return v.Bytes()
+}
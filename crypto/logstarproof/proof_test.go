@@ -0,0 +1,52 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package logstarproof_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+	. "github.com/binance-chain/tss-lib/crypto/logstarproof"
+	"github.com/binance-chain/tss-lib/ecdsa/keygen"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+func TestLogStarProof(t *testing.T) {
+	q := tss.EC().Params().N
+	pk, _, err := keygen.LoadKeygenTestFixtures(1)
+	assert.NoError(t, err)
+	NHat, s, tt, err := keygen.LoadNTildeH1H2FromTestFixture(1)
+	assert.NoError(t, err)
+	paiPK := &pk[0].PaillierSK.PublicKey
+	session := big.NewInt(42)
+
+	x := common.GetRandomPositiveInt(q)
+	C, rho, err := paiPK.EncryptAndReturnRandomness(x)
+	assert.NoError(t, err)
+	G := crypto.ScalarBaseMult(tss.EC(), common.GetRandomPositiveInt(q))
+	X := G.ScalarMult(x)
+
+	pf, err := NewProof(paiPK, C, X, G, x, rho, NHat, s, tt, session)
+	assert.NoError(t, err)
+	assert.True(t, pf.Verify(paiPK, C, X, G, NHat, s, tt, session))
+	assert.False(t, pf.Verify(paiPK, C, X, G, NHat, s, tt, big.NewInt(43)), "the proof is bound to the session")
+
+	pf2, err := UnmarshalProof(pf.Serialize())
+	assert.NoError(t, err)
+	assert.True(t, pf2.Verify(paiPK, C, X, G, NHat, s, tt, session))
+
+	// X does not match the plaintext of C
+	X2 := G.ScalarMult(new(big.Int).Add(x, big.NewInt(1)))
+	assert.False(t, pf.Verify(paiPK, C, X2, G, NHat, s, tt, session))
+	pf3, err := NewProof(paiPK, C, X2, G, x, rho, NHat, s, tt, session)
+	assert.NoError(t, err)
+	assert.False(t, pf3.Verify(paiPK, C, X2, G, NHat, s, tt, session))
+}
@@ -61,37 +61,37 @@ func ProveBobWC(pk *paillier.PublicKey, NTilde, h1, h2, c1, c2, x, y, r *big.Int
 	rhoPrm := common.GetRandomPositiveInt(q3NTilde)
 
 	// 4.
-	beta := common.GetRandomPositiveRelativelyPrimeInt(pk.N)
+	beta, betaN := pk.Randomness()
 	gamma := common.GetRandomPositiveRelativelyPrimeInt(pk.N)
 
 	// 5.
 	u := crypto.NewECPointNoCurveCheck(tss.EC(), zero, zero) // initialization suppresses an IDE warning
 	if X != nil {
-		u = crypto.ScalarBaseMult(tss.EC(), alpha)
+		u = crypto.ScalarBaseMultSecret(tss.EC(), alpha)
 	}
 
 	// 6.
 	modNTilde := common.ModInt(NTilde)
-	z := modNTilde.Exp(h1, x)
-	z = modNTilde.Mul(z, modNTilde.Exp(h2, rho))
+	z := modNTilde.ExpSecret(h1, x)
+	z = modNTilde.Mul(z, modNTilde.ExpSecret(h2, rho))
 
 	// 7.
-	zPrm := modNTilde.Exp(h1, alpha)
-	zPrm = modNTilde.Mul(zPrm, modNTilde.Exp(h2, rhoPrm))
+	zPrm := modNTilde.ExpSecret(h1, alpha)
+	zPrm = modNTilde.Mul(zPrm, modNTilde.ExpSecret(h2, rhoPrm))
 
 	// 8.
-	t := modNTilde.Exp(h1, y)
-	t = modNTilde.Mul(t, modNTilde.Exp(h2, sigma))
+	t := modNTilde.ExpSecret(h1, y)
+	t = modNTilde.Mul(t, modNTilde.ExpSecret(h2, sigma))
 
 	// 9.
 	modNSquared := common.ModInt(NSquared)
-	v := modNSquared.Exp(c1, alpha)
-	v = modNSquared.Mul(v, modNSquared.Exp(pk.Gamma(), gamma))
-	v = modNSquared.Mul(v, modNSquared.Exp(beta, pk.N))
+	v := modNSquared.ExpSecret(c1, alpha)
+	v = modNSquared.Mul(v, pk.ExpGamma(gamma))
+	v = modNSquared.Mul(v, betaN)
 
 	// 10.
-	w := modNTilde.Exp(h1, gamma)
-	w = modNTilde.Mul(w, modNTilde.Exp(h2, tau))
+	w := modNTilde.ExpSecret(h1, gamma)
+	w = modNTilde.Mul(w, modNTilde.ExpSecret(h2, tau))
 
 	// 11-12. e'
 	var e *big.Int
@@ -255,7 +255,7 @@ func (pf *ProofBobWC) Verify(pk *paillier.PublicKey, NTilde, h1, h2, c1, c2 *big
 
 		c1ExpS1 := modNSquared.Exp(c1, pf.S1)
 		sExpN := modNSquared.Exp(pf.S, pk.N)
-		gammaExpT1 := modNSquared.Exp(pk.Gamma(), pf.T1)
+		gammaExpT1 := pk.ExpGamma(pf.T1)
 		left = modNSquared.Mul(c1ExpS1, sExpN)
 		left = modNSquared.Mul(left, gammaExpT1)
 		c2ExpE := modNSquared.Exp(c2, e)
@@ -45,7 +45,7 @@ func ProveRangeAlice(pk *paillier.PublicKey, c, NTilde, h1, h2, m, r *big.Int) (
 	// 1.
 	alpha := common.GetRandomPositiveInt(q3)
 	// 2.
-	beta := common.GetRandomPositiveRelativelyPrimeInt(pk.N)
+	beta, betaN := pk.Randomness()
 
 	// 3.
 	gamma := common.GetRandomPositiveInt(q3NTilde)
@@ -55,17 +55,16 @@ func ProveRangeAlice(pk *paillier.PublicKey, c, NTilde, h1, h2, m, r *big.Int) (
 
 	// 5.
 	modNTilde := common.ModInt(NTilde)
-	z := modNTilde.Exp(h1, m)
-	z = modNTilde.Mul(z, modNTilde.Exp(h2, rho))
+	z := modNTilde.ExpSecret(h1, m)
+	z = modNTilde.Mul(z, modNTilde.ExpSecret(h2, rho))
 
 	// 6.
 	modNSquared := common.ModInt(pk.NSquare())
-	u := modNSquared.Exp(pk.Gamma(), alpha)
-	u = modNSquared.Mul(u, modNSquared.Exp(beta, pk.N))
+	u := modNSquared.Mul(pk.ExpGamma(alpha), betaN)
 
 	// 7.
-	w := modNTilde.Exp(h1, alpha)
-	w = modNTilde.Mul(w, modNTilde.Exp(h2, gamma))
+	w := modNTilde.ExpSecret(h1, alpha)
+	w = modNTilde.Mul(w, modNTilde.ExpSecret(h2, gamma))
 
 	// 8-9. e'
 	var e *big.Int
@@ -132,7 +131,7 @@ func (pf *RangeProofAlice) Verify(pk *paillier.PublicKey, NTilde, h1, h2, c *big
 
 		cExpMinusE := modNSquared.Exp(c, minusE)
 		sExpN := modNSquared.Exp(pf.S, pk.N)
-		gammaExpS1 := modNSquared.Exp(pk.Gamma(), pf.S1)
+		gammaExpS1 := pk.ExpGamma(pf.S1)
 		// u != (4)
 		products = modNSquared.Mul(gammaExpS1, sExpN)
 		products = modNSquared.Mul(products, cExpMinusE)
@@ -16,6 +16,13 @@ import (
 	"github.com/binance-chain/tss-lib/tss"
 )
 
+var (
+	// ErrInvalidCiphertextA is returned by BobMid and BobMidWC when Alice's ciphertext cA is not in Z*_N^2
+	ErrInvalidCiphertextA = errors.New("MtA: Alice's ciphertext cA is not in Z*_N^2")
+	// ErrInvalidCiphertextB is returned by AliceEnd and AliceEndWC when Bob's ciphertext cB is not in Z*_N^2
+	ErrInvalidCiphertextB = errors.New("MtA: Bob's ciphertext cB is not in Z*_N^2")
+)
+
 func AliceInit(
 	pkA *paillier.PublicKey,
 	a, NTildeB, h1B, h2B *big.Int,
@@ -33,6 +40,10 @@ func BobMid(
 	pf *RangeProofAlice,
 	b, cA, NTildeA, h1A, h2A, NTildeB, h1B, h2B *big.Int,
 ) (beta, cB, betaPrm *big.Int, piB *ProofBob, err error) {
+	if pkA.ValidateCiphertext(cA) != nil {
+		err = ErrInvalidCiphertextA
+		return
+	}
 	if !pf.Verify(pkA, NTildeB, h1B, h2B, cA) {
 		err = errors.New("RangeProofAlice.Verify() returned false")
 		return
@@ -62,6 +73,10 @@ func BobMidWC(
 	b, cA, NTildeA, h1A, h2A, NTildeB, h1B, h2B *big.Int,
 	B *crypto.ECPoint,
 ) (beta, cB, betaPrm *big.Int, piB *ProofBobWC, err error) {
+	if pkA.ValidateCiphertext(cA) != nil {
+		err = ErrInvalidCiphertextA
+		return
+	}
 	if !pf.Verify(pkA, NTildeB, h1B, h2B, cA) {
 		err = errors.New("RangeProofAlice.Verify() returned false")
 		return
@@ -91,6 +106,9 @@ func AliceEnd(
 	h1A, h2A, cA, cB, NTildeA *big.Int,
 	sk *paillier.PrivateKey,
 ) (*big.Int, error) {
+	if pkA.ValidateCiphertext(cB) != nil {
+		return nil, ErrInvalidCiphertextB
+	}
 	if !pf.Verify(pkA, NTildeA, h1A, h2A, cA, cB) {
 		return nil, errors.New("ProofBob.Verify() returned false")
 	}
@@ -109,6 +127,9 @@ func AliceEndWC(
 	cA, cB, NTildeA, h1A, h2A *big.Int,
 	sk *paillier.PrivateKey,
 ) (*big.Int, error) {
+	if pkA.ValidateCiphertext(cB) != nil {
+		return nil, ErrInvalidCiphertextB
+	}
 	if !pf.Verify(pkA, NTildeA, h1A, h2A, cA, cB, B) {
 		return nil, errors.New("ProofBobWC.Verify() returned false")
 	}
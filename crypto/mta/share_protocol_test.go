@@ -87,3 +87,30 @@ func TestShareProtocolWC(t *testing.T) {
 	aTimesBPlusBetaModQ := new(big.Int).Mod(aTimesBPlusBeta, q)
 	assert.Equal(t, 0, alpha.Cmp(aTimesBPlusBetaModQ))
 }
+
+func TestShareProtocolInvalidCiphertext(t *testing.T) {
+	q := tss.EC().Params().N
+	keys, _, err := keygen.LoadKeygenTestFixtures(2)
+	assert.NoError(t, err)
+	sk, pk := keys[0].PaillierSK, &keys[0].PaillierSK.PublicKey
+	NTildei, h1i, h2i := keys[0].NTildei, keys[0].H1i, keys[0].H2i
+	NTildej, h1j, h2j := keys[1].NTildei, keys[1].H1i, keys[1].H2i
+	b := common.GetRandomPositiveInt(q)
+	B := crypto.ScalarBaseMult(tss.EC(), b)
+
+	cA, pf, err := AliceInit(pk, common.GetRandomPositiveInt(q), NTildej, h1j, h2j)
+	assert.NoError(t, err)
+	_, cB, _, pfB, err := BobMid(pk, pf, b, cA, NTildei, h1i, h2i, NTildej, h1j, h2j)
+	assert.NoError(t, err)
+
+	for _, invalid := range []*big.Int{big.NewInt(0), pk.N, pk.NSquare()} {
+		_, _, _, _, err = BobMid(pk, pf, b, invalid, NTildei, h1i, h2i, NTildej, h1j, h2j)
+		assert.Equal(t, ErrInvalidCiphertextA, err)
+		_, _, _, _, err = BobMidWC(pk, pf, b, invalid, NTildei, h1i, h2i, NTildej, h1j, h2j, B)
+		assert.Equal(t, ErrInvalidCiphertextA, err)
+		_, err = AliceEnd(pk, pfB, h1i, h2i, cA, invalid, NTildei, sk)
+		assert.Equal(t, ErrInvalidCiphertextB, err)
+	}
+	_, err = AliceEnd(pk, pfB, h1i, h2i, cA, cB, NTildei, sk)
+	assert.NoError(t, err)
+}
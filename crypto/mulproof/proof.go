@@ -0,0 +1,120 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+// Paillier multiplication proof (Πmul) from CGGMP21, figure 29, made non-interactive with Fiat-Shamir.
+
+// It proves that the Paillier ciphertext C = Y^x * rho^N mod N^2 encrypts the product of the plaintexts of
+// X = enc(x; rhoX) and Y. It only involves the prover's own key, so one proof convinces every verifier.
+
+package mulproof
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto/paillier"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+const (
+	// ProofBytesParts is the length of a serialized Proof
+	ProofBytesParts = 5
+)
+
+type (
+	Proof struct {
+		A, B, Z, U, V *big.Int
+	}
+)
+
+// NewProof proves that C = Y^x * rho^N and X = enc(x; rhoX) under `pk`. `session` binds the proof to its context and
+// must be given to Verify as well.
+func NewProof(pk *paillier.PublicKey, X, Y, C, x, rhoX, rho, session *big.Int) (*Proof, error) {
+	if pk == nil || X == nil || Y == nil || C == nil || x == nil || rhoX == nil || rho == nil {
+		return nil, errors.New("mulproof.NewProof received nil value(s)")
+	}
+	N := pk.N
+	alpha := common.GetRandomPositiveRelativelyPrimeInt(N)
+	r := common.GetRandomPositiveRelativelyPrimeInt(N)
+	s := common.GetRandomPositiveRelativelyPrimeInt(N)
+	defer common.Zeroize(alpha, r, s)
+
+	modN, modNSquare := common.ModInt(N), common.ModInt(pk.NSquare())
+	pf := &Proof{
+		A: modNSquare.Mul(modNSquare.ExpSecret(Y, alpha), modNSquare.ExpSecret(r, N)),
+		B: modNSquare.Mul(pk.ExpGamma(alpha), modNSquare.ExpSecret(s, N)),
+	}
+	e := challenge(pk, X, Y, C, session, pf)
+	pf.Z = new(big.Int).Add(alpha, new(big.Int).Mul(e, x))
+	pf.U = modN.Mul(r, modN.ExpSecret(rho, e))
+	pf.V = modN.Mul(s, modN.ExpSecret(rhoX, e))
+	return pf, nil
+}
+
+// Verify checks the proof for X, Y and C under `pk`
+func (pf *Proof) Verify(pk *paillier.PublicKey, X, Y, C, session *big.Int) bool {
+	if pf == nil || pk == nil || pk.N == nil || X == nil || Y == nil || C == nil || pf.Z == nil {
+		return false
+	}
+	N := pk.N
+	NSquare := pk.NSquare()
+	for _, v := range []*big.Int{pf.A, pf.B, X, Y, C} {
+		if !common.IsNumberInMultiplicativeGroup(NSquare, v) {
+			return false
+		}
+	}
+	if !common.IsNumberInMultiplicativeGroup(N, pf.U) || !common.IsNumberInMultiplicativeGroup(N, pf.V) {
+		return false
+	}
+	e := challenge(pk, X, Y, C, session, pf)
+
+	// Y^z * u^N = A * C^e mod N^2
+	modNSquare := common.ModInt(NSquare)
+	lhs := modNSquare.Mul(modNSquare.Exp(Y, pf.Z), modNSquare.Exp(pf.U, N))
+	if lhs.Cmp(modNSquare.Mul(pf.A, modNSquare.Exp(C, e))) != 0 {
+		return false
+	}
+	// (1 + N)^z * v^N = B * X^e mod N^2
+	lhs = modNSquare.Mul(pk.ExpGamma(pf.Z), modNSquare.Exp(pf.V, N))
+	return lhs.Cmp(modNSquare.Mul(pf.B, modNSquare.Exp(X, e))) == 0
+}
+
+// Serialize encodes the proof's values in order. Missing values are encoded as empty parts, which fail to validate.
+func (pf *Proof) Serialize() [][]byte {
+	return [][]byte{bytesOrEmpty(pf.A), bytesOrEmpty(pf.B), bytesOrEmpty(pf.Z), bytesOrEmpty(pf.U), bytesOrEmpty(pf.V)}
+}
+
+func UnmarshalProof(bzs [][]byte) (*Proof, error) {
+	if len(bzs) != ProofBytesParts {
+		return nil, fmt.Errorf("UnmarshalProof expected %d parts but got %d", ProofBytesParts, len(bzs))
+	}
+	return &Proof{
+		A: new(big.Int).SetBytes(bzs[0]),
+		B: new(big.Int).SetBytes(bzs[1]),
+		Z: new(big.Int).SetBytes(bzs[2]),
+		U: new(big.Int).SetBytes(bzs[3]),
+		V: new(big.Int).SetBytes(bzs[4]),
+	}, nil
+}
+
+// ----- utils
+
+func challenge(pk *paillier.PublicKey, X, Y, C, session *big.Int, pf *Proof) *big.Int {
+	if session == nil {
+		session = big.NewInt(0)
+	}
+	eHash := common.SHA512_256i(pk.N, X, Y, C, session, pf.A, pf.B)
+	return common.RejectionSample(tss.EC().Params().N, eHash)
+}
+
+func bytesOrEmpty(v *big.Int) []byte {
+	if v == nil {
+		return []byte{}
+	}
+	return v.Bytes()
+}
@@ -0,0 +1,55 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package mulproof_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/binance-chain/tss-lib/common"
+	. "github.com/binance-chain/tss-lib/crypto/mulproof"
+	"github.com/binance-chain/tss-lib/ecdsa/keygen"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+func TestMulProof(t *testing.T) {
+	q := tss.EC().Params().N
+	keys, _, err := keygen.LoadKeygenTestFixtures(1)
+	assert.NoError(t, err)
+	sk := keys[0].PaillierSK
+	pk := &sk.PublicKey
+	session := big.NewInt(42)
+
+	x, y := common.GetRandomPositiveInt(q), common.GetRandomPositiveInt(q)
+	X, rhoX, err := pk.EncryptAndReturnRandomness(x)
+	assert.NoError(t, err)
+	Y, err := pk.Encrypt(y)
+	assert.NoError(t, err)
+	modNSquare := common.ModInt(pk.NSquare())
+	rho, rhoN := pk.Randomness()
+	C := modNSquare.Mul(modNSquare.Exp(Y, x), rhoN)
+	plain, err := sk.Decrypt(C)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, new(big.Int).Mul(x, y).Cmp(plain))
+
+	pf, err := NewProof(pk, X, Y, C, x, rhoX, rho, session)
+	assert.NoError(t, err)
+	assert.True(t, pf.Verify(pk, X, Y, C, session))
+	assert.False(t, pf.Verify(pk, X, Y, C, big.NewInt(43)), "the proof is bound to the session")
+
+	pf2, err := UnmarshalProof(pf.Serialize())
+	assert.NoError(t, err)
+	assert.True(t, pf2.Verify(pk, X, Y, C, session))
+
+	// C encrypts another product
+	C2 := modNSquare.Mul(C, pk.ExpGamma(big.NewInt(1)))
+	pf3, err := NewProof(pk, X, Y, C2, x, rhoX, rho, session)
+	assert.NoError(t, err)
+	assert.False(t, pf3.Verify(pk, X, Y, C2, session))
+}
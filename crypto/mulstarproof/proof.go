@@ -0,0 +1,177 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+// Multiplication Paillier vs group proof (Πmul*) from CGGMP21, figure 31, made non-interactive with Fiat-Shamir.
+
+// It proves that the Paillier ciphertext D = C^x * rho^N0 mod N0^2 multiplies the plaintext of C by the discrete
+// logarithm x in ±2^(ℓ+ε) of X = x*G. The proof is computed against the verifier's ring-Pedersen parameters
+// (NHat, s, t), so a prover produces one proof per verifier.
+
+package mulstarproof
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+	"github.com/binance-chain/tss-lib/crypto/paillier"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+const (
+	l   = 256 // ℓ
+	eps = 512 // ε
+
+	// ProofBytesParts is the length of a serialized Proof
+	ProofBytesParts = 8
+)
+
+type (
+	Proof struct {
+		A      *big.Int
+		Bx     *crypto.ECPoint
+		E, S   *big.Int
+		Z1, Z2 *big.Int
+		W      *big.Int
+	}
+)
+
+var one = big.NewInt(1)
+
+// NewProof proves that D = C^x * rho^N0 mod N0^2 under `pk` and that X = x*G, to the verifier with the
+// ring-Pedersen parameters (NHat, s, t). `session` binds the proof to its context and must be given to Verify as well.
+func NewProof(pk *paillier.PublicKey, C, D *big.Int, X *crypto.ECPoint, x, rho, NHat, s, t, session *big.Int) (*Proof, error) {
+	if pk == nil || C == nil || D == nil || X == nil || x == nil || rho == nil || NHat == nil || s == nil || t == nil {
+		return nil, errors.New("mulstarproof.NewProof received nil value(s)")
+	}
+	N0 := pk.N
+	q := tss.EC().Params().N
+	lN := new(big.Int).Lsh(NHat, l)
+
+	alpha := common.GetRandomSymmetricInt(new(big.Int).Lsh(one, l+eps))
+	r := common.GetRandomPositiveRelativelyPrimeInt(N0)
+	gamma := common.GetRandomSymmetricInt(new(big.Int).Lsh(lN, eps))
+	m := common.GetRandomSymmetricInt(lN)
+	defer common.Zeroize(alpha, r, gamma, m)
+
+	modNHat, modNSquare := common.ModInt(NHat), common.ModInt(pk.NSquare())
+	CAlpha := modNSquare.ExpSecret(C, alpha)
+	if CAlpha == nil {
+		return nil, errors.New("the ciphertext C is not invertible")
+	}
+	pf := &Proof{
+		A:  modNSquare.Mul(CAlpha, modNSquare.ExpSecret(r, N0)),
+		Bx: crypto.ScalarBaseMultSecret(tss.EC(), new(big.Int).Mod(alpha, q)),
+		E:  modNHat.Mul(modNHat.ExpSecret(s, alpha), modNHat.ExpSecret(t, gamma)),
+		S:  modNHat.Mul(modNHat.ExpSecret(s, x), modNHat.ExpSecret(t, m)),
+	}
+	e := challenge(pk, C, D, X, NHat, s, t, session, pf)
+	pf.Z1 = new(big.Int).Add(alpha, new(big.Int).Mul(e, x))
+	pf.Z2 = new(big.Int).Add(gamma, new(big.Int).Mul(e, m))
+	pf.W = common.ModInt(N0).Mul(r, common.ModInt(N0).ExpSecret(rho, e))
+	return pf, nil
+}
+
+// Verify checks the proof for C and D under `pk` and X with the verifier's own ring-Pedersen parameters (NHat, s, t)
+func (pf *Proof) Verify(pk *paillier.PublicKey, C, D *big.Int, X *crypto.ECPoint, NHat, s, t, session *big.Int) bool {
+	if pf == nil || pk == nil || pk.N == nil || C == nil || D == nil || X == nil || NHat == nil || s == nil || t == nil {
+		return false
+	}
+	if pf.Bx == nil || !pf.Bx.ValidateBasic() || pf.Z1 == nil || pf.Z2 == nil {
+		return false
+	}
+	N0 := pk.N
+	NSquare := pk.NSquare()
+	if !common.IsNumberInMultiplicativeGroup(NHat, pf.E) || !common.IsNumberInMultiplicativeGroup(NHat, pf.S) ||
+		!common.IsNumberInMultiplicativeGroup(NSquare, pf.A) || !common.IsNumberInMultiplicativeGroup(NSquare, C) ||
+		!common.IsNumberInMultiplicativeGroup(NSquare, D) || !common.IsNumberInMultiplicativeGroup(N0, pf.W) {
+		return false
+	}
+	// range check: z1 in ±2^(ℓ+ε)
+	if new(big.Int).Abs(pf.Z1).Cmp(new(big.Int).Lsh(one, l+eps)) > 0 {
+		return false
+	}
+	q := tss.EC().Params().N
+	e := challenge(pk, C, D, X, NHat, s, t, session, pf)
+
+	// C^z1 * w^N0 = A * D^e mod N0^2
+	modNSquare := common.ModInt(NSquare)
+	CZ1 := modNSquare.Exp(C, pf.Z1)
+	if CZ1 == nil || modNSquare.Mul(CZ1, modNSquare.Exp(pf.W, N0)).Cmp(modNSquare.Mul(pf.A, modNSquare.Exp(D, e))) != 0 {
+		return false
+	}
+	// z1*G = Bx + e*X
+	rhs, err := pf.Bx.Add(X.ScalarMult(e))
+	if err != nil || !crypto.ScalarBaseMult(tss.EC(), new(big.Int).Mod(pf.Z1, q)).Equals(rhs) {
+		return false
+	}
+	// s^z1 t^z2 = E * S^e mod NHat
+	modNHat := common.ModInt(NHat)
+	sZ1, tZ2 := modNHat.Exp(s, pf.Z1), modNHat.Exp(t, pf.Z2)
+	if sZ1 == nil || tZ2 == nil {
+		return false
+	}
+	return modNHat.Mul(sZ1, tZ2).Cmp(modNHat.Mul(pf.E, modNHat.Exp(pf.S, e))) == 0
+}
+
+// Serialize encodes the proof's values in order; the signed ones are prefixed with a sign byte.
+// Missing values are encoded as empty parts, which fail to validate.
+func (pf *Proof) Serialize() [][]byte {
+	var bxX, bxY *big.Int
+	if pf.Bx != nil {
+		bxX, bxY = pf.Bx.X(), pf.Bx.Y()
+	}
+	return [][]byte{
+		bytesOrEmpty(pf.A), bytesOrEmpty(bxX), bytesOrEmpty(bxY), bytesOrEmpty(pf.E), bytesOrEmpty(pf.S),
+		common.SignedIntToBytes(pf.Z1), common.SignedIntToBytes(pf.Z2), bytesOrEmpty(pf.W),
+	}
+}
+
+func UnmarshalProof(bzs [][]byte) (*Proof, error) {
+	if len(bzs) != ProofBytesParts {
+		return nil, fmt.Errorf("UnmarshalProof expected %d parts but got %d", ProofBytesParts, len(bzs))
+	}
+	Bx, err := crypto.NewECPoint(tss.EC(), new(big.Int).SetBytes(bzs[1]), new(big.Int).SetBytes(bzs[2]))
+	if err != nil {
+		return nil, err
+	}
+	Z1, err := common.SignedBytesToInt(bzs[5])
+	if err != nil {
+		return nil, err
+	}
+	Z2, err := common.SignedBytesToInt(bzs[6])
+	if err != nil {
+		return nil, err
+	}
+	return &Proof{
+		A:  new(big.Int).SetBytes(bzs[0]),
+		Bx: Bx,
+		E:  new(big.Int).SetBytes(bzs[3]),
+		S:  new(big.Int).SetBytes(bzs[4]),
+		Z1: Z1,
+		Z2: Z2,
+		W:  new(big.Int).SetBytes(bzs[7]),
+	}, nil
+}
+
+// ----- utils
+
+func challenge(pk *paillier.PublicKey, C, D *big.Int, X *crypto.ECPoint, NHat, s, t, session *big.Int, pf *Proof) *big.Int {
+	if session == nil {
+		session = big.NewInt(0)
+	}
+	eHash := common.SHA512_256i(pk.N, C, D, X.X(), X.Y(), NHat, s, t, session, pf.A, pf.Bx.X(), pf.Bx.Y(), pf.E, pf.S)
+	return common.RejectionSample(tss.EC().Params().N, eHash)
+}
+
+func bytesOrEmpty(v *big.Int) []byte {
+	if v == nil {
+		return []byte{}
+	}
+	return v.Bytes()
+}
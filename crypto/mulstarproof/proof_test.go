@@ -0,0 +1,54 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package mulstarproof_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+	. "github.com/binance-chain/tss-lib/crypto/mulstarproof"
+	"github.com/binance-chain/tss-lib/ecdsa/keygen"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+func TestMulStarProof(t *testing.T) {
+	q := tss.EC().Params().N
+	keys, _, err := keygen.LoadKeygenTestFixtures(1)
+	assert.NoError(t, err)
+	NHat, s, tt, err := keygen.LoadNTildeH1H2FromTestFixture(1)
+	assert.NoError(t, err)
+	pk := &keys[0].PaillierSK.PublicKey
+	session := big.NewInt(42)
+
+	C, err := pk.Encrypt(common.GetRandomPositiveInt(q))
+	assert.NoError(t, err)
+	x := common.GetRandomPositiveInt(q)
+	modNSquare := common.ModInt(pk.NSquare())
+	rho, rhoN := pk.Randomness()
+	D := modNSquare.Mul(modNSquare.Exp(C, x), rhoN)
+	X := crypto.ScalarBaseMult(tss.EC(), x)
+
+	pf, err := NewProof(pk, C, D, X, x, rho, NHat, s, tt, session)
+	assert.NoError(t, err)
+	assert.True(t, pf.Verify(pk, C, D, X, NHat, s, tt, session))
+	assert.False(t, pf.Verify(pk, C, D, X, NHat, s, tt, big.NewInt(43)), "the proof is bound to the session")
+
+	pf2, err := UnmarshalProof(pf.Serialize())
+	assert.NoError(t, err)
+	assert.True(t, pf2.Verify(pk, C, D, X, NHat, s, tt, session))
+
+	// X does not match the multiplier of D
+	X2 := crypto.ScalarBaseMult(tss.EC(), new(big.Int).Add(x, big.NewInt(1)))
+	assert.False(t, pf.Verify(pk, C, D, X2, NHat, s, tt, session))
+	pf3, err := NewProof(pk, C, D, X2, x, rho, NHat, s, tt, session)
+	assert.NoError(t, err)
+	assert.False(t, pf3.Verify(pk, C, D, X2, NHat, s, tt, session))
+}
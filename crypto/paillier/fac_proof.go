@@ -0,0 +1,264 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package paillier
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/binance-chain/tss-lib/common"
+)
+
+// No small factor proof (Πfac) from CGGMP21, figure 28, made non-interactive with Fiat-Shamir.
+// It proves that both factors of N are larger than about 2^facProofL. The proof is computed against the verifier's
+// ring-Pedersen parameters (NHat, s, t), i.e. its NTilde, h1 and h2, so a prover produces one proof per verifier.
+
+const (
+	facProofL   = 256 // ℓ
+	facProofEps = 512 // ε
+
+	// FacProofBytesParts is the length of a serialized FacProof
+	FacProofBytesParts = 11
+)
+
+type FacProof struct {
+	P, Q, A, B, T, Sigma,
+	Z1, Z2, W1, W2, V *big.Int
+}
+
+// FacProof proves that the key's modulus has no factor smaller than 2^256 to the verifier with the ring-Pedersen
+// parameters (NHat, s, t). `q` is the curve order, which sets the challenge space, and `session` binds the proof
+// to its context.
+func (privateKey *PrivateKey) FacProof(NHat, s, t, q, session *big.Int) (*FacProof, error) {
+	N0 := privateKey.N
+	p, qq, err := privateKey.primes()
+	if err != nil {
+		return nil, err
+	}
+	if NHat == nil || s == nil || t == nil || q == nil {
+		return nil, errors.New("FacProof: nil verifier parameters")
+	}
+	sqrtN0 := new(big.Int).Sqrt(N0)
+	lEps := new(big.Int).Lsh(one, facProofL+facProofEps)
+	l := new(big.Int).Lsh(one, facProofL)
+	lN := new(big.Int).Mul(l, NHat)
+	lN0N := new(big.Int).Mul(lN, N0)
+
+	alpha := randomSymmetric(new(big.Int).Mul(lEps, sqrtN0))
+	beta := randomSymmetric(new(big.Int).Mul(lEps, sqrtN0))
+	mu := randomSymmetric(lN)
+	nu := randomSymmetric(lN)
+	sigma := randomSymmetric(lN0N)
+	r := randomSymmetric(new(big.Int).Lsh(lN0N, facProofEps))
+	x := randomSymmetric(new(big.Int).Mul(lEps, NHat))
+	y := randomSymmetric(new(big.Int).Mul(lEps, NHat))
+	defer common.Zeroize(alpha, beta, mu, nu, r, x, y)
+
+	modNHat := common.ModInt(NHat)
+	pedersen := func(a, b *big.Int) (*big.Int, error) { // s^a t^b
+		sa, err := expSignedSecret(NHat, s, a)
+		if err != nil {
+			return nil, err
+		}
+		tb, err := expSignedSecret(NHat, t, b)
+		if err != nil {
+			return nil, err
+		}
+		return modNHat.Mul(sa, tb), nil
+	}
+	pf := &FacProof{Sigma: sigma}
+	if pf.P, err = pedersen(p, mu); err != nil {
+		return nil, err
+	}
+	if pf.Q, err = pedersen(qq, nu); err != nil {
+		return nil, err
+	}
+	if pf.A, err = pedersen(alpha, x); err != nil {
+		return nil, err
+	}
+	if pf.B, err = pedersen(beta, y); err != nil {
+		return nil, err
+	}
+	QAlpha, err := expSignedSecret(NHat, pf.Q, alpha)
+	if err != nil {
+		return nil, err
+	}
+	tr, err := expSignedSecret(NHat, t, r)
+	if err != nil {
+		return nil, err
+	}
+	pf.T = modNHat.Mul(QAlpha, tr)
+
+	e := facProofChallenge(N0, NHat, s, t, q, session, pf)
+	// sigmaHat = sigma - nu * p
+	sigmaHat := new(big.Int).Sub(sigma, new(big.Int).Mul(nu, p))
+	pf.Z1 = new(big.Int).Add(alpha, new(big.Int).Mul(e, p))
+	pf.Z2 = new(big.Int).Add(beta, new(big.Int).Mul(e, qq))
+	pf.W1 = new(big.Int).Add(x, new(big.Int).Mul(e, mu))
+	pf.W2 = new(big.Int).Add(y, new(big.Int).Mul(e, nu))
+	pf.V = new(big.Int).Add(r, new(big.Int).Mul(e, sigmaHat))
+	return pf, nil
+}
+
+// Verify checks the proof for modulus N0 with the verifier's own ring-Pedersen parameters (NHat, s, t)
+func (pf *FacProof) Verify(N0, NHat, s, t, q, session *big.Int) bool {
+	if pf == nil || N0 == nil || NHat == nil || s == nil || t == nil || q == nil || N0.Sign() <= 0 || NHat.Sign() <= 0 {
+		return false
+	}
+	for _, v := range []*big.Int{pf.P, pf.Q, pf.A, pf.B, pf.T} {
+		if !common.IsNumberInMultiplicativeGroup(NHat, v) {
+			return false
+		}
+	}
+	for _, v := range []*big.Int{pf.Sigma, pf.Z1, pf.Z2, pf.W1, pf.W2, pf.V} {
+		if v == nil {
+			return false
+		}
+	}
+	// range check: z1, z2 in ±sqrt(N0) * 2^(ℓ+ε)
+	bound := new(big.Int).Lsh(new(big.Int).Sqrt(N0), facProofL+facProofEps)
+	if new(big.Int).Abs(pf.Z1).Cmp(bound) > 0 || new(big.Int).Abs(pf.Z2).Cmp(bound) > 0 {
+		return false
+	}
+	e := facProofChallenge(N0, NHat, s, t, q, session, pf)
+	modNHat := common.ModInt(NHat)
+	check := func(base1, exp1, base2, exp2, lhs1, lhsBase, lhsExp *big.Int) bool {
+		a, err1 := expSigned(NHat, base1, exp1)
+		b, err2 := expSigned(NHat, base2, exp2)
+		c, err3 := expSigned(NHat, lhsBase, lhsExp)
+		if err1 != nil || err2 != nil || err3 != nil {
+			return false
+		}
+		return modNHat.Mul(a, b).Cmp(modNHat.Mul(lhs1, c)) == 0
+	}
+	// s^z1 t^w1 = A P^e
+	if !check(s, pf.Z1, t, pf.W1, pf.A, pf.P, e) {
+		return false
+	}
+	// s^z2 t^w2 = B Q^e
+	if !check(s, pf.Z2, t, pf.W2, pf.B, pf.Q, e) {
+		return false
+	}
+	// Q^z1 t^v = T R^e, R = s^N0 t^sigma
+	sN0, err1 := expSigned(NHat, s, N0)
+	tSigma, err2 := expSigned(NHat, t, pf.Sigma)
+	if err1 != nil || err2 != nil {
+		return false
+	}
+	R := modNHat.Mul(sN0, tSigma)
+	return check(pf.Q, pf.Z1, t, pf.V, pf.T, R, e)
+}
+
+// Serialize encodes the proof's values in order; the signed ones are prefixed with a sign byte.
+// Missing values are encoded as empty parts, which fail to validate.
+func (pf *FacProof) Serialize() [][]byte {
+	bzs := make([][]byte, 0, FacProofBytesParts)
+	for _, v := range []*big.Int{pf.P, pf.Q, pf.A, pf.B, pf.T} {
+		bzs = append(bzs, bytesOrEmpty(v))
+	}
+	for _, v := range []*big.Int{pf.Sigma, pf.Z1, pf.Z2, pf.W1, pf.W2, pf.V} {
+		bzs = append(bzs, signedBytes(v))
+	}
+	return bzs
+}
+
+func UnmarshalFacProof(bzs [][]byte) (*FacProof, error) {
+	if len(bzs) != FacProofBytesParts {
+		return nil, fmt.Errorf("UnmarshalFacProof expected %d parts but got %d", FacProofBytesParts, len(bzs))
+	}
+	ints := make([]*big.Int, FacProofBytesParts)
+	for i, bz := range bzs {
+		if i < 5 {
+			ints[i] = new(big.Int).SetBytes(bz)
+			continue
+		}
+		v, err := fromSignedBytes(bz)
+		if err != nil {
+			return nil, err
+		}
+		ints[i] = v
+	}
+	return &FacProof{
+		P: ints[0], Q: ints[1], A: ints[2], B: ints[3], T: ints[4],
+		Sigma: ints[5], Z1: ints[6], Z2: ints[7], W1: ints[8], W2: ints[9], V: ints[10],
+	}, nil
+}
+
+// ----- utils
+
+func facProofChallenge(N0, NHat, s, t, q, session *big.Int, pf *FacProof) *big.Int {
+	if session == nil {
+		session = zero
+	}
+	eHash := common.SHA512_256i(N0, NHat, s, t, session, pf.P, pf.Q, pf.A, pf.B, pf.T, new(big.Int).Abs(pf.Sigma),
+		big.NewInt(int64(pf.Sigma.Sign())))
+	return common.RejectionSample(q, eHash)
+}
+
+// randomSymmetric returns a uniform random integer in [-bound, bound]
+func randomSymmetric(bound *big.Int) *big.Int {
+	width := new(big.Int).Lsh(bound, 1)
+	width.Add(width, one)
+	v, err := rand.Int(rand.Reader, width)
+	if err != nil {
+		panic(fmt.Errorf("rand.Int failure in randomSymmetric: %v", err))
+	}
+	return v.Sub(v, bound)
+}
+
+// expSigned returns base^exp mod N for a public exponent, inverting the base if the exponent is negative
+func expSigned(N, base, exp *big.Int) (*big.Int, error) {
+	base, abs, err := absExponent(N, base, exp)
+	if err != nil {
+		return nil, err
+	}
+	return common.ModInt(N).Exp(base, abs), nil
+}
+
+// expSignedSecret is expSigned for a secret exponent; only its sign may leak
+func expSignedSecret(N, base, exp *big.Int) (*big.Int, error) {
+	base, abs, err := absExponent(N, base, exp)
+	if err != nil {
+		return nil, err
+	}
+	return common.ModInt(N).ExpSecret(base, abs), nil
+}
+
+func absExponent(N, base, exp *big.Int) (*big.Int, *big.Int, error) {
+	if exp.Sign() >= 0 {
+		return base, exp, nil
+	}
+	inv := new(big.Int).ModInverse(base, N)
+	if inv == nil {
+		return nil, nil, errors.New("the base is not invertible")
+	}
+	return inv, new(big.Int).Neg(exp), nil
+}
+
+func signedBytes(v *big.Int) []byte {
+	if v == nil {
+		return []byte{}
+	}
+	sign := byte(0)
+	if v.Sign() < 0 {
+		sign = 1
+	}
+	return append([]byte{sign}, v.Bytes()...)
+}
+
+func fromSignedBytes(bz []byte) (*big.Int, error) {
+	if len(bz) == 0 || bz[0] > 1 {
+		return nil, errors.New("malformed signed integer")
+	}
+	v := new(big.Int).SetBytes(bz[1:])
+	if bz[0] == 1 {
+		v.Neg(v)
+	}
+	return v, nil
+}
@@ -0,0 +1,255 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package paillier
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/binance-chain/tss-lib/common"
+)
+
+// Paillier-Blum modulus proof (Πmod) from Canetti, Gennaro, Goldfeder, Makriyannis, Peled: UC Non-Interactive,
+// Proactive, Threshold ECDSA with Identifiable Aborts (CGGMP21), figure 16, made non-interactive with Fiat-Shamir.
+// It proves that N = pq with p, q = 3 mod 4 and gcd(N, phi(N)) = 1. The safe primes of GenerateKeyPair are 3 mod 4.
+
+const (
+	ModProofIters = 80
+	// ModProofBytesParts is the length of a serialized ModProof
+	ModProofBytesParts = 2*ModProofIters + 3
+)
+
+type ModProof struct {
+	W    *big.Int
+	X, Z [ModProofIters]*big.Int
+	A, B [ModProofIters]bool
+}
+
+var (
+	two  = big.NewInt(2)
+	four = big.NewInt(4)
+)
+
+// ModProof proves that the key's modulus is a Paillier-Blum modulus. `session` binds the proof to its context,
+// e.g. the prover's party key, and must be given to Verify as well.
+func (privateKey *PrivateKey) ModProof(session *big.Int) (*ModProof, error) {
+	p, q, err := privateKey.primes()
+	if err != nil {
+		return nil, err
+	}
+	return NewModProof(privateKey.N, p, q, session)
+}
+
+// NewModProof proves that N = pq is a Paillier-Blum modulus given its factors. It also serves other moduli that
+// are products of safe primes, such as NTilde.
+func NewModProof(N, p, q, session *big.Int) (*ModProof, error) {
+	if N == nil || p == nil || q == nil || new(big.Int).Mul(p, q).Cmp(N) != 0 {
+		return nil, errors.New("NewModProof: N != pq")
+	}
+	if p.Bit(0)&p.Bit(1) == 0 || q.Bit(0)&q.Bit(1) == 0 {
+		return nil, errors.New("the primes of the modulus are not 3 mod 4")
+	}
+	modN := common.ModInt(N)
+	phiN := new(big.Int).Mul(new(big.Int).Sub(p, one), new(big.Int).Sub(q, one))
+
+	// a w with Jacobi symbol -1 is a non-residue mod exactly one of p and q
+	var w *big.Int
+	for {
+		w = common.GetRandomPositiveRelativelyPrimeInt(N)
+		if big.Jacobi(w, N) == -1 {
+			break
+		}
+	}
+	nInv := new(big.Int).ModInverse(N, phiN)
+	if nInv == nil {
+		return nil, errors.New("gcd(N, phi(N)) != 1")
+	}
+	minusOne := new(big.Int).Sub(N, one)
+
+	pf := &ModProof{W: w}
+	ys := modProofChallenges(N, w, session)
+	for i, y := range ys {
+		pf.Z[i] = modN.ExpSecret(y, nInv)
+		// find a, b such that y' = (-1)^a * w^b * y is a quadratic residue mod N
+		var yi *big.Int
+		for _, ab := range [4][2]bool{{false, false}, {true, false}, {false, true}, {true, true}} {
+			yi = new(big.Int).Set(y)
+			if ab[0] {
+				yi = modN.Mul(yi, minusOne)
+			}
+			if ab[1] {
+				yi = modN.Mul(yi, w)
+			}
+			if big.Jacobi(yi, p) == 1 && big.Jacobi(yi, q) == 1 {
+				pf.A[i], pf.B[i] = ab[0], ab[1]
+				break
+			}
+		}
+		pf.X[i] = fourthRoot(yi, p, q, N)
+	}
+	return pf, nil
+}
+
+func (pf *ModProof) Verify(N, session *big.Int) bool {
+	if pf == nil || N == nil || N.Sign() <= 0 || N.Bit(0) == 0 || N.ProbablyPrime(30) {
+		return false
+	}
+	if !common.IsNumberInMultiplicativeGroup(N, pf.W) {
+		return false
+	}
+	for i := 0; i < ModProofIters; i++ {
+		if !common.IsNumberInMultiplicativeGroup(N, pf.X[i]) || !common.IsNumberInMultiplicativeGroup(N, pf.Z[i]) {
+			return false
+		}
+	}
+	modN := common.ModInt(N)
+	minusOne := new(big.Int).Sub(N, one)
+	ys := modProofChallenges(N, pf.W, session)
+	for i, y := range ys {
+		// z^N = y
+		if modN.Exp(pf.Z[i], N).Cmp(y) != 0 {
+			return false
+		}
+		// x^4 = (-1)^a * w^b * y
+		yi := new(big.Int).Set(y)
+		if pf.A[i] {
+			yi = modN.Mul(yi, minusOne)
+		}
+		if pf.B[i] {
+			yi = modN.Mul(yi, pf.W)
+		}
+		if modN.Exp(pf.X[i], four).Cmp(yi) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Serialize encodes the proof as W, the Xs, the Zs and two byte strings holding the A and B bits.
+// Missing values are encoded as empty parts, which fail to validate.
+func (pf *ModProof) Serialize() [][]byte {
+	bzs := make([][]byte, 0, ModProofBytesParts)
+	bzs = append(bzs, bytesOrEmpty(pf.W))
+	for _, x := range pf.X {
+		bzs = append(bzs, bytesOrEmpty(x))
+	}
+	for _, z := range pf.Z {
+		bzs = append(bzs, bytesOrEmpty(z))
+	}
+	as, bs := make([]byte, ModProofIters), make([]byte, ModProofIters)
+	for i := range as {
+		if pf.A[i] {
+			as[i] = 1
+		}
+		if pf.B[i] {
+			bs[i] = 1
+		}
+	}
+	return append(bzs, as, bs)
+}
+
+func UnmarshalModProof(bzs [][]byte) (*ModProof, error) {
+	if len(bzs) != ModProofBytesParts {
+		return nil, fmt.Errorf("UnmarshalModProof expected %d parts but got %d", ModProofBytesParts, len(bzs))
+	}
+	pf := &ModProof{W: new(big.Int).SetBytes(bzs[0])}
+	for i := 0; i < ModProofIters; i++ {
+		pf.X[i] = new(big.Int).SetBytes(bzs[1+i])
+		pf.Z[i] = new(big.Int).SetBytes(bzs[1+ModProofIters+i])
+	}
+	as, bs := bzs[2*ModProofIters+1], bzs[2*ModProofIters+2]
+	if len(as) != ModProofIters || len(bs) != ModProofIters {
+		return nil, errors.New("UnmarshalModProof: malformed a or b bits")
+	}
+	for i := 0; i < ModProofIters; i++ {
+		if as[i] > 1 || bs[i] > 1 {
+			return nil, errors.New("UnmarshalModProof: malformed a or b bits")
+		}
+		pf.A[i], pf.B[i] = as[i] == 1, bs[i] == 1
+	}
+	return pf, nil
+}
+
+// ----- utils
+
+// modProofChallenges derives the challenges y_i in Z_N* from the statement
+func modProofChallenges(N, w, session *big.Int) []*big.Int {
+	if session == nil {
+		session = zero
+	}
+	ys := make([]*big.Int, ModProofIters)
+	blocks := (N.BitLen() + 255) / 256
+	for i, n := 0, 0; i < ModProofIters; n++ {
+		y := new(big.Int)
+		for j := 0; j < blocks; j++ {
+			h := common.SHA512_256i(N, w, session, big.NewInt(int64(i)), big.NewInt(int64(n)), big.NewInt(int64(j)))
+			y.Lsh(y, 256).Or(y, h)
+		}
+		y.Mod(y, N)
+		if common.IsNumberInMultiplicativeGroup(N, y) {
+			ys[i] = y
+			i++
+		}
+	}
+	return ys
+}
+
+// fourthRoot returns the fourth root of y mod N = pq that is itself a quadratic residue; y must be a quadratic
+// residue and p, q = 3 mod 4, in which case y^((p+1)/4) is the square root of y mod p that is a residue
+func fourthRoot(y, p, q, N *big.Int) *big.Int {
+	root := func(prime *big.Int) *big.Int {
+		e := new(big.Int).Add(prime, one)
+		e.Rsh(e, 2)
+		e.Mul(e, e)
+		return common.ModInt(prime).ExpSecret(new(big.Int).Mod(y, prime), e)
+	}
+	xp, xq := root(p), root(q)
+	// CRT: x = xq + q * ((xp - xq) * q^-1 mod p)
+	qInv := new(big.Int).ModInverse(q, p)
+	h := common.ModInt(p).Mul(new(big.Int).Sub(xp, xq), qInv)
+	x := new(big.Int).Mul(h, q)
+	return x.Add(x, xq).Mod(x, N)
+}
+
+// primes returns copies of p and q, recovering them from N and phi(N) = N - (p + q) + 1 if they are not stored
+func (privateKey *PrivateKey) primes() (p, q *big.Int, err error) {
+	if privateKey != nil && privateKey.P != nil && privateKey.Q != nil {
+		if privateKey.N == nil || new(big.Int).Mul(privateKey.P, privateKey.Q).Cmp(privateKey.N) != 0 {
+			return nil, nil, errors.New("the Paillier private key is inconsistent")
+		}
+		return new(big.Int).Set(privateKey.P), new(big.Int).Set(privateKey.Q), nil
+	}
+	if privateKey == nil || privateKey.N == nil || privateKey.PhiN == nil {
+		return nil, nil, errors.New("the Paillier private key is incomplete")
+	}
+	N := privateKey.N
+	sum := new(big.Int).Sub(N, privateKey.PhiN)
+	sum.Add(sum, one)
+	// (p - q)^2 = (p + q)^2 - 4N
+	disc := new(big.Int).Mul(sum, sum)
+	disc.Sub(disc, new(big.Int).Mul(four, N))
+	if disc.Sign() < 0 {
+		return nil, nil, errors.New("the Paillier private key is inconsistent")
+	}
+	diff := new(big.Int).Sqrt(disc)
+	p = new(big.Int).Add(sum, diff)
+	p.Div(p, two)
+	q = new(big.Int).Sub(sum, diff)
+	q.Div(q, two)
+	if new(big.Int).Mul(p, q).Cmp(N) != 0 {
+		return nil, nil, errors.New("the Paillier private key is inconsistent")
+	}
+	return p, q, nil
+}
+
+func bytesOrEmpty(v *big.Int) []byte {
+	if v == nil {
+		return []byte{}
+	}
+	return v.Bytes()
+}
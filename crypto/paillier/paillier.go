@@ -16,6 +16,7 @@
 package paillier
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	gmath "math"
@@ -39,12 +40,29 @@ const (
 type (
 	PublicKey struct {
 		N *big.Int
+
+		// optional precomputation, see Precomputed and WithRandomnessPool
+		nSquare *big.Int
+		pool    *RandomnessPool
 	}
 
 	PrivateKey struct {
 		PublicKey
 		LambdaN, // lcm(p-1, q-1)
 		PhiN *big.Int // (p-1) * (q-1)
+		// P and Q are the factors of N, which are recovered from PhiN for keys saved by older versions
+		P, Q *big.Int
+
+		crt *crtParams // set by Precomputed
+	}
+
+	// crtParams hold the values for decryption mod p^2 and q^2 with the Chinese remainder theorem
+	crtParams struct {
+		p, q,
+		pSquare, qSquare,
+		pMinus1, qMinus1,
+		hp, hq, // L_p((1+N)^(p-1) mod p^2)^-1 mod p, and the same for q
+		qInvP *big.Int // q^-1 mod p
 	}
 
 	// Proof uses the new GenerateXs method in GG18Spec (6)
@@ -53,6 +71,8 @@ type (
 
 var (
 	ErrMessageTooLong = fmt.Errorf("the message is too large or < 0")
+	// ErrInvalidCiphertext is returned for a ciphertext that is not in Z*_{N^2}, e.g. 0 or a multiple of a factor of N
+	ErrInvalidCiphertext = fmt.Errorf("the ciphertext is not in Z*_N^2")
 
 	zero = big.NewInt(0)
 	one  = big.NewInt(1)
@@ -65,10 +85,21 @@ func init() {
 
 // len is the length of the modulus (each prime = len / 2)
 func GenerateKeyPair(modulusBitLen int, timeout time.Duration, optionalConcurrency ...int) (privateKey *PrivateKey, publicKey *PublicKey, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	privateKey, publicKey, err = GenerateKeyPairContext(ctx, modulusBitLen, optionalConcurrency...)
+	if err == context.DeadlineExceeded {
+		err = fmt.Errorf("generator timed out after %v", timeout)
+	}
+	return
+}
+
+// GenerateKeyPairContext is like GenerateKeyPair but stops when `ctx` is done, in which case `ctx.Err()` is returned
+func GenerateKeyPairContext(ctx context.Context, modulusBitLen int, optionalConcurrency ...int) (privateKey *PrivateKey, publicKey *PublicKey, err error) {
 	var concurrency int
 	if 0 < len(optionalConcurrency) {
 		if 1 < len(optionalConcurrency) {
-			panic(errors.New("GeneratePreParams: expected 0 or 1 item in `optionalConcurrency`"))
+			panic(errors.New("GenerateKeyPair: expected 0 or 1 item in `optionalConcurrency`"))
 		}
 		concurrency = optionalConcurrency[0]
 	} else {
@@ -80,7 +111,7 @@ func GenerateKeyPair(modulusBitLen int, timeout time.Duration, optionalConcurren
 	{
 		tmp := new(big.Int)
 		for {
-			sgps, err := common.GetRandomSafePrimesConcurrent(modulusBitLen/2, 2, timeout, concurrency)
+			sgps, err := common.GetRandomSafePrimesConcurrentContext(ctx, modulusBitLen/2, 2, concurrency)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -102,7 +133,7 @@ func GenerateKeyPair(modulusBitLen int, timeout time.Duration, optionalConcurren
 	lambdaN := new(big.Int).Div(phiN, gcd)
 
 	publicKey = &PublicKey{N: N}
-	privateKey = &PrivateKey{PublicKey: *publicKey, LambdaN: lambdaN, PhiN: phiN}
+	privateKey = &PrivateKey{PublicKey: *publicKey, LambdaN: lambdaN, PhiN: phiN, P: P, Q: Q}
 	return
 }
 
@@ -112,14 +143,12 @@ func (publicKey *PublicKey) EncryptAndReturnRandomness(m *big.Int) (c *big.Int,
 	if m.Cmp(zero) == -1 || m.Cmp(publicKey.N) != -1 { // m < 0 || m >= N ?
 		return nil, nil, ErrMessageTooLong
 	}
-	x = common.GetRandomPositiveRelativelyPrimeInt(publicKey.N)
-	N2 := publicKey.NSquare()
 	// 1. gamma^m mod N2
-	Gm := new(big.Int).Exp(publicKey.Gamma(), m, N2)
+	Gm := publicKey.ExpGamma(m)
 	// 2. x^N mod N2
-	xN := new(big.Int).Exp(x, publicKey.N, N2)
+	x, xN := publicKey.Randomness()
 	// 3. (1) * (2) mod N2
-	c = common.ModInt(N2).Mul(Gm, xN)
+	c = common.ModInt(publicKey.NSquare()).Mul(Gm, xN)
 	return
 }
 
@@ -132,30 +161,77 @@ func (publicKey *PublicKey) HomoMult(m, c1 *big.Int) (*big.Int, error) {
 	if m.Cmp(zero) == -1 || m.Cmp(publicKey.N) != -1 { // m < 0 || m >= N ?
 		return nil, ErrMessageTooLong
 	}
-	N2 := publicKey.NSquare()
-	if c1.Cmp(zero) == -1 || c1.Cmp(N2) != -1 { // c1 < 0 || c1 >= N2 ?
-		return nil, ErrMessageTooLong
+	if err := publicKey.ValidateCiphertext(c1); err != nil {
+		return nil, err
 	}
 	// cipher^m mod N2
-	return common.ModInt(N2).Exp(c1, m), nil
+	return common.ModInt(publicKey.NSquare()).ExpSecret(c1, m), nil
 }
 
 func (publicKey *PublicKey) HomoAdd(c1, c2 *big.Int) (*big.Int, error) {
-	N2 := publicKey.NSquare()
-	if c1.Cmp(zero) == -1 || c1.Cmp(N2) != -1 { // c1 < 0 || c1 >= N2 ?
-		return nil, ErrMessageTooLong
+	if err := publicKey.ValidateCiphertext(c1); err != nil {
+		return nil, err
 	}
-	if c2.Cmp(zero) == -1 || c2.Cmp(N2) != -1 { // c2 < 0 || c2 >= N2 ?
-		return nil, ErrMessageTooLong
+	if err := publicKey.ValidateCiphertext(c2); err != nil {
+		return nil, err
 	}
 	// c1 * c2 mod N2
-	return common.ModInt(N2).Mul(c1, c2), nil
+	return common.ModInt(publicKey.NSquare()).Mul(c1, c2), nil
+}
+
+// ValidateCiphertext returns ErrInvalidCiphertext unless 0 < c < N^2 and gcd(c, N) = 1.
+// Operating on a ciphertext from a peer that fails this check may leak information about the secrets it is combined with.
+func (publicKey *PublicKey) ValidateCiphertext(c *big.Int) error {
+	if c == nil || c.Sign() <= 0 || c.Cmp(publicKey.NSquare()) != -1 || new(big.Int).GCD(nil, nil, c, publicKey.N).Cmp(one) != 0 {
+		return ErrInvalidCiphertext
+	}
+	return nil
 }
 
+// NSquare returns N^2. The value is shared by a precomputed key and must not be modified.
 func (publicKey *PublicKey) NSquare() *big.Int {
+	if publicKey.nSquare != nil {
+		return publicKey.nSquare
+	}
 	return new(big.Int).Mul(publicKey.N, publicKey.N)
 }
 
+// ExpGamma returns Gamma^x mod N^2, which is 1 + xN as the other terms of the binomial expansion are multiples of N^2
+func (publicKey *PublicKey) ExpGamma(x *big.Int) *big.Int {
+	v := new(big.Int).Mod(x, publicKey.N)
+	v.Mul(v, publicKey.N)
+	return v.Add(v, one)
+}
+
+// Randomness returns a random r in Z*_N and r^N mod N^2, the randomness of an encryption.
+// The pair is taken from the key's RandomnessPool when it has one that is not empty.
+func (publicKey *PublicKey) Randomness() (r, rN *big.Int) {
+	if publicKey.pool != nil {
+		if r, rN = publicKey.pool.take(); r != nil {
+			return
+		}
+	}
+	return newRandomness(publicKey.N, publicKey.NSquare())
+}
+
+// Precomputed returns a copy of the key that caches N^2. Use it for a series of operations with the same key, e.g.
+// for the MtA protocol with a peer during signing.
+func (publicKey *PublicKey) Precomputed() *PublicKey {
+	pk := *publicKey
+	pk.nSquare = new(big.Int).Mul(publicKey.N, publicKey.N)
+	return &pk
+}
+
+// WithRandomnessPool returns a precomputed copy of the key whose encryptions take their randomness from `pool`
+func (publicKey *PublicKey) WithRandomnessPool(pool *RandomnessPool) (*PublicKey, error) {
+	if pool == nil || pool.N.Cmp(publicKey.N) != 0 {
+		return nil, errors.New("the randomness pool is for a different public key")
+	}
+	pk := publicKey.Precomputed()
+	pk.pool = pool
+	return pk, nil
+}
+
 // AsInts returns the PublicKey serialised to a slice of *big.Int for hashing
 func (publicKey *PublicKey) AsInts() []*big.Int {
 	return []*big.Int{publicKey.N, publicKey.Gamma()}
@@ -168,19 +244,135 @@ func (publicKey *PublicKey) Gamma() *big.Int {
 
 // ----- //
 
+// Decrypt decrypts mod p^2 and q^2 and combines the results with the CRT, which takes about a quarter of the time
+// of an exponentiation mod N^2. A key that has neither its factors nor PhiN is decrypted with LambdaN.
 func (privateKey *PrivateKey) Decrypt(c *big.Int) (m *big.Int, err error) {
-	N2 := privateKey.NSquare()
-	if c.Cmp(zero) == -1 || c.Cmp(N2) != -1 { // c < 0 || c >= N2 ?
-		return nil, ErrMessageTooLong
+	if err = privateKey.ValidateCiphertext(c); err != nil {
+		return nil, err
+	}
+	if privateKey.crt != nil {
+		return privateKey.crt.decrypt(c), nil
+	}
+	if privateKey.P == nil && privateKey.PhiN == nil {
+		return privateKey.decryptLambdaN(c), nil
+	}
+	crt, err := privateKey.crtParams()
+	if err != nil {
+		return nil, err
+	}
+	defer crt.zeroize()
+	return crt.decrypt(c), nil
+}
+
+// DecryptSigned is Decrypt for the encryption of a signed value: a plaintext above N/2 is returned as m - N
+func (privateKey *PrivateKey) DecryptSigned(c *big.Int) (*big.Int, error) {
+	m, err := privateKey.Decrypt(c)
+	if err != nil {
+		return nil, err
+	}
+	if m.Cmp(new(big.Int).Rsh(privateKey.N, 1)) > 0 {
+		m.Sub(m, privateKey.N)
+	}
+	return m, nil
+}
+
+// RecoverRandomness returns the randomness r of c = (1+N)^m * r^N mod N^2, the N-th root of c mod N, so that the
+// owner of the key can prove statements about a ciphertext that it did not encrypt itself, e.g. the result of
+// homomorphic operations on the ciphertexts of its peers.
+func (privateKey *PrivateKey) RecoverRandomness(c *big.Int) (*big.Int, error) {
+	if err := privateKey.ValidateCiphertext(c); err != nil {
+		return nil, err
 	}
+	order := privateKey.PhiN
+	if order == nil {
+		order = privateKey.LambdaN
+	}
+	if order == nil {
+		return nil, errors.New("the private key has neither PhiN nor LambdaN")
+	}
+	NInv := new(big.Int).ModInverse(privateKey.N, order)
+	if NInv == nil {
+		return nil, errors.New("N is not invertible mod the order of Z*_N")
+	}
+	defer common.Zeroize(NInv)
+	modN := common.ModInt(privateKey.N)
+	return modN.ExpSecret(new(big.Int).Mod(c, privateKey.N), NInv), nil
+}
+
+// Precomputed returns a copy of the key with the values for CRT decryption and N^2 computed once.
+// The copy holds its own secrets, so that it can be zeroized when it is no longer needed without affecting the key.
+func (privateKey *PrivateKey) Precomputed() (*PrivateKey, error) {
+	crt, err := privateKey.crtParams()
+	if err != nil {
+		return nil, err
+	}
+	return &PrivateKey{
+		PublicKey: *privateKey.PublicKey.Precomputed(),
+		LambdaN:   copyInt(privateKey.LambdaN),
+		PhiN:      copyInt(privateKey.PhiN),
+		P:         copyInt(privateKey.P),
+		Q:         copyInt(privateKey.Q),
+		crt:       crt,
+	}, nil
+}
+
+// Zeroize overwrites the secret values of the private key; the public key is left intact
+func (privateKey *PrivateKey) Zeroize() {
+	if privateKey != nil {
+		common.Zeroize(privateKey.LambdaN, privateKey.PhiN, privateKey.P, privateKey.Q)
+		privateKey.crt.zeroize()
+	}
+}
+
+func (privateKey *PrivateKey) decryptLambdaN(c *big.Int) *big.Int {
+	N2 := privateKey.NSquare()
 	// 1. L(u) = (c^LambdaN-1 mod N2) / N
-	Lc := L(new(big.Int).Exp(c, privateKey.LambdaN, N2), privateKey.N)
+	Lc := L(common.ModInt(N2).ExpSecret(c, privateKey.LambdaN), privateKey.N)
 	// 2. L(u) = (Gamma^LambdaN-1 mod N2) / N
-	Lg := L(new(big.Int).Exp(privateKey.Gamma(), privateKey.LambdaN, N2), privateKey.N)
+	Lg := L(common.ModInt(N2).ExpSecret(privateKey.Gamma(), privateKey.LambdaN), privateKey.N)
 	// 3. (1) * modInv(2) mod N
 	inv := new(big.Int).ModInverse(Lg, privateKey.N)
-	m = common.ModInt(privateKey.N).Mul(Lc, inv)
-	return
+	return common.ModInt(privateKey.N).Mul(Lc, inv)
+}
+
+func (privateKey *PrivateKey) crtParams() (*crtParams, error) {
+	p, q, err := privateKey.primes()
+	if err != nil {
+		return nil, err
+	}
+	crt := &crtParams{
+		p: p, q: q,
+		pSquare: new(big.Int).Mul(p, p), qSquare: new(big.Int).Mul(q, q),
+		pMinus1: new(big.Int).Sub(p, one), qMinus1: new(big.Int).Sub(q, one),
+	}
+	// L_p((1+N)^(p-1) mod p^2) = (p-1)N/p = (p-1)q mod p
+	crt.hp = new(big.Int).ModInverse(common.ModInt(p).Mul(crt.pMinus1, q), p)
+	crt.hq = new(big.Int).ModInverse(common.ModInt(q).Mul(crt.qMinus1, p), q)
+	crt.qInvP = new(big.Int).ModInverse(q, p)
+	if crt.hp == nil || crt.hq == nil || crt.qInvP == nil {
+		return nil, errors.New("the Paillier private key is inconsistent")
+	}
+	return crt, nil
+}
+
+func (crt *crtParams) decrypt(c *big.Int) *big.Int {
+	mp := decryptModPrime(c, crt.p, crt.pSquare, crt.pMinus1, crt.hp)
+	mq := decryptModPrime(c, crt.q, crt.qSquare, crt.qMinus1, crt.hq)
+	// m = mq + q * ((mp - mq) * q^-1 mod p)
+	m := common.ModInt(crt.p).Mul(new(big.Int).Sub(mp, mq), crt.qInvP)
+	return m.Mul(m, crt.q).Add(m, mq)
+}
+
+func (crt *crtParams) zeroize() {
+	if crt != nil {
+		common.Zeroize(crt.p, crt.q, crt.pSquare, crt.qSquare, crt.pMinus1, crt.qMinus1, crt.hp, crt.hq, crt.qInvP)
+	}
+}
+
+// decryptModPrime returns m mod p = L_p(c^(p-1) mod p^2) * hp mod p
+func decryptModPrime(c, p, pSquare, pMinus1, hp *big.Int) *big.Int {
+	u := common.ModInt(pSquare).ExpSecret(c, pMinus1)
+	return common.ModInt(p).Mul(L(u, p), hp)
 }
 
 // ----- //
@@ -193,9 +385,9 @@ func (privateKey *PrivateKey) Proof(k *big.Int, ecdsaPub *crypto2.ECPoint) Proof
 	var pi Proof
 	iters := ProofIters
 	xs := GenerateXs(iters, k, privateKey.N, ecdsaPub)
+	M := new(big.Int).ModInverse(privateKey.N, privateKey.PhiN)
 	for i := 0; i < iters; i++ {
-		M := new(big.Int).ModInverse(privateKey.N, privateKey.PhiN)
-		pi[i] = new(big.Int).Exp(xs[i], M, privateKey.N)
+		pi[i] = common.ModInt(privateKey.N).ExpSecret(xs[i], M)
 	}
 	return pi
 }
@@ -241,6 +433,13 @@ func (pf Proof) Verify(pkN, k *big.Int, ecdsaPub *crypto2.ECPoint) (bool, error)
 
 // ----- utils
 
+func copyInt(i *big.Int) *big.Int {
+	if i == nil {
+		return nil
+	}
+	return new(big.Int).Set(i)
+}
+
 func L(u, N *big.Int) *big.Int {
 	t := new(big.Int).Sub(u, one)
 	return new(big.Int).Div(t, N)
@@ -7,6 +7,7 @@
 package paillier_test
 
 import (
+	"context"
 	"math/big"
 	"testing"
 	"time"
@@ -29,7 +30,7 @@ var (
 	publicKey  *PublicKey
 )
 
-func setUp(t *testing.T) {
+func setUp(t testing.TB) {
 	if privateKey != nil && publicKey != nil {
 		return
 	}
@@ -99,6 +100,28 @@ func TestHomoAdd(t *testing.T) {
 	assert.Equal(t, new(big.Int).Add(num1, num2), plain)
 }
 
+func TestValidateCiphertext(t *testing.T) {
+	setUp(t)
+	c, err := publicKey.Encrypt(big.NewInt(42))
+	assert.NoError(t, err)
+	assert.NoError(t, publicKey.ValidateCiphertext(c))
+	N2 := publicKey.NSquare()
+	for _, invalid := range []*big.Int{
+		nil, big.NewInt(0), big.NewInt(-1), N2, new(big.Int).Add(N2, big.NewInt(1)),
+		publicKey.N, privateKey.P, new(big.Int).Mul(c, privateKey.Q), // not units mod N^2
+	} {
+		assert.Equal(t, ErrInvalidCiphertext, publicKey.ValidateCiphertext(invalid), "c = %v", invalid)
+		_, err = publicKey.HomoAdd(c, invalid)
+		assert.Equal(t, ErrInvalidCiphertext, err)
+		_, err = publicKey.HomoAdd(invalid, c)
+		assert.Equal(t, ErrInvalidCiphertext, err)
+		_, err = publicKey.HomoMult(big.NewInt(2), invalid)
+		assert.Equal(t, ErrInvalidCiphertext, err)
+		_, err = privateKey.Decrypt(invalid)
+		assert.Equal(t, ErrInvalidCiphertext, err)
+	}
+}
+
 func TestProofVerify(t *testing.T) {
 	setUp(t)
 	ki := common.MustGetRandomInt(256)                     // index
@@ -145,3 +168,168 @@ func TestGenerateXs(t *testing.T) {
 		assert.True(t, common.IsNumberInMultiplicativeGroup(N, xi))
 	}
 }
+
+func TestDecryptCRT(t *testing.T) {
+	setUp(t)
+	// the factors are recovered from PhiN, as for keys saved by older versions
+	withoutPQ := &PrivateKey{PublicKey: PublicKey{N: privateKey.N}, LambdaN: privateKey.LambdaN, PhiN: privateKey.PhiN}
+	// decrypted with LambdaN
+	withoutPhiN := &PrivateKey{PublicKey: PublicKey{N: privateKey.N}, LambdaN: privateKey.LambdaN}
+	precomputed, err := privateKey.Precomputed()
+	assert.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		m := common.GetRandomPositiveInt(publicKey.N)
+		c, err := publicKey.Encrypt(m)
+		assert.NoError(t, err)
+		for _, sk := range []*PrivateKey{privateKey, withoutPQ, withoutPhiN, precomputed} {
+			actual, err := sk.Decrypt(c)
+			assert.NoError(t, err)
+			assert.Equal(t, 0, m.Cmp(actual))
+		}
+	}
+	precomputed.Zeroize()
+	m, err := privateKey.Decrypt(publicKey.ExpGamma(big.NewInt(7)))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), m.Int64(), "zeroizing the precomputed copy leaves the key intact")
+
+	inconsistent := &PrivateKey{PublicKey: PublicKey{N: privateKey.N}, P: privateKey.P, Q: big.NewInt(3)}
+	_, err = inconsistent.Decrypt(big.NewInt(2))
+	assert.Error(t, err)
+}
+
+func TestDecryptSigned(t *testing.T) {
+	setUp(t)
+	for _, m := range []*big.Int{big.NewInt(0), big.NewInt(7), big.NewInt(-7), new(big.Int).Rsh(publicKey.N, 1)} {
+		actual, err := privateKey.DecryptSigned(publicKey.ExpGamma(m))
+		assert.NoError(t, err)
+		assert.Equal(t, 0, m.Cmp(actual), "m = %s", m)
+	}
+}
+
+func TestRecoverRandomness(t *testing.T) {
+	setUp(t)
+	withoutPhiN := &PrivateKey{PublicKey: PublicKey{N: privateKey.N}, LambdaN: privateKey.LambdaN}
+	c1, r1, err := publicKey.EncryptAndReturnRandomness(big.NewInt(3))
+	assert.NoError(t, err)
+	c2, r2, err := publicKey.EncryptAndReturnRandomness(big.NewInt(4))
+	assert.NoError(t, err)
+	// the randomness of a homomorphic sum is the product of the randomness of its terms
+	sum, err := publicKey.HomoAdd(c1, c2)
+	assert.NoError(t, err)
+	for _, sk := range []*PrivateKey{privateKey, withoutPhiN} {
+		r, err := sk.RecoverRandomness(c1)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, r1.Cmp(r))
+		r, err = sk.RecoverRandomness(sum)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, common.ModInt(publicKey.N).Mul(r1, r2).Cmp(r))
+	}
+	_, err = privateKey.RecoverRandomness(publicKey.N)
+	assert.Error(t, err)
+}
+
+func TestRandomnessPool(t *testing.T) {
+	setUp(t)
+	pool := NewRandomnessPool(publicKey, 4)
+	pool.Start()
+	defer pool.Stop()
+	for pool.Ready() < 4 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	pk, err := publicKey.WithRandomnessPool(pool)
+	assert.NoError(t, err)
+	_, err = publicKey.WithRandomnessPool(NewRandomnessPool(&PublicKey{N: big.NewInt(15)}, 1))
+	assert.Error(t, err)
+
+	seen := make(map[string]struct{})
+	for i := 0; i < 8; i++ { // the pool is drained and then falls back to computing the randomness
+		m := big.NewInt(int64(i))
+		c, r, err := pk.EncryptAndReturnRandomness(m)
+		assert.NoError(t, err)
+		_, found := seen[r.String()]
+		assert.False(t, found, "each pair is handed out once")
+		seen[r.String()] = struct{}{}
+		rN := new(big.Int).Exp(r, publicKey.N, publicKey.NSquare())
+		assert.Equal(t, 0, c.Cmp(new(big.Int).Mod(new(big.Int).Mul(publicKey.ExpGamma(m), rN), publicKey.NSquare())))
+		actual, err := privateKey.Decrypt(c)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, m.Cmp(actual))
+	}
+}
+
+func TestExpGamma(t *testing.T) {
+	setUp(t)
+	N2 := publicKey.NSquare()
+	for _, x := range []*big.Int{big.NewInt(0), big.NewInt(5), big.NewInt(-5), new(big.Int).Lsh(publicKey.N, 3)} {
+		expected := new(big.Int).Exp(publicKey.Gamma(), x, N2)
+		assert.Equal(t, 0, expected.Cmp(publicKey.ExpGamma(x)), "x = %s", x)
+	}
+}
+
+func TestPrivateKeyZeroize(t *testing.T) {
+	setUp(t)
+	// work on a copy, the key is shared with the other tests
+	sk := &PrivateKey{
+		PublicKey: PublicKey{N: new(big.Int).Set(privateKey.N)},
+		LambdaN:   new(big.Int).Set(privateKey.LambdaN),
+		PhiN:      new(big.Int).Set(privateKey.PhiN),
+		P:         new(big.Int).Set(privateKey.P),
+		Q:         new(big.Int).Set(privateKey.Q),
+	}
+	sk.Zeroize()
+	assert.Zero(t, sk.LambdaN.Sign())
+	assert.Zero(t, sk.PhiN.Sign())
+	assert.Zero(t, sk.P.Sign())
+	assert.Zero(t, sk.Q.Sign())
+	assert.Equal(t, 0, sk.N.Cmp(privateKey.N), "the public key must be left intact")
+}
+
+func TestGenerateKeyPairContextCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	sk, pk, err := GenerateKeyPairContext(ctx, testPaillierKeyLength)
+	assert.Equal(t, context.Canceled, err)
+	assert.Nil(t, sk)
+	assert.Nil(t, pk)
+}
+
+func BenchmarkDecrypt(b *testing.B) {
+	setUp(b)
+	c, _ := publicKey.Encrypt(common.GetRandomPositiveInt(publicKey.N))
+	lambdaN := &PrivateKey{PublicKey: PublicKey{N: privateKey.N}, LambdaN: privateKey.LambdaN}
+	precomputed, _ := privateKey.Precomputed()
+	for _, bm := range []struct {
+		name string
+		sk   *PrivateKey
+	}{{"LambdaN", lambdaN}, {"CRT", privateKey}, {"CRTPrecomputed", precomputed}} {
+		b.Run(bm.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = bm.sk.Decrypt(c)
+			}
+		})
+	}
+}
+
+func BenchmarkEncrypt(b *testing.B) {
+	setUp(b)
+	m := common.GetRandomPositiveInt(publicKey.N)
+	pool := NewRandomnessPool(publicKey, 1024)
+	pool.Start()
+	defer pool.Stop()
+	pooled, _ := publicKey.WithRandomnessPool(pool)
+	b.Run("Plain", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = publicKey.Encrypt(m)
+		}
+	})
+	b.Run("Pooled", func(b *testing.B) {
+		b.StopTimer()
+		for pool.Ready() < b.N && pool.Ready() < 1024 {
+			time.Sleep(10 * time.Millisecond)
+		}
+		b.StartTimer()
+		for i := 0; i < b.N; i++ {
+			_, _ = pooled.Encrypt(m)
+		}
+	})
+}
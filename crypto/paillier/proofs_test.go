@@ -0,0 +1,105 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package paillier_test
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/binance-chain/tss-lib/common"
+	. "github.com/binance-chain/tss-lib/crypto/paillier"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+var session = big.NewInt(42)
+
+// ringPedersen returns verifier parameters (NHat, s, t) with a smaller modulus than in the protocol, to keep the
+// tests fast
+func ringPedersen(t *testing.T) (NHat, s, tt *big.Int) {
+	sk, _, err := GenerateKeyPair(1024, 10*time.Minute)
+	assert.NoError(t, err)
+	NHat = sk.N
+	r := common.GetRandomPositiveRelativelyPrimeInt(NHat)
+	s = common.ModInt(NHat).Mul(r, r)
+	lambda := common.GetRandomPositiveInt(sk.PhiN)
+	tt = common.ModInt(NHat).Exp(s, lambda)
+	return
+}
+
+// keyFromPrimes builds a private key with arbitrary primes, bypassing the checks of GenerateKeyPair
+func keyFromPrimes(p, q *big.Int) *PrivateKey {
+	N := new(big.Int).Mul(p, q)
+	phiN := new(big.Int).Mul(new(big.Int).Sub(p, big.NewInt(1)), new(big.Int).Sub(q, big.NewInt(1)))
+	return &PrivateKey{PublicKey: PublicKey{N: N}, PhiN: phiN, LambdaN: phiN}
+}
+
+func TestModProof(t *testing.T) {
+	setUp(t)
+	pf, err := privateKey.ModProof(session)
+	assert.NoError(t, err)
+	assert.True(t, pf.Verify(publicKey.N, session))
+	assert.False(t, pf.Verify(publicKey.N, big.NewInt(43)), "the proof is bound to the session")
+
+	pf2, err := UnmarshalModProof(pf.Serialize())
+	assert.NoError(t, err)
+	assert.True(t, pf2.Verify(publicKey.N, session))
+
+	pf2.A[0] = !pf2.A[0]
+	assert.False(t, pf2.Verify(publicKey.N, session))
+
+	// a prime is not a Paillier-Blum modulus
+	prime, err := rand.Prime(rand.Reader, 2048)
+	assert.NoError(t, err)
+	assert.False(t, pf.Verify(prime, session))
+}
+
+func TestModProofNotBlum(t *testing.T) {
+	var p, q *big.Int
+	for p == nil || p.Bit(1) == 1 { // p = 1 mod 4
+		p, _ = rand.Prime(rand.Reader, 512)
+	}
+	for q == nil || q.Bit(1) == 0 {
+		q, _ = rand.Prime(rand.Reader, 512)
+	}
+	_, err := keyFromPrimes(p, q).ModProof(session)
+	assert.Error(t, err)
+}
+
+func TestFacProof(t *testing.T) {
+	setUp(t)
+	q := tss.EC().Params().N
+	NHat, s, tt := ringPedersen(t)
+	pf, err := privateKey.FacProof(NHat, s, tt, q, session)
+	assert.NoError(t, err)
+	assert.True(t, pf.Verify(publicKey.N, NHat, s, tt, q, session))
+	assert.False(t, pf.Verify(publicKey.N, NHat, s, tt, q, big.NewInt(43)), "the proof is bound to the session")
+	assert.False(t, pf.Verify(publicKey.N, NHat, tt, s, q, session), "the proof is bound to the verifier's parameters")
+
+	pf2, err := UnmarshalFacProof(pf.Serialize())
+	assert.NoError(t, err)
+	assert.True(t, pf2.Verify(publicKey.N, NHat, s, tt, q, session))
+
+	pf2.Z1.Add(pf2.Z1, big.NewInt(1))
+	assert.False(t, pf2.Verify(publicKey.N, NHat, s, tt, q, session))
+}
+
+func TestFacProofSmallFactor(t *testing.T) {
+	q := tss.EC().Params().N
+	NHat, s, tt := ringPedersen(t)
+	small, err := rand.Prime(rand.Reader, 128)
+	assert.NoError(t, err)
+	large, err := rand.Prime(rand.Reader, 2048-128)
+	assert.NoError(t, err)
+	sk := keyFromPrimes(small, large)
+	pf, err := sk.FacProof(NHat, s, tt, q, session)
+	assert.NoError(t, err)
+	assert.False(t, pf.Verify(sk.N, NHat, s, tt, q, session))
+}
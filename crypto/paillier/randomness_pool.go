@@ -0,0 +1,97 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package paillier
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/binance-chain/tss-lib/common"
+)
+
+type (
+	// RandomnessPool computes the randomness of encryptions under one public key in the background, i.e. pairs of a
+	// random r in Z*_N and r^N mod N^2. The exponentiation is the most expensive part of an encryption, so a pool
+	// that is filled ahead of time takes it out of the critical path, e.g. of the MtA protocol of signing.
+	// Each pair is handed out once. Attach the pool to the key with PublicKey.WithRandomnessPool.
+	RandomnessPool struct {
+		N       *big.Int
+		nSquare *big.Int
+		workers int
+
+		pairs  chan randomness
+		ctx    context.Context // cancelled by Stop
+		cancel context.CancelFunc
+	}
+
+	randomness struct {
+		r, rN *big.Int
+	}
+)
+
+// NewRandomnessPool creates a pool that keeps `size` pairs ready for `publicKey`. Call Start to begin filling it.
+// The number of workers defaults to 1.
+func NewRandomnessPool(publicKey *PublicKey, size int, optionalWorkers ...int) *RandomnessPool {
+	workers := 1
+	if 0 < len(optionalWorkers) && 0 < optionalWorkers[0] {
+		workers = optionalWorkers[0]
+	}
+	if size < 1 {
+		size = 1
+	}
+	pool := &RandomnessPool{
+		N:       publicKey.N,
+		nSquare: new(big.Int).Mul(publicKey.N, publicKey.N),
+		workers: workers,
+		pairs:   make(chan randomness, size),
+	}
+	pool.ctx, pool.cancel = context.WithCancel(context.Background())
+	return pool
+}
+
+// Start launches the refill workers
+func (pool *RandomnessPool) Start() {
+	for i := 0; i < pool.workers; i++ {
+		go pool.refill()
+	}
+}
+
+// Stop stops the refill workers. The pairs that are ready may still be taken.
+func (pool *RandomnessPool) Stop() {
+	pool.cancel()
+}
+
+// Ready returns the number of pairs that are ready
+func (pool *RandomnessPool) Ready() int {
+	return len(pool.pairs)
+}
+
+// take returns a ready pair, or nils if the pool is empty
+func (pool *RandomnessPool) take() (r, rN *big.Int) {
+	select {
+	case pair := <-pool.pairs:
+		return pair.r, pair.rN
+	default:
+		return nil, nil
+	}
+}
+
+func (pool *RandomnessPool) refill() {
+	for {
+		r, rN := newRandomness(pool.N, pool.nSquare)
+		select {
+		case pool.pairs <- randomness{r, rN}:
+		case <-pool.ctx.Done():
+			return
+		}
+	}
+}
+
+func newRandomness(N, NSquare *big.Int) (r, rN *big.Int) {
+	r = common.GetRandomPositiveRelativelyPrimeInt(N)
+	return r, new(big.Int).Exp(r, N, NSquare)
+}
@@ -0,0 +1,47 @@
+//go:build timing
+// +build timing
+
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package paillier_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/binance-chain/tss-lib/crypto/paillier"
+	"github.com/binance-chain/tss-lib/test"
+)
+
+func TestDecryptTiming(t *testing.T) {
+	// a smaller key keeps the samples fast; the exponentiation does not depend on the key length
+	sk, pk, err := GenerateKeyPair(512, 10*time.Minute)
+	assert.NoError(t, err)
+	sk, err = sk.Precomputed()
+	assert.NoError(t, err)
+	c, err := pk.Encrypt(big.NewInt(100))
+	assert.NoError(t, err)
+	// CRT decryption exponentiates by p-1 and q-1; the fixed key has exponents of the same length with a single bit set
+	bits := uint(sk.P.BitLen() - 1)
+	P := new(big.Int).Add(new(big.Int).Lsh(big.NewInt(1), bits), big.NewInt(1))
+	Q := new(big.Int).Add(P, big.NewInt(2))
+	fixed, err := (&PrivateKey{PublicKey: PublicKey{N: new(big.Int).Mul(P, Q)}, P: P, Q: Q}).Precomputed()
+	assert.NoError(t, err)
+	fixedC, err := fixed.Encrypt(big.NewInt(100))
+	assert.NoError(t, err)
+	tStat := test.TimingLeak(1000, func(class int) func() {
+		key, ct := fixed, fixedC
+		if class == 1 {
+			key, ct = sk, c
+		}
+		return func() { _, _ = key.Decrypt(ct) }
+	})
+	assert.True(t, tStat < test.TimingLeakThreshold, "t = %f", tStat)
+}
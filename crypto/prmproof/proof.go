@@ -0,0 +1,144 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+// Ring-Pedersen parameter proof (Πprm) from CGGMP21, figure 17, made non-interactive with Fiat-Shamir.
+
+// It proves knowledge of lambda such that s = t^lambda mod N, i.e. that s lies in the group generated by t.
+// The proof also carries a Paillier-Blum modulus proof (Πmod) for N, so that a verifier knows N is a Blum integer.
+// It may be used in place of the two DLN proofs for (NTilde, h1, h2), with s = h2 and t = h1, and is cheaper.
+
+package prmproof
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto/paillier"
+)
+
+const (
+	Iterations = 80
+
+	// ProofBytesParts is the length of a serialized Proof
+	ProofBytesParts = 2*Iterations + paillier.ModProofBytesParts
+)
+
+type (
+	Proof struct {
+		A, Z [Iterations]*big.Int
+		Blum *paillier.ModProof
+	}
+)
+
+// NewProof proves that s = t^lambda mod N for N = (2p+1)(2q+1), where p and q are the Sophie Germain primes of N's
+// safe prime factors. `session` binds the proof to its context and must be given to Verify as well.
+func NewProof(s, t, lambda, p, q, N, session *big.Int) (*Proof, error) {
+	P, Q := safePrime(p), safePrime(q)
+	blum, err := paillier.NewModProof(N, P, Q, session)
+	if err != nil {
+		return nil, err
+	}
+	pMulQ := new(big.Int).Mul(p, q)
+	modN, modPQ := common.ModInt(N), common.ModInt(pMulQ)
+	a := make([]*big.Int, Iterations)
+	defer common.Zeroize(a...)
+	pf := &Proof{Blum: blum}
+	for i := range pf.A {
+		a[i] = common.GetRandomPositiveInt(pMulQ)
+		pf.A[i] = modN.ExpSecret(t, a[i])
+	}
+	e := challenge(s, t, N, session, pf.A[:])
+	for i := range pf.Z {
+		pf.Z[i] = new(big.Int).Set(a[i])
+		if e.Bit(i) == 1 {
+			pf.Z[i] = modPQ.Add(a[i], lambda)
+		}
+	}
+	return pf, nil
+}
+
+func (pf *Proof) Verify(s, t, N, session *big.Int) bool {
+	if pf == nil || s == nil || t == nil || N == nil || N.Sign() <= 0 {
+		return false
+	}
+	if !common.IsNumberInMultiplicativeGroup(N, s) || !common.IsNumberInMultiplicativeGroup(N, t) {
+		return false
+	}
+	for i := 0; i < Iterations; i++ {
+		if !common.IsNumberInMultiplicativeGroup(N, pf.A[i]) || pf.Z[i] == nil || pf.Z[i].Sign() < 0 {
+			return false
+		}
+	}
+	if !pf.Blum.Verify(N, session) {
+		return false
+	}
+	modN := common.ModInt(N)
+	e := challenge(s, t, N, session, pf.A[:])
+	for i := 0; i < Iterations; i++ {
+		// t^z = A s^e
+		rhs := pf.A[i]
+		if e.Bit(i) == 1 {
+			rhs = modN.Mul(rhs, s)
+		}
+		if modN.Exp(t, pf.Z[i]).Cmp(rhs) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Serialize encodes the As, the Zs and then the Blum modulus proof
+func (pf *Proof) Serialize() [][]byte {
+	bzs := make([][]byte, 0, ProofBytesParts)
+	for _, v := range append(pf.A[:], pf.Z[:]...) {
+		if v == nil {
+			bzs = append(bzs, []byte{})
+			continue
+		}
+		bzs = append(bzs, v.Bytes())
+	}
+	blum := pf.Blum
+	if blum == nil {
+		blum = new(paillier.ModProof)
+	}
+	return append(bzs, blum.Serialize()...)
+}
+
+func UnmarshalProof(bzs [][]byte) (*Proof, error) {
+	if len(bzs) != ProofBytesParts {
+		return nil, fmt.Errorf("UnmarshalProof expected %d parts but got %d", ProofBytesParts, len(bzs))
+	}
+	pf := new(Proof)
+	for i := 0; i < Iterations; i++ {
+		pf.A[i] = new(big.Int).SetBytes(bzs[i])
+		pf.Z[i] = new(big.Int).SetBytes(bzs[Iterations+i])
+	}
+	blum, err := paillier.UnmarshalModProof(bzs[2*Iterations:])
+	if err != nil {
+		return nil, err
+	}
+	pf.Blum = blum
+	return pf, nil
+}
+
+// ----- utils
+
+func challenge(s, t, N, session *big.Int, A []*big.Int) *big.Int {
+	if session == nil {
+		session = big.NewInt(0)
+	}
+	msg := append([]*big.Int{s, t, N, session}, A...)
+	return common.SHA512_256i(msg...)
+}
+
+func safePrime(p *big.Int) *big.Int {
+	if p == nil {
+		return nil
+	}
+	P := new(big.Int).Lsh(p, 1)
+	return P.Add(P, big.NewInt(1))
+}
@@ -0,0 +1,51 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package prmproof_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/binance-chain/tss-lib/crypto/prmproof"
+	"github.com/binance-chain/tss-lib/ecdsa/keygen"
+)
+
+func TestPrmProof(t *testing.T) {
+	fixtures, _, err := keygen.LoadKeygenTestFixtures(1)
+	assert.NoError(t, err)
+	pp := fixtures[0].LocalPreParams
+	session := big.NewInt(42)
+
+	pf, err := NewProof(pp.H2i, pp.H1i, pp.Alpha, pp.P, pp.Q, pp.NTildei, session)
+	assert.NoError(t, err)
+	assert.True(t, pf.Verify(pp.H2i, pp.H1i, pp.NTildei, session))
+	assert.False(t, pf.Verify(pp.H2i, pp.H1i, pp.NTildei, big.NewInt(43)), "the proof is bound to the session")
+	assert.False(t, pf.Verify(pp.H1i, pp.H2i, pp.NTildei, session))
+
+	pf2, err := UnmarshalProof(pf.Serialize())
+	assert.NoError(t, err)
+	assert.True(t, pf2.Verify(pp.H2i, pp.H1i, pp.NTildei, session))
+
+	pf2.Z[0].Add(pf2.Z[0], big.NewInt(1))
+	assert.False(t, pf2.Verify(pp.H2i, pp.H1i, pp.NTildei, session))
+}
+
+func TestPrmProofWrongLambda(t *testing.T) {
+	fixtures, _, err := keygen.LoadKeygenTestFixtures(1)
+	assert.NoError(t, err)
+	pp := fixtures[0].LocalPreParams
+	session := big.NewInt(42)
+
+	pf, err := NewProof(pp.H2i, pp.H1i, pp.Beta, pp.P, pp.Q, pp.NTildei, session)
+	assert.NoError(t, err)
+	assert.False(t, pf.Verify(pp.H2i, pp.H1i, pp.NTildei, session))
+
+	_, err = NewProof(pp.H2i, pp.H1i, pp.Alpha, pp.P, pp.P, pp.NTildei, session)
+	assert.Error(t, err, "the primes must match the modulus")
+}
@@ -0,0 +1,155 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package schnorr
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+	"github.com/binance-chain/tss-lib/crypto/group"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+// batchCoefficientBitLen is the bit length of the random coefficients of a batch verification. A batch that contains
+// an invalid proof passes with a probability of at most 2^-128.
+const batchCoefficientBitLen = 128
+
+// batchVerifier accumulates random linear combinations of verification equations of the form
+// sum_i s_i*P_i - b*G == 0, which are then checked with one multi-scalar multiplication
+type batchVerifier struct {
+	grp     group.Group
+	scalars []group.Scalar
+	points  []group.Point
+	base    group.Scalar // the coefficient b of the generator
+
+	batched, invalid []int
+}
+
+func newBatchVerifier(grp group.Group, n int) *batchVerifier {
+	return &batchVerifier{
+		grp:     grp,
+		scalars: make([]group.Scalar, 0, 2*n+1),
+		points:  make([]group.Point, 0, 2*n+1),
+		base:    grp.NewScalar(big.NewInt(0)),
+		batched: make([]int, 0, n),
+	}
+}
+
+func (bv *batchVerifier) coefficient() group.Scalar {
+	return bv.grp.NewScalar(common.MustGetRandomInt(batchCoefficientBitLen))
+}
+
+// verify checks the combined equation. When it fails, `each` decides which of the batched proofs are invalid.
+// It returns the sorted indexes of the invalid proofs.
+func (bv *batchVerifier) verify(each func(k int) bool) []int {
+	if 0 < len(bv.batched) {
+		sum, err := group.VarTimeMultiScalarMult(
+			bv.grp, append(bv.scalars, bv.base.Negate()), append(bv.points, bv.grp.Generator()))
+		if err != nil || !sum.IsIdentity() {
+			for _, k := range bv.batched {
+				if !each(k) {
+					bv.invalid = append(bv.invalid, k)
+				}
+			}
+		}
+	}
+	sort.Ints(bv.invalid)
+	return bv.invalid
+}
+
+// BatchVerifyZKProofs verifies proofs[k] for Xs[k]. With random coefficients rho_k it checks that
+// sum_k rho_k*(alpha_k + c_k*X_k - t_k*G) is the identity, which costs about as much as a single verification
+// when there are many proofs. Only when this check fails are the proofs verified one by one to find the invalid ones.
+// It returns the indexes of the invalid proofs, or nil when all of them are valid.
+func BatchVerifyZKProofs(proofs []*ZKProof, Xs []*crypto.ECPoint) []int {
+	grp, err := group.ForCurve(tss.EC())
+	if err != nil {
+		return allIndexes(len(proofs))
+	}
+	bv := newBatchVerifier(grp, len(proofs))
+	for k, pf := range proofs {
+		if pf == nil || !pf.ValidateBasic() || len(Xs) <= k || Xs[k] == nil {
+			bv.invalid = append(bv.invalid, k)
+			continue
+		}
+		gX, err := Xs[k].ToGroupPoint(grp)
+		if err != nil {
+			bv.invalid = append(bv.invalid, k)
+			continue
+		}
+		alpha, err := pf.Alpha.ToGroupPoint(grp)
+		if err != nil {
+			bv.invalid = append(bv.invalid, k)
+			continue
+		}
+		rho := bv.coefficient()
+		c := grp.NewScalar(zkProofChallenge(Xs[k], pf.Alpha))
+		bv.scalars = append(bv.scalars, rho, rho.Mul(c))
+		bv.points = append(bv.points, alpha, gX)
+		bv.base = bv.base.Add(rho.Mul(grp.NewScalar(pf.T)))
+		bv.batched = append(bv.batched, k)
+	}
+	return bv.verify(func(k int) bool {
+		return proofs[k].Verify(Xs[k])
+	})
+}
+
+// BatchVerifyZKVProofs verifies proofs[k] for Vs[k] and the common point R, as in the last rounds of signing where
+// every party proves its V_i for the same R. With random coefficients rho_k it checks that
+// sum_k rho_k*(alpha_k + c_k*V_k - t_k*R - u_k*G) is the identity, and falls back to one verification per proof
+// only when it is not. It returns the indexes of the invalid proofs, or nil when all of them are valid.
+func BatchVerifyZKVProofs(proofs []*ZKVProof, Vs []*crypto.ECPoint, R *crypto.ECPoint) []int {
+	grp, err := group.ForCurve(tss.EC())
+	if err != nil || R == nil {
+		return allIndexes(len(proofs))
+	}
+	gR, err := R.ToGroupPoint(grp)
+	if err != nil {
+		return allIndexes(len(proofs))
+	}
+	bv := newBatchVerifier(grp, len(proofs))
+	tSum := grp.NewScalar(big.NewInt(0))
+	for k, pf := range proofs {
+		if pf == nil || !pf.ValidateBasic() || len(Vs) <= k || Vs[k] == nil {
+			bv.invalid = append(bv.invalid, k)
+			continue
+		}
+		gV, err := Vs[k].ToGroupPoint(grp)
+		if err != nil {
+			bv.invalid = append(bv.invalid, k)
+			continue
+		}
+		alpha, err := pf.Alpha.ToGroupPoint(grp)
+		if err != nil {
+			bv.invalid = append(bv.invalid, k)
+			continue
+		}
+		rho := bv.coefficient()
+		c := grp.NewScalar(zkvProofChallenge(Vs[k], R, pf.Alpha))
+		bv.scalars = append(bv.scalars, rho, rho.Mul(c))
+		bv.points = append(bv.points, alpha, gV)
+		tSum = tSum.Add(rho.Mul(grp.NewScalar(pf.T)))
+		bv.base = bv.base.Add(rho.Mul(grp.NewScalar(pf.U)))
+		bv.batched = append(bv.batched, k)
+	}
+	// the t_k share the point R, so it enters the combination once
+	bv.scalars = append(bv.scalars, tSum.Negate())
+	bv.points = append(bv.points, gR)
+	return bv.verify(func(k int) bool {
+		return proofs[k].Verify(Vs[k], R)
+	})
+}
+
+func allIndexes(n int) []int {
+	indexes := make([]int, n)
+	for k := range indexes {
+		indexes[k] = k
+	}
+	return indexes
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/binance-chain/tss-lib/common"
 	"github.com/binance-chain/tss-lib/crypto"
+	"github.com/binance-chain/tss-lib/crypto/group"
 	"github.com/binance-chain/tss-lib/tss"
 )
 
@@ -32,45 +33,45 @@ func NewZKProof(x *big.Int, X *crypto.ECPoint) (*ZKProof, error) {
 	if x == nil || X == nil || !X.ValidateBasic() {
 		return nil, errors.New("ZKProof constructor received nil or invalid value(s)")
 	}
-	ecParams := tss.EC().Params()
-	q := ecParams.N
-	g := crypto.NewECPointNoCurveCheck(tss.EC(), ecParams.Gx, ecParams.Gy) // already on the curve.
-
-	a := common.GetRandomPositiveInt(q)
-	alpha := crypto.ScalarBaseMult(tss.EC(), a)
+	grp, err := group.ForCurve(tss.EC())
+	if err != nil {
+		return nil, err
+	}
+	q := tss.EC().Params().N
 
-	var c *big.Int
-	{
-		cHash := common.SHA512_256i(X.X(), X.Y(), g.X(), g.Y(), alpha.X(), alpha.Y())
-		c = common.RejectionSample(q, cHash)
+	a := grp.NewScalar(common.GetRandomPositiveInt(q))
+	alpha, err := crypto.NewECPointFromGroup(tss.EC(), grp.ScalarBaseMultSecret(a))
+	if err != nil {
+		return nil, err
 	}
-	t := new(big.Int).Mul(c, x)
-	t = common.ModInt(q).Add(a, t)
 
-	return &ZKProof{Alpha: alpha, T: t}, nil
+	c := zkProofChallenge(X, alpha)
+	t := a.Add(grp.NewScalar(c).Mul(grp.NewScalar(x)))
+
+	return &ZKProof{Alpha: alpha, T: t.BigInt()}, nil
 }
 
 // NewZKProof verifies a new Schnorr ZK proof of knowledge of the discrete logarithm (GG18Spec Fig. 16)
 func (pf *ZKProof) Verify(X *crypto.ECPoint) bool {
-	if pf == nil || !pf.ValidateBasic() {
+	if pf == nil || !pf.ValidateBasic() || X == nil {
 		return false
 	}
-	ecParams := tss.EC().Params()
-	q := ecParams.N
-	g := crypto.NewECPointNoCurveCheck(tss.EC(), ecParams.Gx, ecParams.Gy)
-
-	var c *big.Int
-	{
-		cHash := common.SHA512_256i(X.X(), X.Y(), g.X(), g.Y(), pf.Alpha.X(), pf.Alpha.Y())
-		c = common.RejectionSample(q, cHash)
+	grp, err := group.ForCurve(tss.EC())
+	if err != nil {
+		return false
 	}
-	tG := crypto.ScalarBaseMult(tss.EC(), pf.T)
-	Xc := X.ScalarMult(c)
-	aXc, err := pf.Alpha.Add(Xc)
+	c := zkProofChallenge(X, pf.Alpha)
+	gX, err := X.ToGroupPoint(grp)
 	if err != nil {
 		return false
 	}
-	return aXc.X().Cmp(tG.X()) == 0 && aXc.Y().Cmp(tG.Y()) == 0
+	alpha, err := pf.Alpha.ToGroupPoint(grp)
+	if err != nil {
+		return false
+	}
+	tG := grp.ScalarBaseMult(grp.NewScalar(pf.T))
+	aXc := alpha.Add(gX.ScalarMult(grp.NewScalar(c)))
+	return aXc.Equal(tG)
 }
 
 func (pf *ZKProof) ValidateBasic() bool {
@@ -82,52 +83,69 @@ func NewZKVProof(V, R *crypto.ECPoint, s, l *big.Int) (*ZKVProof, error) {
 	if V == nil || R == nil || s == nil || l == nil || !V.ValidateBasic() || !R.ValidateBasic() {
 		return nil, errors.New("ZKVProof constructor received nil value(s)")
 	}
-	ecParams := tss.EC().Params()
-	q := ecParams.N
-	g := crypto.NewECPointNoCurveCheck(tss.EC(), ecParams.Gx, ecParams.Gy)
-
-	a, b := common.GetRandomPositiveInt(q), common.GetRandomPositiveInt(q)
-	aR := R.ScalarMult(a)
-	bG := crypto.ScalarBaseMult(tss.EC(), b)
-	alpha, _ := aR.Add(bG) // already on the curve.
+	grp, err := group.ForCurve(tss.EC())
+	if err != nil {
+		return nil, err
+	}
+	gR, err := R.ToGroupPoint(grp)
+	if err != nil {
+		return nil, err
+	}
+	q := tss.EC().Params().N
 
-	var c *big.Int
-	{
-		cHash := common.SHA512_256i(V.X(), V.Y(), R.X(), R.Y(), g.X(), g.Y(), alpha.X(), alpha.Y())
-		c = common.RejectionSample(q, cHash)
+	a, b := grp.NewScalar(common.GetRandomPositiveInt(q)), grp.NewScalar(common.GetRandomPositiveInt(q))
+	alpha, err := crypto.NewECPointFromGroup(tss.EC(), gR.ScalarMultSecret(a).Add(grp.ScalarBaseMultSecret(b)))
+	if err != nil {
+		return nil, err
 	}
-	modQ := common.ModInt(q)
-	t := modQ.Add(a, new(big.Int).Mul(c, s))
-	u := modQ.Add(b, new(big.Int).Mul(c, l))
 
-	return &ZKVProof{Alpha: alpha, T: t, U: u}, nil
+	gc := grp.NewScalar(zkvProofChallenge(V, R, alpha))
+	t := a.Add(gc.Mul(grp.NewScalar(s)))
+	u := b.Add(gc.Mul(grp.NewScalar(l)))
+
+	return &ZKVProof{Alpha: alpha, T: t.BigInt(), U: u.BigInt()}, nil
 }
 
 func (pf *ZKVProof) Verify(V, R *crypto.ECPoint) bool {
-	if pf == nil || !pf.ValidateBasic() {
+	if pf == nil || !pf.ValidateBasic() || V == nil || R == nil {
 		return false
 	}
-	ecParams := tss.EC().Params()
-	q := ecParams.N
-	g := crypto.NewECPointNoCurveCheck(tss.EC(), ecParams.Gx, ecParams.Gy)
-
-	var c *big.Int
-	{
-		cHash := common.SHA512_256i(V.X(), V.Y(), R.X(), R.Y(), g.X(), g.Y(), pf.Alpha.X(), pf.Alpha.Y())
-		c = common.RejectionSample(q, cHash)
+	grp, err := group.ForCurve(tss.EC())
+	if err != nil {
+		return false
 	}
-	tR := R.ScalarMult(pf.T)
-	uG := crypto.ScalarBaseMult(tss.EC(), pf.U)
-	tRuG, _ := tR.Add(uG) // already on the curve.
-
-	Vc := V.ScalarMult(c)
-	aVc, err := pf.Alpha.Add(Vc)
+	c := zkvProofChallenge(V, R, pf.Alpha)
+	gV, err := V.ToGroupPoint(grp)
+	if err != nil {
+		return false
+	}
+	gR, err := R.ToGroupPoint(grp)
 	if err != nil {
 		return false
 	}
-	return tRuG.X().Cmp(aVc.X()) == 0 && tRuG.Y().Cmp(aVc.Y()) == 0
+	alpha, err := pf.Alpha.ToGroupPoint(grp)
+	if err != nil {
+		return false
+	}
+	tRuG := gR.ScalarMult(grp.NewScalar(pf.T)).Add(grp.ScalarBaseMult(grp.NewScalar(pf.U)))
+	aVc := alpha.Add(gV.ScalarMult(grp.NewScalar(c)))
+	return tRuG.Equal(aVc)
 }
 
 func (pf *ZKVProof) ValidateBasic() bool {
 	return pf.Alpha != nil && pf.T != nil && pf.U != nil && pf.Alpha.ValidateBasic()
 }
+
+// zkProofChallenge is the Fiat-Shamir challenge of a ZKProof for X with the commitment alpha
+func zkProofChallenge(X, alpha *crypto.ECPoint) *big.Int {
+	ecParams := tss.EC().Params()
+	cHash := common.SHA512_256i(X.X(), X.Y(), ecParams.Gx, ecParams.Gy, alpha.X(), alpha.Y())
+	return common.RejectionSample(ecParams.N, cHash)
+}
+
+// zkvProofChallenge is the Fiat-Shamir challenge of a ZKVProof for V and R with the commitment alpha
+func zkvProofChallenge(V, R, alpha *crypto.ECPoint) *big.Int {
+	ecParams := tss.EC().Params()
+	cHash := common.SHA512_256i(V.X(), V.Y(), R.X(), R.Y(), ecParams.Gx, ecParams.Gy, alpha.X(), alpha.Y())
+	return common.RejectionSample(ecParams.N, cHash)
+}
@@ -7,6 +7,8 @@
 package schnorr_test
 
 import (
+	"crypto/elliptic"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -100,3 +102,88 @@ func TestSchnorrVProofVerifyBadS(t *testing.T) {
 
 	assert.False(t, res, "verify result must be false")
 }
+
+func newTestZKProofs(n int) ([]*ZKProof, []*crypto.ECPoint) {
+	q := tss.EC().Params().N
+	proofs, Xs := make([]*ZKProof, n), make([]*crypto.ECPoint, n)
+	for k := range proofs {
+		x := common.GetRandomPositiveInt(q)
+		Xs[k] = crypto.ScalarBaseMult(tss.EC(), x)
+		proofs[k], _ = NewZKProof(x, Xs[k])
+	}
+	return proofs, Xs
+}
+
+func newTestZKVProofs(n int, R *crypto.ECPoint) ([]*ZKVProof, []*crypto.ECPoint) {
+	q := tss.EC().Params().N
+	proofs, Vs := make([]*ZKVProof, n), make([]*crypto.ECPoint, n)
+	for k := range proofs {
+		s, l := common.GetRandomPositiveInt(q), common.GetRandomPositiveInt(q)
+		Vs[k], _ = R.ScalarMult(s).Add(crypto.ScalarBaseMult(tss.EC(), l))
+		proofs[k], _ = NewZKVProof(Vs[k], R, s, l)
+	}
+	return proofs, Vs
+}
+
+func TestBatchVerifyZKProofs(t *testing.T) {
+	proofs, Xs := newTestZKProofs(6)
+	assert.Nil(t, BatchVerifyZKProofs(proofs, Xs))
+	assert.Nil(t, BatchVerifyZKProofs(nil, nil))
+
+	// a proof for another X, a missing proof and a proof without an X are reported by index
+	other, _ := newTestZKProofs(1)
+	proofs[1], proofs[4] = other[0], nil
+	assert.Equal(t, []int{1, 4, 5}, BatchVerifyZKProofs(proofs, Xs[:5]))
+
+	// swapping the points of two valid proofs breaks both
+	proofs, Xs = newTestZKProofs(3)
+	Xs[0], Xs[2] = Xs[2], Xs[0]
+	assert.Equal(t, []int{0, 2}, BatchVerifyZKProofs(proofs, Xs))
+}
+
+func TestBatchVerifyZKVProofs(t *testing.T) {
+	q := tss.EC().Params().N
+	R := crypto.ScalarBaseMult(tss.EC(), common.GetRandomPositiveInt(q))
+	proofs, Vs := newTestZKVProofs(5, R)
+	assert.Nil(t, BatchVerifyZKVProofs(proofs, Vs, R))
+
+	// a proof for another R
+	R2 := crypto.ScalarBaseMult(tss.EC(), common.GetRandomPositiveInt(q))
+	other, otherVs := newTestZKVProofs(1, R2)
+	proofs[3], Vs[3] = other[0], otherVs[0]
+	assert.Equal(t, []int{3}, BatchVerifyZKVProofs(proofs, Vs, R))
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, BatchVerifyZKVProofs(proofs, Vs, nil))
+
+	// a proof with a tampered response
+	proofs, Vs = newTestZKVProofs(3, R)
+	proofs[0].U = new(big.Int).Add(proofs[0].U, big.NewInt(1))
+	assert.Equal(t, []int{0}, BatchVerifyZKVProofs(proofs, Vs, R))
+}
+
+func BenchmarkVerifyZKProofs(b *testing.B) {
+	proofs, Xs := newTestZKProofs(20)
+	b.Run("OneByOne", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			for k, proof := range proofs {
+				proof.Verify(Xs[k])
+			}
+		}
+	})
+	b.Run("Batch", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			BatchVerifyZKProofs(proofs, Xs)
+		}
+	})
+}
+
+func TestSchnorrProofOtherCurve(t *testing.T) {
+	// P-384 has no backend in crypto/group and runs over its elliptic.Curve methods
+	defer tss.SetCurve(tss.EC())
+	tss.SetCurve(elliptic.P384())
+	u := common.GetRandomPositiveInt(tss.EC().Params().N)
+	X := crypto.ScalarBaseMult(tss.EC(), u)
+	proof, err := NewZKProof(u, X)
+	assert.NoError(t, err)
+	assert.True(t, proof.Verify(X))
+	assert.False(t, proof.Verify(crypto.ScalarBaseMult(tss.EC(), big.NewInt(2))))
+}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/binance-chain/tss-lib/common"
 	"github.com/binance-chain/tss-lib/crypto"
+	"github.com/binance-chain/tss-lib/crypto/group"
 	"github.com/binance-chain/tss-lib/tss"
 )
 
@@ -54,11 +55,18 @@ func Create(threshold int, secret *big.Int, indexes []*big.Int) (Vs, Shares, err
 		return nil, nil, ErrNumSharesBelowThreshold
 	}
 
+	g, err := group.ForCurve(tss.EC())
+	if err != nil {
+		return nil, nil, err
+	}
 	poly := samplePolynomial(threshold, secret)
 	poly[0] = secret // becomes sigma*G in v
+	defer common.Zeroize(poly[1:]...)
 	v := make(Vs, len(poly))
 	for i, ai := range poly {
-		v[i] = crypto.ScalarBaseMult(tss.EC(), ai)
+		if v[i], err = crypto.NewECPointFromGroup(tss.EC(), g.ScalarBaseMultSecret(g.NewScalar(ai))); err != nil {
+			return nil, nil, err
+		}
 	}
 
 	shares := make(Shares, num)
@@ -73,24 +81,39 @@ func Create(threshold int, secret *big.Int, indexes []*big.Int) (Vs, Shares, err
 }
 
 func (share *Share) Verify(threshold int, vs Vs) bool {
-	if share.Threshold != threshold || vs == nil {
+	if share.Threshold != threshold || len(vs) <= threshold {
+		return false
+	}
+	g, err := group.ForCurve(tss.EC())
+	if err != nil {
 		return false
 	}
-	var err error
-	modQ := common.ModInt(tss.EC().Params().N)
-	v, t := vs[0], one // YRO : we need to have our accumulator outside of the loop
-	for j := 1; j <= threshold; j++ {
-		// t = k_i^j
-		t = modQ.Mul(t, share.ID)
-		// v = v * v_j^t
-		vjt := vs[j].SetCurve(tss.EC()).ScalarMult(t)
-		v, err = v.SetCurve(tss.EC()).Add(vjt)
+	id := g.NewScalar(share.ID)
+	// v = v_0 + sum_j v_j * id^j, evaluated with Horner's rule
+	v := g.Identity()
+	for j := threshold; j >= 0; j-- {
+		vj, err := vs[j].ToGroupPoint(g)
 		if err != nil {
 			return false
 		}
+		v = v.ScalarMult(id).Add(vj)
+	}
+	sigmaGi := g.ScalarBaseMultSecret(g.NewScalar(share.Share))
+	return sigmaGi.Equal(v)
+}
+
+// Zeroize overwrites the share's secret value
+func (share *Share) Zeroize() {
+	if share != nil {
+		common.Zeroize(share.Share)
+	}
+}
+
+// Zeroize overwrites the secret values of all of the shares
+func (shares Shares) Zeroize() {
+	for _, share := range shares {
+		share.Zeroize()
 	}
-	sigmaGi := crypto.ScalarBaseMult(tss.EC(), share.Share)
-	return sigmaGi.Equals(v)
 }
 
 func (shares Shares) ReConstruct() (secret *big.Int, err error) {
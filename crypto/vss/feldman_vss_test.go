@@ -7,6 +7,7 @@
 package vss_test
 
 import (
+	"crypto/elliptic"
 	"math/big"
 	"testing"
 
@@ -88,3 +89,39 @@ func TestReconstruct(t *testing.T) {
 	assert.NoError(t, err4)
 	assert.NotZero(t, secret4)
 }
+
+func TestSharesZeroize(t *testing.T) {
+	num, threshold := 5, 3
+
+	secret := common.GetRandomPositiveInt(tss.EC().Params().N)
+
+	ids := make([]*big.Int, 0)
+	for i := 0; i < num; i++ {
+		ids = append(ids, common.GetRandomPositiveInt(tss.EC().Params().N))
+	}
+
+	_, shares, err := Create(threshold, secret, ids)
+	assert.NoError(t, err)
+
+	shares.Zeroize()
+	for i, share := range shares {
+		assert.Zero(t, share.Share.Sign(), "share %d should be zeroized", i)
+		assert.Equal(t, ids[i], share.ID, "the ids are public and must be left intact")
+	}
+}
+
+func TestVerifyOtherCurve(t *testing.T) {
+	// shares on a curve that only has the generic group
+	defer tss.SetCurve(tss.EC())
+	tss.SetCurve(elliptic.P384())
+	num, threshold := 5, 3
+	ids := make([]*big.Int, 0, num)
+	for i := 0; i < num; i++ {
+		ids = append(ids, common.GetRandomPositiveInt(tss.EC().Params().N))
+	}
+	vs, shares, err := Create(threshold, common.GetRandomPositiveInt(tss.EC().Params().N), ids)
+	assert.NoError(t, err)
+	for i := 0; i < num; i++ {
+		assert.True(t, shares[i].Verify(threshold, vs))
+	}
+}
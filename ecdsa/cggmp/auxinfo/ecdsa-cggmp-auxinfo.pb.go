@@ -0,0 +1,190 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: protob/ecdsa-cggmp-auxinfo.proto
+
+package auxinfo
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// Represents a BROADCAST message sent to all parties during Round 1 of the CGGMP21 aux-info and key refresh.
+type AuxRound1Message struct {
+	Commitment           []byte   `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuxRound1Message) Reset()         { *m = AuxRound1Message{} }
+func (m *AuxRound1Message) String() string { return proto.CompactTextString(m) }
+func (*AuxRound1Message) ProtoMessage()    {}
+func (*AuxRound1Message) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e5665f968bf1abf, []int{0}
+}
+
+func (m *AuxRound1Message) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuxRound1Message.Unmarshal(m, b)
+}
+func (m *AuxRound1Message) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuxRound1Message.Marshal(b, m, deterministic)
+}
+func (m *AuxRound1Message) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuxRound1Message.Merge(m, src)
+}
+func (m *AuxRound1Message) XXX_Size() int {
+	return xxx_messageInfo_AuxRound1Message.Size(m)
+}
+func (m *AuxRound1Message) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuxRound1Message.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuxRound1Message proto.InternalMessageInfo
+
+func (m *AuxRound1Message) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+// Represents a BROADCAST message sent to all parties during Round 2 of the CGGMP21 aux-info and key refresh.
+type AuxRound2Message struct {
+	DeCommitment         [][]byte `protobuf:"bytes,1,rep,name=de_commitment,json=deCommitment,proto3" json:"de_commitment,omitempty"`
+	ModProof             [][]byte `protobuf:"bytes,2,rep,name=mod_proof,json=modProof,proto3" json:"mod_proof,omitempty"`
+	PrmProof             [][]byte `protobuf:"bytes,3,rep,name=prm_proof,json=prmProof,proto3" json:"prm_proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuxRound2Message) Reset()         { *m = AuxRound2Message{} }
+func (m *AuxRound2Message) String() string { return proto.CompactTextString(m) }
+func (*AuxRound2Message) ProtoMessage()    {}
+func (*AuxRound2Message) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e5665f968bf1abf, []int{1}
+}
+
+func (m *AuxRound2Message) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuxRound2Message.Unmarshal(m, b)
+}
+func (m *AuxRound2Message) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuxRound2Message.Marshal(b, m, deterministic)
+}
+func (m *AuxRound2Message) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuxRound2Message.Merge(m, src)
+}
+func (m *AuxRound2Message) XXX_Size() int {
+	return xxx_messageInfo_AuxRound2Message.Size(m)
+}
+func (m *AuxRound2Message) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuxRound2Message.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuxRound2Message proto.InternalMessageInfo
+
+func (m *AuxRound2Message) GetDeCommitment() [][]byte {
+	if m != nil {
+		return m.DeCommitment
+	}
+	return nil
+}
+
+func (m *AuxRound2Message) GetModProof() [][]byte {
+	if m != nil {
+		return m.ModProof
+	}
+	return nil
+}
+
+func (m *AuxRound2Message) GetPrmProof() [][]byte {
+	if m != nil {
+		return m.PrmProof
+	}
+	return nil
+}
+
+// Represents a P2P message sent to each party during Round 3 of the CGGMP21 aux-info and key refresh.
+type AuxRound3Message struct {
+	Share                []byte   `protobuf:"bytes,1,opt,name=share,proto3" json:"share,omitempty"`
+	FacProof             [][]byte `protobuf:"bytes,2,rep,name=fac_proof,json=facProof,proto3" json:"fac_proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuxRound3Message) Reset()         { *m = AuxRound3Message{} }
+func (m *AuxRound3Message) String() string { return proto.CompactTextString(m) }
+func (*AuxRound3Message) ProtoMessage()    {}
+func (*AuxRound3Message) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e5665f968bf1abf, []int{2}
+}
+
+func (m *AuxRound3Message) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuxRound3Message.Unmarshal(m, b)
+}
+func (m *AuxRound3Message) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuxRound3Message.Marshal(b, m, deterministic)
+}
+func (m *AuxRound3Message) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuxRound3Message.Merge(m, src)
+}
+func (m *AuxRound3Message) XXX_Size() int {
+	return xxx_messageInfo_AuxRound3Message.Size(m)
+}
+func (m *AuxRound3Message) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuxRound3Message.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuxRound3Message proto.InternalMessageInfo
+
+func (m *AuxRound3Message) GetShare() []byte {
+	if m != nil {
+		return m.Share
+	}
+	return nil
+}
+
+func (m *AuxRound3Message) GetFacProof() [][]byte {
+	if m != nil {
+		return m.FacProof
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AuxRound1Message)(nil), "AuxRound1Message")
+	proto.RegisterType((*AuxRound2Message)(nil), "AuxRound2Message")
+	proto.RegisterType((*AuxRound3Message)(nil), "AuxRound3Message")
+}
+
+func init() { proto.RegisterFile("protob/ecdsa-cggmp-auxinfo.proto", fileDescriptor_1e5665f968bf1abf) }
+
+var fileDescriptor_1e5665f968bf1abf = []byte{
+	// 208 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x28, 0x28, 0xca, 0x2f,
+	0xc9, 0x4f, 0xd2, 0x4f, 0x4d, 0x4e, 0x29, 0x4e, 0xd4, 0x4d, 0x4e, 0x4f, 0xcf, 0x2d, 0xd0, 0x4d,
+	0x2c, 0xad, 0xc8, 0xcc, 0x4b, 0xcb, 0xd7, 0x03, 0x4b, 0x29, 0x19, 0x71, 0x09, 0x38, 0x96, 0x56,
+	0x04, 0xe5, 0x97, 0xe6, 0xa5, 0x18, 0xfa, 0xa6, 0x16, 0x17, 0x27, 0xa6, 0xa7, 0x0a, 0xc9, 0x71,
+	0x71, 0x25, 0xe7, 0xe7, 0xe6, 0x66, 0x96, 0xe4, 0xa6, 0xe6, 0x95, 0x48, 0x30, 0x2a, 0x30, 0x6a,
+	0xf0, 0x04, 0x21, 0x89, 0x28, 0x15, 0x22, 0xf4, 0x18, 0xc1, 0xf4, 0x28, 0x73, 0xf1, 0xa6, 0xa4,
+	0xc6, 0xa3, 0x68, 0x63, 0xd6, 0xe0, 0x09, 0xe2, 0x49, 0x49, 0x75, 0x86, 0x8b, 0x09, 0x49, 0x73,
+	0x71, 0xe6, 0xe6, 0xa7, 0xc4, 0x17, 0x14, 0xe5, 0xe7, 0xa7, 0x49, 0x30, 0x81, 0x15, 0x70, 0xe4,
+	0xe6, 0xa7, 0x04, 0x80, 0xf8, 0x20, 0xc9, 0x82, 0xa2, 0x5c, 0xa8, 0x24, 0x33, 0x44, 0xb2, 0xa0,
+	0x28, 0x17, 0x2c, 0xa9, 0xe4, 0x8a, 0xb0, 0xd2, 0x18, 0x66, 0xa5, 0x08, 0x17, 0x6b, 0x71, 0x46,
+	0x62, 0x51, 0x2a, 0xd4, 0x85, 0x10, 0x0e, 0xc8, 0x98, 0xb4, 0xc4, 0x64, 0x54, 0x3b, 0xd2, 0x12,
+	0x93, 0xc1, 0xc6, 0x38, 0x89, 0x46, 0x09, 0x83, 0x83, 0x42, 0x1f, 0x1c, 0x14, 0xfa, 0xd0, 0xa0,
+	0x48, 0x62, 0x03, 0x87, 0x85, 0x31, 0x60, 0x00, 0xce, 0xb2, 0x32, 0x20, 0x2f, 0x01, 0x00, 0x00,
+}
@@ -0,0 +1,182 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package auxinfo
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+	cmt "github.com/binance-chain/tss-lib/crypto/commitments"
+	"github.com/binance-chain/tss-lib/ecdsa/keygen"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+// Implements Party
+// Implements Stringer
+var _ tss.Party = (*LocalParty)(nil)
+var _ tss.Zeroizer = (*LocalParty)(nil)
+var _ fmt.Stringer = (*LocalParty)(nil)
+
+type (
+	LocalParty struct {
+		*tss.BaseParty
+		params *tss.Parameters
+
+		temp localTempData
+		data keygen.LocalPartySaveData
+
+		// outbound messaging
+		out chan<- tss.Message
+		end chan<- keygen.LocalPartySaveData
+	}
+
+	localMessageStore struct {
+		auxRound1Messages,
+		auxRound2Messages,
+		auxRound3Messages []tss.ParsedMessage
+	}
+
+	localTempData struct {
+		localMessageStore
+
+		// the pre-params provided to the constructor, if any
+		preParams *keygen.LocalPreParams
+
+		// temp data (thrown away after the refresh)
+		coeffs   []*big.Int // a_1..a_t of the zero-sharing polynomial
+		shares   []*big.Int // its evaluations at each party's key
+		KGCs     []cmt.HashCommitment
+		deCommit cmt.HashDeCommitment
+
+		// round 3
+		bigVjs [][]*crypto.ECPoint // a_c*G for c = 1..t, of each Pj
+	}
+)
+
+// NewLocalParty returns a party for the CGGMP21 aux-info and key refresh protocol (figure 6 of the paper). All of the
+// parties that hold shares of `key` must take part. They each prove a fresh Paillier key and ring-Pedersen parameters
+// with Πmod, Πprm and Πfac, and re-randomize their key shares with a sharing of zero; the public key does not change.
+// The refreshed share is sent on `end` and the input share should be discarded once it has been persisted.
+//
+// `key` may come from the key generation in `cggmp/keygen` or from `cggmp/keygen.ImportGG18`.
+// When `optionalPreParams` is provided the pre-computed primes will be used instead of generating them from scratch.
+func NewLocalParty(
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	out chan<- tss.Message,
+	end chan<- keygen.LocalPartySaveData,
+	optionalPreParams ...keygen.LocalPreParams,
+) tss.Party {
+	partyCount := params.PartyCount()
+	data := keygen.BuildLocalSaveDataSubset(key, params.Parties().IDs())
+	// the refreshed share gets a new Xi and pre-params, which must not alias the ones in `key`
+	data.LocalPreParams = keygen.LocalPreParams{}
+	if key.Xi != nil {
+		data.Xi = new(big.Int).Set(key.Xi)
+	}
+	p := &LocalParty{
+		BaseParty: new(tss.BaseParty),
+		params:    params,
+		temp:      localTempData{},
+		data:      data,
+		out:       out,
+		end:       end,
+	}
+	if 0 < len(optionalPreParams) {
+		if 1 < len(optionalPreParams) {
+			panic(errors.New("auxinfo.NewLocalParty expected 0 or 1 item in `optionalPreParams`"))
+		}
+		if !optionalPreParams[0].ValidateWithProof() {
+			panic(errors.New("`optionalPreParams` failed to validate; it might have been generated with an older version of tss-lib"))
+		}
+		p.temp.preParams = &optionalPreParams[0]
+	}
+	// msgs init
+	p.temp.auxRound1Messages = make([]tss.ParsedMessage, partyCount)
+	p.temp.auxRound2Messages = make([]tss.ParsedMessage, partyCount)
+	p.temp.auxRound3Messages = make([]tss.ParsedMessage, partyCount)
+	// temp data init
+	p.temp.KGCs = make([]cmt.HashCommitment, partyCount)
+	p.temp.bigVjs = make([][]*crypto.ECPoint, partyCount)
+	return p
+}
+
+func (p *LocalParty) FirstRound() tss.Round {
+	return newRound1(p.params, &p.data, &p.temp, p.out, p.end)
+}
+
+func (p *LocalParty) Start() *tss.Error {
+	return tss.BaseStart(p, TaskName)
+}
+
+func (p *LocalParty) Update(msg tss.ParsedMessage) (ok bool, err *tss.Error) {
+	return tss.BaseUpdate(p, msg, TaskName)
+}
+
+func (p *LocalParty) UpdateFromBytes(wireBytes []byte, from *tss.PartyID, isBroadcast bool) (bool, *tss.Error) {
+	msg, err := tss.ParseWireMessage(wireBytes, from, isBroadcast)
+	if err != nil {
+		return false, p.WrapError(err)
+	}
+	return p.Update(msg)
+}
+
+func (p *LocalParty) ValidateMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
+	if ok, err := p.BaseParty.ValidateMessage(msg); !ok || err != nil {
+		return ok, err
+	}
+	// check that the message's "from index" will fit into the array
+	if maxFromIdx := p.params.PartyCount() - 1; maxFromIdx < msg.GetFrom().Index {
+		return false, p.WrapError(fmt.Errorf("received msg with a sender index too great (%d <= %d)",
+			p.params.PartyCount(), msg.GetFrom().Index), msg.GetFrom())
+	}
+	return true, nil
+}
+
+func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
+	// ValidateBasic is cheap; double-check the message here in case the public StoreMessage was called externally
+	if ok, err := p.ValidateMessage(msg); !ok || err != nil {
+		return ok, err
+	}
+	fromPIdx := msg.GetFrom().Index
+
+	// switch/case is necessary to store any messages beyond current round
+	// this does not handle message replays. we expect the caller to apply replay and spoofing protection.
+	switch msg.Content().(type) {
+	case *AuxRound1Message:
+		p.temp.auxRound1Messages[fromPIdx] = msg
+	case *AuxRound2Message:
+		p.temp.auxRound2Messages[fromPIdx] = msg
+	case *AuxRound3Message:
+		p.temp.auxRound3Messages[fromPIdx] = msg
+	default: // unrecognised message, just ignore!
+		common.Logger.Warningf("unrecognised message ignored: %v", msg)
+		return false, nil
+	}
+	return true, nil
+}
+
+// Zeroize overwrites the zero-sharing polynomial and the shares that were dealt, as well as the refreshed key share
+// in the save data if the refresh did not finish
+func (p *LocalParty) Zeroize() {
+	common.Zeroize(p.temp.coeffs...)
+	common.Zeroize(p.temp.shares...)
+	if p.Running() {
+		p.data.LocalSecrets.Zeroize()
+	}
+}
+
+func (p *LocalParty) PartyID() *tss.PartyID {
+	return p.params.PartyID()
+}
+
+func (p *LocalParty) String() string {
+	return fmt.Sprintf("id: %s, %s", p.PartyID(), p.BaseParty.String())
+}
@@ -0,0 +1,146 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package auxinfo
+
+import (
+	"fmt"
+	"runtime"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ipfs/go-log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+	"github.com/binance-chain/tss-lib/crypto/vss"
+	cggmpkeygen "github.com/binance-chain/tss-lib/ecdsa/cggmp/keygen"
+	"github.com/binance-chain/tss-lib/ecdsa/keygen"
+	"github.com/binance-chain/tss-lib/test"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+const (
+	testParticipants = 3
+	testThreshold    = 1
+)
+
+func setUp(level string) {
+	if err := log.SetLogLevel("tss-lib", level); err != nil {
+		panic(err)
+	}
+}
+
+// runParties runs the parties made by `newParty` until each of them has sent its save data on `endCh`
+func runParties(t *testing.T, pIDs tss.SortedPartyIDs, newParty func(params *tss.Parameters, outCh chan tss.Message, endCh chan keygen.LocalPartySaveData) tss.Party) []keygen.LocalPartySaveData {
+	p2pCtx := tss.NewPeerContext(pIDs)
+	parties := make([]tss.Party, 0, len(pIDs))
+
+	errCh := make(chan *tss.Error, len(pIDs))
+	outCh := make(chan tss.Message, len(pIDs))
+	endCh := make(chan keygen.LocalPartySaveData, len(pIDs))
+
+	updater := test.SharedPartyUpdater
+
+	for i := 0; i < len(pIDs); i++ {
+		params := tss.NewParameters(p2pCtx, pIDs[i], len(pIDs), testThreshold)
+		P := newParty(params, outCh, endCh)
+		parties = append(parties, P)
+		go func(P tss.Party) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+
+	saves := make([]keygen.LocalPartySaveData, len(pIDs))
+	var ended int32
+	for {
+		fmt.Printf("ACTIVE GOROUTINES: %d\n", runtime.NumGoroutine())
+		select {
+		case err := <-errCh:
+			common.Logger.Errorf("Error: %s", err)
+			assert.FailNow(t, err.Error())
+			return nil
+
+		case msg := <-outCh:
+			dest := msg.GetTo()
+			if dest == nil {
+				for _, P := range parties {
+					if P.PartyID().Index == msg.GetFrom().Index {
+						continue
+					}
+					go updater(P, msg, errCh)
+				}
+			} else {
+				if dest[0].Index == msg.GetFrom().Index {
+					t.Fatalf("party %d tried to send a message to itself (%d)", dest[0].Index, msg.GetFrom().Index)
+				}
+				go updater(parties[dest[0].Index], msg, errCh)
+			}
+
+		case save := <-endCh:
+			index, err := save.OriginalIndex()
+			assert.NoErrorf(t, err, "should not be an error getting a party's index from save data")
+			saves[index] = save
+			atomic.AddInt32(&ended, 1)
+			if atomic.LoadInt32(&ended) == int32(len(pIDs)) {
+				t.Logf("Done. Received save data from %d participants", ended)
+				return saves
+			}
+		}
+	}
+}
+
+func TestE2EConcurrent(t *testing.T) {
+	setUp("info")
+
+	fixtures, pIDs, err := keygen.LoadKeygenTestFixtures(testParticipants)
+	if !assert.NoError(t, err, "should load keygen fixtures") {
+		return
+	}
+
+	// PHASE: keygen
+	keys := runParties(t, pIDs, func(params *tss.Parameters, outCh chan tss.Message, endCh chan keygen.LocalPartySaveData) tss.Party {
+		return cggmpkeygen.NewLocalParty(params, outCh, endCh)
+	})
+	if keys == nil {
+		return
+	}
+	oldXs := make([]*vss.Share, len(keys))
+	for i, key := range keys {
+		assert.False(t, key.LocalPreParams.Validate(), "keygen does not make the aux-info")
+		oldXs[i] = &vss.Share{Threshold: testThreshold, ID: key.ShareID, Share: key.Xi}
+	}
+
+	// PHASE: aux-info and refresh, with the pre-params of the fixtures
+	refreshed := runParties(t, pIDs, func(params *tss.Parameters, outCh chan tss.Message, endCh chan keygen.LocalPartySaveData) tss.Party {
+		i := params.PartyID().Index
+		return NewLocalParty(params, keys[i], outCh, endCh, fixtures[i].LocalPreParams)
+	})
+	if refreshed == nil {
+		return
+	}
+	newXs := make(vss.Shares, len(refreshed))
+	for i, save := range refreshed {
+		assert.True(t, save.ECDSAPub.Equals(keys[0].ECDSAPub), "the public key must not change")
+		assert.NotEqual(t, 0, save.Xi.Cmp(keys[i].Xi), "the key share must change")
+		assert.True(t, crypto.ScalarBaseMult(tss.EC(), save.Xi).Equals(save.BigXj[i]))
+		for j := range refreshed {
+			assert.True(t, save.BigXj[j].Equals(refreshed[0].BigXj[j]))
+			assert.Equal(t, 0, save.NTildej[j].Cmp(fixtures[j].NTildei))
+			assert.Equal(t, 0, save.PaillierPKs[j].N.Cmp(fixtures[j].PaillierSK.N))
+		}
+		assert.True(t, save.LocalPreParams.ValidateWithProof())
+		newXs[i] = &vss.Share{Threshold: testThreshold, ID: save.ShareID, Share: save.Xi}
+	}
+	oldX, err := vss.Shares(oldXs).ReConstruct()
+	assert.NoError(t, err)
+	newX, err := newXs.ReConstruct()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, oldX.Cmp(newX), "the refreshed shares must reconstruct the same secret key")
+}
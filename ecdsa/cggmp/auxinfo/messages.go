@@ -0,0 +1,131 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package auxinfo
+
+import (
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/binance-chain/tss-lib/common"
+	cmt "github.com/binance-chain/tss-lib/crypto/commitments"
+	"github.com/binance-chain/tss-lib/crypto/paillier"
+	"github.com/binance-chain/tss-lib/crypto/prmproof"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+// These messages were generated from Protocol Buffers definitions into ecdsa-cggmp-auxinfo.pb.go
+// The following messages are registered on the Protocol Buffers "wire"
+
+var (
+	// Ensure that aux-info messages implement ValidateBasic
+	_ = []tss.MessageContent{
+		(*AuxRound1Message)(nil),
+		(*AuxRound2Message)(nil),
+		(*AuxRound3Message)(nil),
+	}
+)
+
+func init() {
+	proto.RegisterType((*AuxRound1Message)(nil), tss.ECDSAProtoNamePrefix+"cggmp.auxinfo.AuxRound1Message")
+	proto.RegisterType((*AuxRound2Message)(nil), tss.ECDSAProtoNamePrefix+"cggmp.auxinfo.AuxRound2Message")
+	proto.RegisterType((*AuxRound3Message)(nil), tss.ECDSAProtoNamePrefix+"cggmp.auxinfo.AuxRound3Message")
+}
+
+// ----- //
+
+func NewAuxRound1Message(from *tss.PartyID, ct cmt.HashCommitment) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &AuxRound1Message{
+		Commitment: ct.Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *AuxRound1Message) ValidateBasic() bool {
+	return m != nil && common.NonEmptyBytes(m.GetCommitment())
+}
+
+func (m *AuxRound1Message) UnmarshalCommitment() *big.Int {
+	return new(big.Int).SetBytes(m.GetCommitment())
+}
+
+// ----- //
+
+func NewAuxRound2Message(
+	from *tss.PartyID,
+	deCommitment cmt.HashDeCommitment,
+	modProof *paillier.ModProof,
+	prmProof *prmproof.Proof,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &AuxRound2Message{
+		DeCommitment: common.BigIntsToBytes(deCommitment),
+		ModProof:     modProof.Serialize(),
+		PrmProof:     prmProof.Serialize(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *AuxRound2Message) ValidateBasic() bool {
+	return m != nil &&
+		common.NonEmptyMultiBytes(m.GetDeCommitment()) &&
+		common.NonEmptyMultiBytes(m.GetModProof(), paillier.ModProofBytesParts) &&
+		common.NonEmptyMultiBytes(m.GetPrmProof(), prmproof.ProofBytesParts)
+}
+
+func (m *AuxRound2Message) UnmarshalDeCommitment() []*big.Int {
+	return cmt.NewHashDeCommitmentFromBytes(m.GetDeCommitment())
+}
+
+func (m *AuxRound2Message) UnmarshalModProof() (*paillier.ModProof, error) {
+	return paillier.UnmarshalModProof(m.GetModProof())
+}
+
+func (m *AuxRound2Message) UnmarshalPrmProof() (*prmproof.Proof, error) {
+	return prmproof.UnmarshalProof(m.GetPrmProof())
+}
+
+// ----- //
+
+func NewAuxRound3Message(to, from *tss.PartyID, share *big.Int, facProof *paillier.FacProof) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		To:          []*tss.PartyID{to},
+		IsBroadcast: false,
+	}
+	content := &AuxRound3Message{
+		Share: share.Bytes(),
+	}
+	if facProof != nil { // nil in the message kept by the sender
+		content.FacProof = facProof.Serialize()
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *AuxRound3Message) ValidateBasic() bool {
+	return m != nil &&
+		common.NonEmptyBytes(m.GetShare()) &&
+		common.NonEmptyMultiBytes(m.GetFacProof(), paillier.FacProofBytesParts)
+}
+
+func (m *AuxRound3Message) UnmarshalShare() *big.Int {
+	return new(big.Int).SetBytes(m.GetShare())
+}
+
+func (m *AuxRound3Message) UnmarshalFacProof() (*paillier.FacProof, error) {
+	return paillier.UnmarshalFacProof(m.GetFacProof())
+}
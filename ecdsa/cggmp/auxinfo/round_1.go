@@ -0,0 +1,135 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package auxinfo
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+	cmts "github.com/binance-chain/tss-lib/crypto/commitments"
+	"github.com/binance-chain/tss-lib/ecdsa/keygen"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+// round 1 represents round 1 of the CGGMP21 aux-info and key refresh (Canetti, Gennaro, Goldfeder, Makriyannis, Peled; 2021)
+func newRound1(params *tss.Parameters, save *keygen.LocalPartySaveData, temp *localTempData, out chan<- tss.Message, end chan<- keygen.LocalPartySaveData) tss.Round {
+	return &round1{
+		&base{params, save, temp, out, end, make([]bool, len(params.Parties().IDs())), false, 1}}
+}
+
+func (round *round1) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 1
+	round.started = true
+	round.resetOK()
+
+	Pi := round.PartyID()
+	i := Pi.Index
+	Ps := round.Parties().IDs()
+
+	// every holder of a share must take part, as the refresh changes all of the shares
+	if len(round.save.Ks) != len(Ps) || round.save.Xi == nil || round.save.ShareID == nil {
+		return round.WrapError(fmt.Errorf("the key share must be held by exactly the %d parties", len(Ps)), Pi)
+	}
+	for j, Pj := range Ps {
+		if round.save.Ks[j] == nil || round.save.Ks[j].Cmp(Pj.KeyInt()) != 0 || round.save.BigXj[j] == nil {
+			return round.WrapError(fmt.Errorf("party %s does not hold a share of this key", Pj), Pi)
+		}
+	}
+	if round.save.ShareID.Cmp(Pi.KeyInt()) != 0 {
+		return round.WrapError(errors.New("the key share does not belong to this party"), Pi)
+	}
+
+	// 1. use the pre-params if they were provided to the LocalParty constructor
+	// otherwise take them from the default PreParamsPool, if set, or generate them now
+	preParams := round.temp.preParams
+	if preParams == nil {
+		var err error
+		if preParams, err = keygen.GetPreParams(round.SafePrimeGenTimeout(), 3); err != nil {
+			return round.WrapError(errors.New("pre-params generation failed"), Pi)
+		}
+	}
+	round.save.LocalPreParams = *preParams
+	round.save.PaillierPKs[i] = &preParams.PaillierSK.PublicKey
+	round.save.NTildej[i] = preParams.NTildei
+	round.save.H1j[i], round.save.H2j[i] = preParams.H1i, preParams.H2i
+
+	// 2. sample a polynomial f with f(0) = 0, to re-randomize the shares without changing the key
+	q := tss.EC().Params().N
+	modQ := common.ModInt(q)
+	t := round.Threshold()
+	coeffs := make([]*big.Int, t)
+	bigVs := make([]*crypto.ECPoint, t)
+	for c := range coeffs {
+		coeffs[c] = common.GetRandomPositiveInt(q)
+		bigVs[c] = crypto.ScalarBaseMultSecret(tss.EC(), coeffs[c])
+	}
+	shares := make([]*big.Int, len(Ps))
+	for j, Pj := range Ps {
+		kj, z := Pj.KeyInt(), big.NewInt(1)
+		shares[j] = big.NewInt(0)
+		for _, a := range coeffs {
+			z = modQ.Mul(z, kj)
+			shares[j] = modQ.Add(shares[j], modQ.Mul(a, z))
+		}
+	}
+
+	// 3. commit to the Paillier modulus, ring-Pedersen parameters and the polynomial
+	bigVsFlat, err := crypto.FlattenECPoints(bigVs)
+	if err != nil {
+		return round.WrapError(err, Pi)
+	}
+	secrets, err := cmts.NewBuilder().
+		AddPart([]*big.Int{preParams.PaillierSK.N, preParams.NTildei, preParams.H1i, preParams.H2i}).
+		AddPart(bigVsFlat).
+		Secrets()
+	if err != nil {
+		return round.WrapError(err, Pi)
+	}
+	cmt := cmts.NewHashCommitment(secrets...)
+
+	round.temp.coeffs = coeffs
+	round.temp.shares = shares
+	round.temp.deCommit = cmt.D
+	round.temp.bigVjs[i] = bigVs
+
+	// BROADCAST the commitment
+	msg := NewAuxRound1Message(Pi, cmt.C)
+	round.temp.auxRound1Messages[i] = msg
+	round.out <- msg
+	return nil
+}
+
+func (round *round1) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*AuxRound1Message); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round1) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.auxRound1Messages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round1) NextRound() tss.Round {
+	round.started = false
+	return &round2{round}
+}
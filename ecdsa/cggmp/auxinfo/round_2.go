@@ -0,0 +1,76 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package auxinfo
+
+import (
+	"errors"
+
+	"github.com/binance-chain/tss-lib/crypto/prmproof"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+func (round *round2) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 2
+	round.started = true
+	round.resetOK()
+
+	Pi := round.PartyID()
+	i := Pi.Index
+
+	// 1. store the commitments
+	for j, msg := range round.temp.auxRound1Messages {
+		r1msg := msg.Content().(*AuxRound1Message)
+		round.temp.KGCs[j] = r1msg.UnmarshalCommitment()
+	}
+
+	// 2. prove that the Paillier modulus is a product of two primes = 3 mod 4 (Πmod) and that the ring-Pedersen
+	// parameters are well formed (Πprm)
+	pp := round.save.LocalPreParams
+	modProof, err := pp.PaillierSK.ModProof(Pi.KeyInt())
+	if err != nil {
+		return round.WrapError(err, Pi)
+	}
+	prmProof, err := prmproof.NewProof(pp.H2i, pp.H1i, pp.Alpha, pp.P, pp.Q, pp.NTildei, Pi.KeyInt())
+	if err != nil {
+		return round.WrapError(err, Pi)
+	}
+
+	// BROADCAST the de-commitment and the proofs
+	r2msg := NewAuxRound2Message(Pi, round.temp.deCommit, modProof, prmProof)
+	round.temp.auxRound2Messages[i] = r2msg
+	round.out <- r2msg
+	return nil
+}
+
+func (round *round2) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*AuxRound2Message); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round2) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.auxRound2Messages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		// proof checks are in round 3
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round2) NextRound() tss.Round {
+	round.started = false
+	return &round3{round}
+}
@@ -0,0 +1,192 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package auxinfo
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"sync"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/binance-chain/tss-lib/crypto"
+	cmts "github.com/binance-chain/tss-lib/crypto/commitments"
+	"github.com/binance-chain/tss-lib/crypto/paillier"
+	"github.com/binance-chain/tss-lib/ecdsa/keygen"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+func (round *round3) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 3
+	round.started = true
+	round.resetOK()
+
+	Ps := round.Parties().IDs()
+	Pi := round.PartyID()
+	i := Pi.Index
+
+	// 1. open the commitments and ensure the uniqueness of h1j, h2j
+	type opened struct {
+		N, NTilde, H1, H2 *big.Int
+		bigVs             []*crypto.ECPoint
+	}
+	openings := make([]opened, len(Ps))
+	h1H2Map := make(map[string]struct{}, len(Ps)*2)
+	for j, Pj := range Ps {
+		r2msg := round.temp.auxRound2Messages[j].Content().(*AuxRound2Message)
+		cmtDeCmt := cmts.HashCommitDecommit{C: round.temp.KGCs[j], D: r2msg.UnmarshalDeCommitment()}
+		ok, secrets := cmtDeCmt.DeCommit()
+		if !ok || secrets == nil {
+			return round.WrapError(errors.New("de-commitment verify failed"), Pj)
+		}
+		parts, err := cmts.ParseSecrets(secrets)
+		if err != nil || len(parts) != 2 || len(parts[0]) != 4 || len(parts[1]) != 2*round.Threshold() {
+			return round.WrapError(errors.New("de-commitment has an unexpected format"), Pj)
+		}
+		bigVs, err := crypto.UnFlattenECPoints(tss.EC(), parts[1])
+		if err != nil {
+			return round.WrapError(errors.New("the refresh polynomial commitment is invalid"), Pj)
+		}
+		o := opened{parts[0][0], parts[0][1], parts[0][2], parts[0][3], bigVs}
+		if j != i {
+			if err := keygen.CheckPeerModuli(round.Params(), &paillier.PublicKey{N: o.N}, o.NTilde, o.H1, o.H2); err != nil {
+				return round.WrapError(err, Pj)
+			}
+		}
+		if o.H1.Cmp(o.H2) == 0 {
+			return round.WrapError(errors.New("h1j and h2j were equal for this party"), Pj)
+		}
+		h1JHex, h2JHex := hex.EncodeToString(o.H1.Bytes()), hex.EncodeToString(o.H2.Bytes())
+		if _, found := h1H2Map[h1JHex]; found {
+			return round.WrapError(errors.New("this h1j was already used by another party"), Pj)
+		}
+		if _, found := h1H2Map[h2JHex]; found {
+			return round.WrapError(errors.New("this h2j was already used by another party"), Pj)
+		}
+		h1H2Map[h1JHex], h1H2Map[h2JHex] = struct{}{}, struct{}{}
+		openings[j] = o
+	}
+
+	// 2. verify the Paillier modulus (Πmod) and ring-Pedersen parameter (Πprm) proofs (concurrent)
+	errs := make([]error, len(Ps))
+	wg := new(sync.WaitGroup)
+	verifiers := round.VerifierPool()
+	for j, Pj := range Ps {
+		if j == i {
+			continue
+		}
+		j, Pj, r2msg, o := j, Pj, round.temp.auxRound2Messages[j].Content().(*AuxRound2Message), openings[j]
+		wg.Add(1)
+		verifiers.Go(func() {
+			defer wg.Done()
+			if modProof, err := r2msg.UnmarshalModProof(); err != nil || !modProof.Verify(o.N, Pj.KeyInt()) {
+				errs[j] = errors.New("paillier modulus proof verification failed")
+				return
+			}
+			if prmProof, err := r2msg.UnmarshalPrmProof(); err != nil || !prmProof.Verify(o.H2, o.H1, o.NTilde, Pj.KeyInt()) {
+				errs[j] = errors.New("ring-Pedersen parameter proof verification failed")
+			}
+		})
+	}
+	wg.Wait()
+	if err := round.collectErrors(errs); err != nil {
+		return err
+	}
+
+	// save the new public aux-info of every Pj
+	for j, o := range openings {
+		if j == i {
+			continue
+		}
+		round.save.PaillierPKs[j] = &paillier.PublicKey{N: o.N}
+		round.save.NTildej[j] = o.NTilde
+		round.save.H1j[j], round.save.H2j[j] = o.H1, o.H2
+		round.temp.bigVjs[j] = o.bigVs
+	}
+
+	// 3. prove to each Pj that our Paillier modulus has no small factors (Πfac), with Pj's NTilde, h1, h2
+	facProofs := make([]*paillier.FacProof, len(Ps))
+	facProofErrs := make([]error, len(Ps))
+	for j := range Ps {
+		if j == i {
+			continue
+		}
+		wg.Add(1)
+		go func(j int) {
+			defer wg.Done()
+			facProofs[j], facProofErrs[j] = round.save.PaillierSK.FacProof(
+				round.save.NTildej[j], round.save.H1j[j], round.save.H2j[j], tss.EC().Params().N, Pi.KeyInt())
+		}(j)
+	}
+	wg.Wait()
+	for _, err := range facProofErrs {
+		if err != nil {
+			return round.WrapError(err, Pi)
+		}
+	}
+
+	// 4. P2P send the share of zero and the proof to each Pj
+	for j, Pj := range Ps {
+		r3msg := NewAuxRound3Message(Pj, Pi, round.temp.shares[j], facProofs[j])
+		// do not send to this Pj, but store for round 4
+		if j == i {
+			round.temp.auxRound3Messages[j] = r3msg
+			continue
+		}
+		round.out <- r3msg
+	}
+	return nil
+}
+
+func (round *round3) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*AuxRound3Message); ok {
+		return !msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round3) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.auxRound3Messages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		// proof and share checks are in round 4
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round3) NextRound() tss.Round {
+	round.started = false
+	return &round4{round}
+}
+
+// ----- //
+
+// collectErrors wraps the errors of the parties that failed a check, naming them as the culprits
+func (round *base) collectErrors(errs []error) *tss.Error {
+	var multiErr error
+	culprits := make([]*tss.PartyID, 0, len(errs)) // who caused the error(s)
+	for j, err := range errs {
+		if err == nil {
+			continue
+		}
+		culprits = append(culprits, round.Parties().IDs()[j])
+		multiErr = multierror.Append(multiErr, err)
+	}
+	if len(culprits) > 0 {
+		return round.WrapError(multiErr, culprits...)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package auxinfo
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+func (round *round4) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 4
+	round.started = true
+	round.resetOK()
+
+	Ps := round.Parties().IDs()
+	Pi := round.PartyID()
+	i := Pi.Index
+	modQ := common.ModInt(tss.EC().Params().N)
+
+	// 1. verify the Πfac proofs, made with our NTilde, h1, h2, and the shares of zero against the commitments of Pj
+	errs := make([]error, len(Ps))
+	wg := new(sync.WaitGroup)
+	verifiers := round.VerifierPool()
+	for j, Pj := range Ps {
+		round.ok[j] = true
+		if j == i {
+			continue
+		}
+		j, Pj, r3msg := j, Pj, round.temp.auxRound3Messages[j].Content().(*AuxRound3Message)
+		wg.Add(1)
+		verifiers.Go(func() {
+			defer wg.Done()
+			if facProof, err := r3msg.UnmarshalFacProof(); err != nil || !facProof.Verify(
+				round.save.PaillierPKs[j].N, round.save.NTildei, round.save.H1i, round.save.H2i,
+				tss.EC().Params().N, Pj.KeyInt()) {
+				errs[j] = errors.New("paillier no small factor proof verification failed")
+				return
+			}
+			expected, err := evalCommitment(round.temp.bigVjs[j], Pi.KeyInt())
+			if err != nil || !crypto.ScalarBaseMultSecret(tss.EC(), r3msg.UnmarshalShare()).Equals(expected) {
+				errs[j] = errors.New("refresh share verify failed")
+			}
+		})
+	}
+	wg.Wait()
+	if err := round.collectErrors(errs); err != nil {
+		return err
+	}
+
+	// 2. add the shares of zero to xi, and the matching public values to each Xj
+	xi := round.save.Xi
+	for j := range Ps {
+		r3msg := round.temp.auxRound3Messages[j].Content().(*AuxRound3Message)
+		xi = modQ.Add(xi, r3msg.UnmarshalShare())
+	}
+	common.Zeroize(round.save.Xi)
+	round.save.Xi = xi
+	for k, Pk := range Ps {
+		bigXk := round.save.BigXj[k]
+		for j := range Ps {
+			delta, err := evalCommitment(round.temp.bigVjs[j], Pk.KeyInt())
+			if err != nil {
+				return round.WrapError(err, Ps[j])
+			}
+			if bigXk, err = bigXk.Add(delta); err != nil {
+				return round.WrapError(errors.New("refreshing Xj resulted in a point not on the curve"), Ps[j])
+			}
+		}
+		round.save.BigXj[k] = bigXk
+	}
+	if !crypto.ScalarBaseMultSecret(tss.EC(), round.save.Xi).Equals(round.save.BigXj[i]) {
+		return round.WrapError(errors.New("the refreshed key share does not match its public key share"))
+	}
+
+	round.end <- *round.save
+
+	return nil
+}
+
+func (round *round4) CanAccept(msg tss.ParsedMessage) bool {
+	// not expecting any incoming messages in this round
+	return false
+}
+
+func (round *round4) Update() (bool, *tss.Error) {
+	// not expecting any incoming messages in this round
+	return false, nil
+}
+
+func (round *round4) NextRound() tss.Round {
+	return nil // finished!
+}
+
+// ----- //
+
+// evalCommitment returns f(k)*G for the polynomial f with f(0) = 0 whose coefficients are committed to in bigVs
+func evalCommitment(bigVs []*crypto.ECPoint, k *big.Int) (*crypto.ECPoint, error) {
+	modQ := common.ModInt(tss.EC().Params().N)
+	var result *crypto.ECPoint
+	z := big.NewInt(1)
+	for _, bigV := range bigVs {
+		z = modQ.Mul(z, k)
+		term := bigV.ScalarMult(z)
+		if result == nil {
+			result = term
+			continue
+		}
+		var err error
+		if result, err = result.Add(term); err != nil {
+			return nil, err
+		}
+	}
+	if result == nil {
+		return nil, errors.New("the refresh polynomial commitment is empty")
+	}
+	return result, nil
+}
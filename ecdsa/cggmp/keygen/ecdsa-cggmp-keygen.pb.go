@@ -0,0 +1,205 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: protob/ecdsa-cggmp-keygen.proto
+
+package keygen
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// Represents a BROADCAST message sent to all parties during Round 1 of the CGGMP21 ECDSA key generation.
+type KGRound1Message struct {
+	Commitment           []byte   `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KGRound1Message) Reset()         { *m = KGRound1Message{} }
+func (m *KGRound1Message) String() string { return proto.CompactTextString(m) }
+func (*KGRound1Message) ProtoMessage()    {}
+func (*KGRound1Message) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a001b88e38cc1a6, []int{0}
+}
+
+func (m *KGRound1Message) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KGRound1Message.Unmarshal(m, b)
+}
+func (m *KGRound1Message) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KGRound1Message.Marshal(b, m, deterministic)
+}
+func (m *KGRound1Message) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KGRound1Message.Merge(m, src)
+}
+func (m *KGRound1Message) XXX_Size() int {
+	return xxx_messageInfo_KGRound1Message.Size(m)
+}
+func (m *KGRound1Message) XXX_DiscardUnknown() {
+	xxx_messageInfo_KGRound1Message.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KGRound1Message proto.InternalMessageInfo
+
+func (m *KGRound1Message) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+// Represents a P2P message sent to each party during Round 2 of the CGGMP21 ECDSA key generation.
+type KGRound2Message1 struct {
+	Share                []byte   `protobuf:"bytes,1,opt,name=share,proto3" json:"share,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KGRound2Message1) Reset()         { *m = KGRound2Message1{} }
+func (m *KGRound2Message1) String() string { return proto.CompactTextString(m) }
+func (*KGRound2Message1) ProtoMessage()    {}
+func (*KGRound2Message1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a001b88e38cc1a6, []int{1}
+}
+
+func (m *KGRound2Message1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KGRound2Message1.Unmarshal(m, b)
+}
+func (m *KGRound2Message1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KGRound2Message1.Marshal(b, m, deterministic)
+}
+func (m *KGRound2Message1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KGRound2Message1.Merge(m, src)
+}
+func (m *KGRound2Message1) XXX_Size() int {
+	return xxx_messageInfo_KGRound2Message1.Size(m)
+}
+func (m *KGRound2Message1) XXX_DiscardUnknown() {
+	xxx_messageInfo_KGRound2Message1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KGRound2Message1 proto.InternalMessageInfo
+
+func (m *KGRound2Message1) GetShare() []byte {
+	if m != nil {
+		return m.Share
+	}
+	return nil
+}
+
+// Represents a BROADCAST message sent to all parties during Round 2 of the CGGMP21 ECDSA key generation.
+type KGRound2Message2 struct {
+	DeCommitment         [][]byte `protobuf:"bytes,1,rep,name=de_commitment,json=deCommitment,proto3" json:"de_commitment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KGRound2Message2) Reset()         { *m = KGRound2Message2{} }
+func (m *KGRound2Message2) String() string { return proto.CompactTextString(m) }
+func (*KGRound2Message2) ProtoMessage()    {}
+func (*KGRound2Message2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a001b88e38cc1a6, []int{2}
+}
+
+func (m *KGRound2Message2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KGRound2Message2.Unmarshal(m, b)
+}
+func (m *KGRound2Message2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KGRound2Message2.Marshal(b, m, deterministic)
+}
+func (m *KGRound2Message2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KGRound2Message2.Merge(m, src)
+}
+func (m *KGRound2Message2) XXX_Size() int {
+	return xxx_messageInfo_KGRound2Message2.Size(m)
+}
+func (m *KGRound2Message2) XXX_DiscardUnknown() {
+	xxx_messageInfo_KGRound2Message2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KGRound2Message2 proto.InternalMessageInfo
+
+func (m *KGRound2Message2) GetDeCommitment() [][]byte {
+	if m != nil {
+		return m.DeCommitment
+	}
+	return nil
+}
+
+// Represents a BROADCAST message sent to all parties during Round 3 of the CGGMP21 ECDSA key generation.
+type KGRound3Message struct {
+	Psi                  []byte   `protobuf:"bytes,1,opt,name=psi,proto3" json:"psi,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KGRound3Message) Reset()         { *m = KGRound3Message{} }
+func (m *KGRound3Message) String() string { return proto.CompactTextString(m) }
+func (*KGRound3Message) ProtoMessage()    {}
+func (*KGRound3Message) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a001b88e38cc1a6, []int{3}
+}
+
+func (m *KGRound3Message) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KGRound3Message.Unmarshal(m, b)
+}
+func (m *KGRound3Message) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KGRound3Message.Marshal(b, m, deterministic)
+}
+func (m *KGRound3Message) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KGRound3Message.Merge(m, src)
+}
+func (m *KGRound3Message) XXX_Size() int {
+	return xxx_messageInfo_KGRound3Message.Size(m)
+}
+func (m *KGRound3Message) XXX_DiscardUnknown() {
+	xxx_messageInfo_KGRound3Message.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KGRound3Message proto.InternalMessageInfo
+
+func (m *KGRound3Message) GetPsi() []byte {
+	if m != nil {
+		return m.Psi
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*KGRound1Message)(nil), "KGRound1Message")
+	proto.RegisterType((*KGRound2Message1)(nil), "KGRound2Message1")
+	proto.RegisterType((*KGRound2Message2)(nil), "KGRound2Message2")
+	proto.RegisterType((*KGRound3Message)(nil), "KGRound3Message")
+}
+
+func init() { proto.RegisterFile("protob/ecdsa-cggmp-keygen.proto", fileDescriptor_9a001b88e38cc1a6) }
+
+var fileDescriptor_9a001b88e38cc1a6 = []byte{
+	// 174 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2f, 0x28, 0xca, 0x2f,
+	0xc9, 0x4f, 0xd2, 0x4f, 0x4d, 0x4e, 0x29, 0x4e, 0xd4, 0x4d, 0x4e, 0x4f, 0xcf, 0x2d, 0xd0, 0xcd,
+	0x4e, 0xad, 0x4c, 0x4f, 0xcd, 0xd3, 0x03, 0xcb, 0x28, 0x19, 0x72, 0xf1, 0x7b, 0xbb, 0x07, 0xe5,
+	0x97, 0xe6, 0xa5, 0x18, 0xfa, 0xa6, 0x16, 0x17, 0x27, 0xa6, 0xa7, 0x0a, 0xc9, 0x71, 0x71, 0x25,
+	0xe7, 0xe7, 0xe6, 0x66, 0x96, 0xe4, 0xa6, 0xe6, 0x95, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0xf0, 0x04,
+	0x21, 0x89, 0x28, 0x69, 0x70, 0x09, 0x40, 0xb5, 0x18, 0x41, 0xb5, 0x18, 0x0a, 0x89, 0x70, 0xb1,
+	0x16, 0x67, 0x24, 0x16, 0xa5, 0x42, 0x95, 0x43, 0x38, 0x4a, 0xe6, 0x18, 0x2a, 0x8d, 0x84, 0x94,
+	0xb9, 0x78, 0x53, 0x52, 0xe3, 0x51, 0x2c, 0x60, 0xd6, 0xe0, 0x09, 0xe2, 0x49, 0x49, 0x75, 0x46,
+	0x58, 0xa1, 0x0c, 0x77, 0x95, 0x31, 0xcc, 0x55, 0x02, 0x5c, 0xcc, 0x05, 0xc5, 0x99, 0x50, 0xf3,
+	0x41, 0x4c, 0x27, 0x91, 0x28, 0x21, 0xb0, 0xb7, 0xf4, 0xc1, 0xde, 0xd2, 0x87, 0x78, 0x2b, 0x89,
+	0x0d, 0xec, 0x2f, 0x63, 0xc0, 0x00, 0x66, 0x40, 0x17, 0xc0, 0xfa, 0x00, 0x00, 0x00,
+}
@@ -0,0 +1,65 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/binance-chain/tss-lib/crypto"
+	ecdsakeygen "github.com/binance-chain/tss-lib/ecdsa/keygen"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+// ImportGG18 checks that a key share made by the GG18 key generation in `ecdsa/keygen` is complete and consistent
+// and returns a copy of it that may be used with the protocols of `ecdsa/cggmp`. Shares of the same key are
+// compatible, as both suites use a Feldman VSS of the secret key indexed by the party keys.
+//
+// The Paillier keys and ring-Pedersen parameters of a GG18 key share were not proven with Πmod and Πprm; run the
+// aux-info protocol in `auxinfo` on the imported shares to replace them before presigning.
+func ImportGG18(key ecdsakeygen.LocalPartySaveData) (ecdsakeygen.LocalPartySaveData, error) {
+	n := len(key.Ks)
+	if n == 0 || key.Xi == nil || key.ShareID == nil || key.ECDSAPub == nil || !key.ECDSAPub.ValidateBasic() {
+		return key, errors.New("ImportGG18: the key share is incomplete")
+	}
+	if len(key.BigXj) != n || len(key.NTildej) != n || len(key.H1j) != n || len(key.H2j) != n ||
+		len(key.PaillierPKs) != n {
+		return key, errors.New("ImportGG18: the key share has inconsistent party counts")
+	}
+	if !key.LocalPreParams.Validate() {
+		return key, errors.New("ImportGG18: the key share is missing its Paillier key or ring-Pedersen parameters")
+	}
+	i, err := key.OriginalIndex()
+	if err != nil {
+		return key, fmt.Errorf("ImportGG18: %v", err)
+	}
+	for j := 0; j < n; j++ {
+		if key.Ks[j] == nil || key.BigXj[j] == nil || !key.BigXj[j].ValidateBasic() || key.PaillierPKs[j] == nil ||
+			key.NTildej[j] == nil || key.H1j[j] == nil || key.H2j[j] == nil {
+			return key, fmt.Errorf("ImportGG18: the key share is missing the public data of party %d", j)
+		}
+	}
+	if !crypto.ScalarBaseMultSecret(tss.EC(), key.Xi).Equals(key.BigXj[i]) {
+		return key, errors.New("ImportGG18: Xi does not match the public key share BigXj[i]")
+	}
+
+	imported := ecdsakeygen.NewLocalPartySaveData(n)
+	imported.LocalPreParams = key.LocalPreParams
+	imported.LocalSecrets = ecdsakeygen.LocalSecrets{
+		Xi:      new(big.Int).Set(key.Xi),
+		ShareID: new(big.Int).Set(key.ShareID),
+	}
+	imported.ECDSAPub = key.ECDSAPub
+	copy(imported.Ks, key.Ks)
+	copy(imported.NTildej, key.NTildej)
+	copy(imported.H1j, key.H1j)
+	copy(imported.H2j, key.H2j)
+	copy(imported.BigXj, key.BigXj)
+	copy(imported.PaillierPKs, key.PaillierPKs)
+	return imported, nil
+}
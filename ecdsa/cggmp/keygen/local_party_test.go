@@ -0,0 +1,144 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"fmt"
+	"math/big"
+	"runtime"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ipfs/go-log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+	"github.com/binance-chain/tss-lib/crypto/vss"
+	ecdsakeygen "github.com/binance-chain/tss-lib/ecdsa/keygen"
+	"github.com/binance-chain/tss-lib/test"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+const (
+	testParticipants = 5
+	testThreshold    = 2
+)
+
+func setUp(level string) {
+	if err := log.SetLogLevel("tss-lib", level); err != nil {
+		panic(err)
+	}
+}
+
+func TestE2EConcurrent(t *testing.T) {
+	setUp("info")
+
+	pIDs := tss.GenerateTestPartyIDs(testParticipants)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	parties := make([]*LocalParty, 0, len(pIDs))
+
+	errCh := make(chan *tss.Error, len(pIDs))
+	outCh := make(chan tss.Message, len(pIDs))
+	endCh := make(chan ecdsakeygen.LocalPartySaveData, len(pIDs))
+
+	updater := test.SharedPartyUpdater
+
+	// init the parties
+	for i := 0; i < len(pIDs); i++ {
+		params := tss.NewParameters(p2pCtx, pIDs[i], len(pIDs), testThreshold)
+		P := NewLocalParty(params, outCh, endCh).(*LocalParty)
+		parties = append(parties, P)
+		go func(P *LocalParty) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+
+	saves := make([]ecdsakeygen.LocalPartySaveData, len(pIDs))
+	var ended int32
+keygen:
+	for {
+		fmt.Printf("ACTIVE GOROUTINES: %d\n", runtime.NumGoroutine())
+		select {
+		case err := <-errCh:
+			common.Logger.Errorf("Error: %s", err)
+			assert.FailNow(t, err.Error())
+			break keygen
+
+		case msg := <-outCh:
+			dest := msg.GetTo()
+			if dest == nil {
+				for _, P := range parties {
+					if P.PartyID().Index == msg.GetFrom().Index {
+						continue
+					}
+					go updater(P, msg, errCh)
+				}
+			} else {
+				if dest[0].Index == msg.GetFrom().Index {
+					t.Fatalf("party %d tried to send a message to itself (%d)", dest[0].Index, msg.GetFrom().Index)
+				}
+				go updater(parties[dest[0].Index], msg, errCh)
+			}
+
+		case save := <-endCh:
+			index, err := save.OriginalIndex()
+			assert.NoErrorf(t, err, "should not be an error getting a party's index from save data")
+			saves[index] = save
+			atomic.AddInt32(&ended, 1)
+			if atomic.LoadInt32(&ended) == int32(len(pIDs)) {
+				t.Logf("Done. Received save data from %d participants", ended)
+				break keygen
+			}
+		}
+	}
+
+	// every party agrees on the public data and its share matches its public key share
+	shares := make(vss.Shares, 0, len(saves))
+	for i, save := range saves {
+		assert.True(t, save.ECDSAPub.Equals(saves[0].ECDSAPub))
+		for j := range saves {
+			assert.True(t, save.BigXj[j].Equals(saves[0].BigXj[j]))
+		}
+		assert.True(t, crypto.ScalarBaseMult(tss.EC(), save.Xi).Equals(save.BigXj[i]))
+		shares = append(shares, &vss.Share{Threshold: testThreshold, ID: save.ShareID, Share: save.Xi})
+	}
+	// any t+1 shares reconstruct the secret key
+	x, err := shares[:testThreshold+1].ReConstruct()
+	assert.NoError(t, err)
+	assert.True(t, crypto.ScalarBaseMult(tss.EC(), x).Equals(saves[0].ECDSAPub))
+	x2, err := shares[len(shares)-testThreshold-1:].ReConstruct()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, x.Cmp(x2))
+}
+
+func TestImportGG18(t *testing.T) {
+	keys, _, err := ecdsakeygen.LoadKeygenTestFixtures(2)
+	if !assert.NoError(t, err) {
+		return
+	}
+	imported, err := ImportGG18(keys[0])
+	assert.NoError(t, err)
+	assert.Equal(t, 0, imported.Xi.Cmp(keys[0].Xi))
+	assert.True(t, imported.ECDSAPub.Equals(keys[0].ECDSAPub))
+	imported.Xi.SetInt64(0)
+	assert.NotEqual(t, 0, keys[0].Xi.Sign(), "the imported share must not alias the original")
+
+	// a share that does not match its public key share is rejected
+	bad := keys[1]
+	bad.Xi = new(big.Int).Add(keys[1].Xi, big.NewInt(1))
+	_, err = ImportGG18(bad)
+	assert.Error(t, err)
+
+	// a share without Paillier material is rejected
+	bad = keys[1]
+	bad.PaillierSK = nil
+	_, err = ImportGG18(bad)
+	assert.Error(t, err)
+}
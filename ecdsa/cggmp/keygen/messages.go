@@ -0,0 +1,127 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/binance-chain/tss-lib/common"
+	cmt "github.com/binance-chain/tss-lib/crypto/commitments"
+	"github.com/binance-chain/tss-lib/crypto/vss"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+// These messages were generated from Protocol Buffers definitions into ecdsa-cggmp-keygen.pb.go
+// The following messages are registered on the Protocol Buffers "wire"
+
+var (
+	// Ensure that keygen messages implement ValidateBasic
+	_ = []tss.MessageContent{
+		(*KGRound1Message)(nil),
+		(*KGRound2Message1)(nil),
+		(*KGRound2Message2)(nil),
+		(*KGRound3Message)(nil),
+	}
+)
+
+func init() {
+	proto.RegisterType((*KGRound1Message)(nil), tss.ECDSAProtoNamePrefix+"cggmp.keygen.KGRound1Message")
+	proto.RegisterType((*KGRound2Message1)(nil), tss.ECDSAProtoNamePrefix+"cggmp.keygen.KGRound2Message1")
+	proto.RegisterType((*KGRound2Message2)(nil), tss.ECDSAProtoNamePrefix+"cggmp.keygen.KGRound2Message2")
+	proto.RegisterType((*KGRound3Message)(nil), tss.ECDSAProtoNamePrefix+"cggmp.keygen.KGRound3Message")
+}
+
+// ----- //
+
+func NewKGRound1Message(from *tss.PartyID, ct cmt.HashCommitment) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &KGRound1Message{
+		Commitment: ct.Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *KGRound1Message) ValidateBasic() bool {
+	return m != nil && common.NonEmptyBytes(m.GetCommitment())
+}
+
+func (m *KGRound1Message) UnmarshalCommitment() *big.Int {
+	return new(big.Int).SetBytes(m.GetCommitment())
+}
+
+// ----- //
+
+func NewKGRound2Message1(to, from *tss.PartyID, share *vss.Share) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		To:          []*tss.PartyID{to},
+		IsBroadcast: false,
+	}
+	content := &KGRound2Message1{
+		Share: share.Share.Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *KGRound2Message1) ValidateBasic() bool {
+	return m != nil && common.NonEmptyBytes(m.GetShare())
+}
+
+func (m *KGRound2Message1) UnmarshalShare() *big.Int {
+	return new(big.Int).SetBytes(m.GetShare())
+}
+
+// ----- //
+
+func NewKGRound2Message2(from *tss.PartyID, deCommitment cmt.HashDeCommitment) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &KGRound2Message2{
+		DeCommitment: common.BigIntsToBytes(deCommitment),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *KGRound2Message2) ValidateBasic() bool {
+	return m != nil && common.NonEmptyMultiBytes(m.GetDeCommitment())
+}
+
+func (m *KGRound2Message2) UnmarshalDeCommitment() []*big.Int {
+	return cmt.NewHashDeCommitmentFromBytes(m.GetDeCommitment())
+}
+
+// ----- //
+
+func NewKGRound3Message(from *tss.PartyID, psi *big.Int) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &KGRound3Message{
+		Psi: psi.Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *KGRound3Message) ValidateBasic() bool {
+	return m != nil && common.NonEmptyBytes(m.GetPsi())
+}
+
+func (m *KGRound3Message) UnmarshalPsi() *big.Int {
+	return new(big.Int).SetBytes(m.GetPsi())
+}
@@ -0,0 +1,115 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+	cmts "github.com/binance-chain/tss-lib/crypto/commitments"
+	"github.com/binance-chain/tss-lib/crypto/vss"
+	ecdsakeygen "github.com/binance-chain/tss-lib/ecdsa/keygen"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+// ridBits is the length of the random identifier that the parties agree on; it seeds the Schnorr challenges
+const ridBits = 256
+
+// round 1 represents round 1 of the CGGMP21 key generation (Canetti, Gennaro, Goldfeder, Makriyannis, Peled; 2021)
+func newRound1(params *tss.Parameters, save *ecdsakeygen.LocalPartySaveData, temp *localTempData, out chan<- tss.Message, end chan<- ecdsakeygen.LocalPartySaveData) tss.Round {
+	return &round1{
+		&base{params, save, temp, out, end, make([]bool, len(params.Parties().IDs())), false, 1}}
+}
+
+func (round *round1) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 1
+	round.started = true
+	round.resetOK()
+
+	Pi := round.PartyID()
+	i := Pi.Index
+
+	if err := ecdsakeygen.CheckCurve(round.Params(), tss.EC()); err != nil {
+		return round.WrapError(err, Pi)
+	}
+
+	// 1. calculate "partial" key share ui and deal it with the vss
+	q := tss.EC().Params().N
+	ui := common.GetRandomPositiveInt(q)
+	ids := round.Parties().IDs().Keys()
+	vs, shares, err := vss.Create(round.Threshold(), ui, ids)
+	if err != nil {
+		return round.WrapError(err, Pi)
+	}
+	round.save.Ks = ids
+	round.save.ShareID = ids[i]
+
+	// 2. sample our part of rid and the nonce of the Schnorr proof for X_i = vs[0]
+	rid := common.MustGetRandomInt(ridBits)
+	tau := common.GetRandomPositiveInt(q)
+	bigA := crypto.ScalarBaseMultSecret(tss.EC(), tau)
+
+	// 3. commit to the vss polynomial, rid and A
+	pGFlat, err := crypto.FlattenECPoints(vs)
+	if err != nil {
+		return round.WrapError(err, Pi)
+	}
+	secrets, err := cmts.NewBuilder().
+		AddPart(pGFlat).
+		AddPart([]*big.Int{rid}).
+		AddPart([]*big.Int{bigA.X(), bigA.Y()}).
+		Secrets()
+	if err != nil {
+		return round.WrapError(err, Pi)
+	}
+	cmt := cmts.NewHashCommitment(secrets...)
+
+	round.temp.ui = ui
+	round.temp.tau = tau
+	round.temp.rid = rid
+	round.temp.vs = vs
+	round.temp.shares = shares
+	round.temp.deCommit = cmt.D
+	round.temp.pjVs[i] = vs
+	round.temp.bigAjs[i] = bigA
+
+	// BROADCAST the commitment
+	msg := NewKGRound1Message(Pi, cmt.C)
+	round.temp.kgRound1Messages[i] = msg
+	round.out <- msg
+	return nil
+}
+
+func (round *round1) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*KGRound1Message); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round1) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.kgRound1Messages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round1) NextRound() tss.Round {
+	round.started = false
+	return &round2{round}
+}
@@ -0,0 +1,218 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/hashicorp/go-multierror"
+	errors2 "github.com/pkg/errors"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+	cmts "github.com/binance-chain/tss-lib/crypto/commitments"
+	"github.com/binance-chain/tss-lib/crypto/vss"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+func (round *round3) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 3
+	round.started = true
+	round.resetOK()
+
+	Ps := round.Parties().IDs()
+	Pi := round.PartyID()
+	i := Pi.Index
+	q := tss.EC().Params().N
+
+	// 1-2. open the commitments of the other parties and verify their shares (concurrent)
+	type openOut struct {
+		unWrappedErr error
+		pjVs         vss.Vs
+		rid          *big.Int
+		bigA         *crypto.ECPoint
+	}
+	chs := make([]chan openOut, len(Ps))
+	verifiers := round.VerifierPool()
+	for j := range Ps {
+		if j == i {
+			continue
+		}
+		chs[j] = make(chan openOut, 1)
+		j, ch := j, chs[j]
+		verifiers.Go(func() {
+			r2msg2 := round.temp.kgRound2Message2s[j].Content().(*KGRound2Message2)
+			cmtDeCmt := cmts.HashCommitDecommit{C: round.temp.KGCs[j], D: r2msg2.UnmarshalDeCommitment()}
+			ok, secrets := cmtDeCmt.DeCommit()
+			if !ok || secrets == nil {
+				ch <- openOut{unWrappedErr: errors.New("de-commitment verify failed")}
+				return
+			}
+			parts, err := cmts.ParseSecrets(secrets)
+			if err != nil || len(parts) != 3 || len(parts[1]) != 1 || len(parts[2]) != 2 {
+				ch <- openOut{unWrappedErr: errors.New("de-commitment has an unexpected format")}
+				return
+			}
+			PjVs, err := crypto.UnFlattenECPoints(tss.EC(), parts[0])
+			if err != nil || len(PjVs) != round.Threshold()+1 {
+				ch <- openOut{unWrappedErr: errors.New("the vss polynomial commitment is invalid")}
+				return
+			}
+			bigAj, err := crypto.NewECPoint(tss.EC(), parts[2][0], parts[2][1])
+			if err != nil {
+				ch <- openOut{unWrappedErr: errors2.Wrapf(err, "the Schnorr commitment is not on the curve")}
+				return
+			}
+			r2msg1 := round.temp.kgRound2Message1s[j].Content().(*KGRound2Message1)
+			PjShare := vss.Share{
+				Threshold: round.Threshold(),
+				ID:        Pi.KeyInt(),
+				Share:     r2msg1.UnmarshalShare(),
+			}
+			if !PjShare.Verify(round.Threshold(), PjVs) {
+				ch <- openOut{unWrappedErr: errors.New("vss verify failed")}
+				return
+			}
+			ch <- openOut{nil, PjVs, parts[1][0], bigAj}
+		})
+	}
+
+	// consume the channels (end the goroutines)
+	results := make([]openOut, len(Ps))
+	{
+		var multiErr error
+		culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
+		for j, Pj := range Ps {
+			if j == i {
+				continue
+			}
+			results[j] = <-chs[j]
+			if err := results[j].unWrappedErr; err != nil {
+				culprits = append(culprits, Pj)
+				multiErr = multierror.Append(multiErr, err)
+			}
+		}
+		if len(culprits) > 0 {
+			return round.WrapError(multiErr, culprits...)
+		}
+	}
+
+	// 3. rid is the XOR of all of the contributions
+	rid := new(big.Int).Set(round.temp.rid)
+	for j := range Ps {
+		if j == i {
+			continue
+		}
+		rid.Xor(rid, results[j].rid)
+		round.temp.pjVs[j] = results[j].pjVs
+		round.temp.bigAjs[j] = results[j].bigA
+	}
+	round.temp.rid = rid
+
+	// 4. calculate xi
+	modQ := common.ModInt(q)
+	xi := new(big.Int).Set(round.temp.shares[i].Share)
+	for j := range Ps {
+		if j == i {
+			continue
+		}
+		r2msg1 := round.temp.kgRound2Message1s[j].Content().(*KGRound2Message1)
+		xi = modQ.Add(xi, r2msg1.UnmarshalShare())
+	}
+	round.save.Xi = xi
+
+	// 5. sum the polynomial commitments, then compute Xj for each Pj and the public key
+	Vc := make(vss.Vs, round.Threshold()+1)
+	copy(Vc, round.temp.vs)
+	{
+		var err error
+		culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
+		for j, Pj := range Ps {
+			if j == i {
+				continue
+			}
+			for c := range Vc {
+				if Vc[c], err = Vc[c].Add(round.temp.pjVs[j][c]); err != nil {
+					culprits = append(culprits, Pj)
+					break
+				}
+			}
+		}
+		if len(culprits) > 0 {
+			return round.WrapError(errors.New("adding PjVs[c] to Vc[c] resulted in a point not on the curve"), culprits...)
+		}
+	}
+	for j, Pj := range Ps {
+		kj := Pj.KeyInt()
+		BigXj := Vc[0]
+		z := big.NewInt(1)
+		for c := 1; c <= round.Threshold(); c++ {
+			var err error
+			z = modQ.Mul(z, kj)
+			if BigXj, err = BigXj.Add(Vc[c].ScalarMult(z)); err != nil {
+				return round.WrapError(errors.New("adding Vc[c].ScalarMult(z) to BigXj resulted in a point not on the curve"))
+			}
+		}
+		round.save.BigXj[j] = BigXj
+	}
+	ecdsaPubKey, err := crypto.NewECPoint(tss.EC(), Vc[0].X(), Vc[0].Y())
+	if err != nil {
+		return round.WrapError(errors2.Wrapf(err, "public key is not on the curve"))
+	}
+	round.save.ECDSAPub = ecdsaPubKey
+	common.Logger.Debugf("%s public key: %x", Pi, ecdsaPubKey)
+
+	// 6. prove knowledge of ui, the discrete log of vs[0], with the nonce committed to in round 1
+	e := schnorrChallenge(rid, Pi.KeyInt(), round.temp.vs[0], round.temp.bigAjs[i])
+	psi := modQ.Add(round.temp.tau, modQ.Mul(e, round.temp.ui))
+	common.Zeroize(round.temp.ui, round.temp.tau)
+
+	// BROADCAST psi
+	r3msg := NewKGRound3Message(Pi, psi)
+	round.temp.kgRound3Messages[i] = r3msg
+	round.out <- r3msg
+	return nil
+}
+
+func (round *round3) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*KGRound3Message); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round3) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.kgRound3Messages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		// proof check is in round 4
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round3) NextRound() tss.Round {
+	round.started = false
+	return &round4{round}
+}
+
+// ----- //
+
+// schnorrChallenge derives the challenge of the Schnorr proof for X = x*G with the commitment A; including rid binds
+// the proof to this key generation session
+func schnorrChallenge(rid, ki *big.Int, X, A *crypto.ECPoint) *big.Int {
+	eHash := common.SHA512_256i(rid, ki, X.X(), X.Y(), A.X(), A.Y())
+	return common.RejectionSample(tss.EC().Params().N, eHash)
+}
@@ -0,0 +1,66 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"errors"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+func (round *round4) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 4
+	round.started = true
+	round.resetOK()
+
+	Ps := round.Parties().IDs()
+	i := round.PartyID().Index
+
+	// 1. verify the Schnorr proofs: psi_j*G = A_j + e_j*X_j
+	culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
+	for j, Pj := range Ps {
+		round.ok[j] = true
+		if j == i {
+			continue
+		}
+		r3msg := round.temp.kgRound3Messages[j].Content().(*KGRound3Message)
+		bigXj, bigAj := round.temp.pjVs[j][0], round.temp.bigAjs[j]
+		e := schnorrChallenge(round.temp.rid, Pj.KeyInt(), bigXj, bigAj)
+		rhs, err := bigAj.Add(bigXj.ScalarMult(e))
+		if err != nil || !crypto.ScalarBaseMult(tss.EC(), r3msg.UnmarshalPsi()).Equals(rhs) {
+			round.ok[j] = false
+			culprits = append(culprits, Pj)
+			common.Logger.Warningf("schnorr proof verify failed for party %s", Pj)
+		}
+	}
+	if len(culprits) > 0 {
+		return round.WrapError(errors.New("schnorr proof verify failed"), culprits...)
+	}
+
+	round.end <- *round.save
+
+	return nil
+}
+
+func (round *round4) CanAccept(msg tss.ParsedMessage) bool {
+	// not expecting any incoming messages in this round
+	return false
+}
+
+func (round *round4) Update() (bool, *tss.Error) {
+	// not expecting any incoming messages in this round
+	return false, nil
+}
+
+func (round *round4) NextRound() tss.Round {
+	return nil // finished!
+}
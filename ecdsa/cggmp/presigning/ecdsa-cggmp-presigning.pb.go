@@ -0,0 +1,473 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: protob/ecdsa-cggmp-presigning.proto
+
+package presigning
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// Represents a BROADCAST message sent to all parties during Round 1 of the ECDSA presigning.
+type PreSignRound1Message1 struct {
+	K                    []byte   `protobuf:"bytes,1,opt,name=k,proto3" json:"k,omitempty"`
+	G                    []byte   `protobuf:"bytes,2,opt,name=g,proto3" json:"g,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PreSignRound1Message1) Reset()         { *m = PreSignRound1Message1{} }
+func (m *PreSignRound1Message1) String() string { return proto.CompactTextString(m) }
+func (*PreSignRound1Message1) ProtoMessage()    {}
+func (*PreSignRound1Message1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_232caa62c06b757f, []int{0}
+}
+
+func (m *PreSignRound1Message1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreSignRound1Message1.Unmarshal(m, b)
+}
+func (m *PreSignRound1Message1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreSignRound1Message1.Marshal(b, m, deterministic)
+}
+func (m *PreSignRound1Message1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreSignRound1Message1.Merge(m, src)
+}
+func (m *PreSignRound1Message1) XXX_Size() int {
+	return xxx_messageInfo_PreSignRound1Message1.Size(m)
+}
+func (m *PreSignRound1Message1) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreSignRound1Message1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreSignRound1Message1 proto.InternalMessageInfo
+
+func (m *PreSignRound1Message1) GetK() []byte {
+	if m != nil {
+		return m.K
+	}
+	return nil
+}
+
+func (m *PreSignRound1Message1) GetG() []byte {
+	if m != nil {
+		return m.G
+	}
+	return nil
+}
+
+// Represents a P2P message sent to each party during Round 1 of the ECDSA presigning.
+type PreSignRound1Message2 struct {
+	EncProof             [][]byte `protobuf:"bytes,1,rep,name=enc_proof,json=encProof,proto3" json:"enc_proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PreSignRound1Message2) Reset()         { *m = PreSignRound1Message2{} }
+func (m *PreSignRound1Message2) String() string { return proto.CompactTextString(m) }
+func (*PreSignRound1Message2) ProtoMessage()    {}
+func (*PreSignRound1Message2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_232caa62c06b757f, []int{1}
+}
+
+func (m *PreSignRound1Message2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreSignRound1Message2.Unmarshal(m, b)
+}
+func (m *PreSignRound1Message2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreSignRound1Message2.Marshal(b, m, deterministic)
+}
+func (m *PreSignRound1Message2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreSignRound1Message2.Merge(m, src)
+}
+func (m *PreSignRound1Message2) XXX_Size() int {
+	return xxx_messageInfo_PreSignRound1Message2.Size(m)
+}
+func (m *PreSignRound1Message2) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreSignRound1Message2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreSignRound1Message2 proto.InternalMessageInfo
+
+func (m *PreSignRound1Message2) GetEncProof() [][]byte {
+	if m != nil {
+		return m.EncProof
+	}
+	return nil
+}
+
+// Represents a BROADCAST message sent to all parties during Round 2 of the ECDSA presigning.
+// The MtA ciphertexts for each party are at the index of that party and are empty at the index of the sender.
+type PreSignRound2Message1 struct {
+	GammaX               []byte   `protobuf:"bytes,1,opt,name=gamma_x,json=gammaX,proto3" json:"gamma_x,omitempty"`
+	GammaY               []byte   `protobuf:"bytes,2,opt,name=gamma_y,json=gammaY,proto3" json:"gamma_y,omitempty"`
+	D                    [][]byte `protobuf:"bytes,3,rep,name=d,proto3" json:"d,omitempty"`
+	F                    [][]byte `protobuf:"bytes,4,rep,name=f,proto3" json:"f,omitempty"`
+	DHat                 [][]byte `protobuf:"bytes,5,rep,name=d_hat,json=dHat,proto3" json:"d_hat,omitempty"`
+	FHat                 [][]byte `protobuf:"bytes,6,rep,name=f_hat,json=fHat,proto3" json:"f_hat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PreSignRound2Message1) Reset()         { *m = PreSignRound2Message1{} }
+func (m *PreSignRound2Message1) String() string { return proto.CompactTextString(m) }
+func (*PreSignRound2Message1) ProtoMessage()    {}
+func (*PreSignRound2Message1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_232caa62c06b757f, []int{2}
+}
+
+func (m *PreSignRound2Message1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreSignRound2Message1.Unmarshal(m, b)
+}
+func (m *PreSignRound2Message1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreSignRound2Message1.Marshal(b, m, deterministic)
+}
+func (m *PreSignRound2Message1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreSignRound2Message1.Merge(m, src)
+}
+func (m *PreSignRound2Message1) XXX_Size() int {
+	return xxx_messageInfo_PreSignRound2Message1.Size(m)
+}
+func (m *PreSignRound2Message1) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreSignRound2Message1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreSignRound2Message1 proto.InternalMessageInfo
+
+func (m *PreSignRound2Message1) GetGammaX() []byte {
+	if m != nil {
+		return m.GammaX
+	}
+	return nil
+}
+
+func (m *PreSignRound2Message1) GetGammaY() []byte {
+	if m != nil {
+		return m.GammaY
+	}
+	return nil
+}
+
+func (m *PreSignRound2Message1) GetD() [][]byte {
+	if m != nil {
+		return m.D
+	}
+	return nil
+}
+
+func (m *PreSignRound2Message1) GetF() [][]byte {
+	if m != nil {
+		return m.F
+	}
+	return nil
+}
+
+func (m *PreSignRound2Message1) GetDHat() [][]byte {
+	if m != nil {
+		return m.DHat
+	}
+	return nil
+}
+
+func (m *PreSignRound2Message1) GetFHat() [][]byte {
+	if m != nil {
+		return m.FHat
+	}
+	return nil
+}
+
+// Represents a P2P message sent to each party during Round 2 of the ECDSA presigning.
+type PreSignRound2Message2 struct {
+	AffGProof            [][]byte `protobuf:"bytes,1,rep,name=aff_g_proof,json=affGProof,proto3" json:"aff_g_proof,omitempty"`
+	AffGProofHat         [][]byte `protobuf:"bytes,2,rep,name=aff_g_proof_hat,json=affGProofHat,proto3" json:"aff_g_proof_hat,omitempty"`
+	LogStarProof         [][]byte `protobuf:"bytes,3,rep,name=log_star_proof,json=logStarProof,proto3" json:"log_star_proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PreSignRound2Message2) Reset()         { *m = PreSignRound2Message2{} }
+func (m *PreSignRound2Message2) String() string { return proto.CompactTextString(m) }
+func (*PreSignRound2Message2) ProtoMessage()    {}
+func (*PreSignRound2Message2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_232caa62c06b757f, []int{3}
+}
+
+func (m *PreSignRound2Message2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreSignRound2Message2.Unmarshal(m, b)
+}
+func (m *PreSignRound2Message2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreSignRound2Message2.Marshal(b, m, deterministic)
+}
+func (m *PreSignRound2Message2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreSignRound2Message2.Merge(m, src)
+}
+func (m *PreSignRound2Message2) XXX_Size() int {
+	return xxx_messageInfo_PreSignRound2Message2.Size(m)
+}
+func (m *PreSignRound2Message2) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreSignRound2Message2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreSignRound2Message2 proto.InternalMessageInfo
+
+func (m *PreSignRound2Message2) GetAffGProof() [][]byte {
+	if m != nil {
+		return m.AffGProof
+	}
+	return nil
+}
+
+func (m *PreSignRound2Message2) GetAffGProofHat() [][]byte {
+	if m != nil {
+		return m.AffGProofHat
+	}
+	return nil
+}
+
+func (m *PreSignRound2Message2) GetLogStarProof() [][]byte {
+	if m != nil {
+		return m.LogStarProof
+	}
+	return nil
+}
+
+// Represents a BROADCAST message sent to all parties during Round 3 of the ECDSA presigning.
+type PreSignRound3Message1 struct {
+	Delta                []byte   `protobuf:"bytes,1,opt,name=delta,proto3" json:"delta,omitempty"`
+	BigDeltaX            []byte   `protobuf:"bytes,2,opt,name=big_delta_x,json=bigDeltaX,proto3" json:"big_delta_x,omitempty"`
+	BigDeltaY            []byte   `protobuf:"bytes,3,opt,name=big_delta_y,json=bigDeltaY,proto3" json:"big_delta_y,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PreSignRound3Message1) Reset()         { *m = PreSignRound3Message1{} }
+func (m *PreSignRound3Message1) String() string { return proto.CompactTextString(m) }
+func (*PreSignRound3Message1) ProtoMessage()    {}
+func (*PreSignRound3Message1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_232caa62c06b757f, []int{4}
+}
+
+func (m *PreSignRound3Message1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreSignRound3Message1.Unmarshal(m, b)
+}
+func (m *PreSignRound3Message1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreSignRound3Message1.Marshal(b, m, deterministic)
+}
+func (m *PreSignRound3Message1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreSignRound3Message1.Merge(m, src)
+}
+func (m *PreSignRound3Message1) XXX_Size() int {
+	return xxx_messageInfo_PreSignRound3Message1.Size(m)
+}
+func (m *PreSignRound3Message1) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreSignRound3Message1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreSignRound3Message1 proto.InternalMessageInfo
+
+func (m *PreSignRound3Message1) GetDelta() []byte {
+	if m != nil {
+		return m.Delta
+	}
+	return nil
+}
+
+func (m *PreSignRound3Message1) GetBigDeltaX() []byte {
+	if m != nil {
+		return m.BigDeltaX
+	}
+	return nil
+}
+
+func (m *PreSignRound3Message1) GetBigDeltaY() []byte {
+	if m != nil {
+		return m.BigDeltaY
+	}
+	return nil
+}
+
+// Represents a P2P message sent to each party during Round 3 of the ECDSA presigning.
+type PreSignRound3Message2 struct {
+	LogStarProof         [][]byte `protobuf:"bytes,1,rep,name=log_star_proof,json=logStarProof,proto3" json:"log_star_proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PreSignRound3Message2) Reset()         { *m = PreSignRound3Message2{} }
+func (m *PreSignRound3Message2) String() string { return proto.CompactTextString(m) }
+func (*PreSignRound3Message2) ProtoMessage()    {}
+func (*PreSignRound3Message2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_232caa62c06b757f, []int{5}
+}
+
+func (m *PreSignRound3Message2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreSignRound3Message2.Unmarshal(m, b)
+}
+func (m *PreSignRound3Message2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreSignRound3Message2.Marshal(b, m, deterministic)
+}
+func (m *PreSignRound3Message2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreSignRound3Message2.Merge(m, src)
+}
+func (m *PreSignRound3Message2) XXX_Size() int {
+	return xxx_messageInfo_PreSignRound3Message2.Size(m)
+}
+func (m *PreSignRound3Message2) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreSignRound3Message2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreSignRound3Message2 proto.InternalMessageInfo
+
+func (m *PreSignRound3Message2) GetLogStarProof() [][]byte {
+	if m != nil {
+		return m.LogStarProof
+	}
+	return nil
+}
+
+// Represents a BROADCAST message sent to all parties during the identification phase of the ECDSA presigning.
+type PreSignRound4Message1 struct {
+	H                    []byte   `protobuf:"bytes,1,opt,name=h,proto3" json:"h,omitempty"`
+	MulProof             [][]byte `protobuf:"bytes,2,rep,name=mul_proof,json=mulProof,proto3" json:"mul_proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PreSignRound4Message1) Reset()         { *m = PreSignRound4Message1{} }
+func (m *PreSignRound4Message1) String() string { return proto.CompactTextString(m) }
+func (*PreSignRound4Message1) ProtoMessage()    {}
+func (*PreSignRound4Message1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_232caa62c06b757f, []int{6}
+}
+
+func (m *PreSignRound4Message1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreSignRound4Message1.Unmarshal(m, b)
+}
+func (m *PreSignRound4Message1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreSignRound4Message1.Marshal(b, m, deterministic)
+}
+func (m *PreSignRound4Message1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreSignRound4Message1.Merge(m, src)
+}
+func (m *PreSignRound4Message1) XXX_Size() int {
+	return xxx_messageInfo_PreSignRound4Message1.Size(m)
+}
+func (m *PreSignRound4Message1) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreSignRound4Message1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreSignRound4Message1 proto.InternalMessageInfo
+
+func (m *PreSignRound4Message1) GetH() []byte {
+	if m != nil {
+		return m.H
+	}
+	return nil
+}
+
+func (m *PreSignRound4Message1) GetMulProof() [][]byte {
+	if m != nil {
+		return m.MulProof
+	}
+	return nil
+}
+
+// Represents a P2P message sent to each party during the identification phase of the ECDSA presigning.
+type PreSignRound4Message2 struct {
+	DecProof             [][]byte `protobuf:"bytes,1,rep,name=dec_proof,json=decProof,proto3" json:"dec_proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PreSignRound4Message2) Reset()         { *m = PreSignRound4Message2{} }
+func (m *PreSignRound4Message2) String() string { return proto.CompactTextString(m) }
+func (*PreSignRound4Message2) ProtoMessage()    {}
+func (*PreSignRound4Message2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_232caa62c06b757f, []int{7}
+}
+
+func (m *PreSignRound4Message2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreSignRound4Message2.Unmarshal(m, b)
+}
+func (m *PreSignRound4Message2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreSignRound4Message2.Marshal(b, m, deterministic)
+}
+func (m *PreSignRound4Message2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreSignRound4Message2.Merge(m, src)
+}
+func (m *PreSignRound4Message2) XXX_Size() int {
+	return xxx_messageInfo_PreSignRound4Message2.Size(m)
+}
+func (m *PreSignRound4Message2) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreSignRound4Message2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreSignRound4Message2 proto.InternalMessageInfo
+
+func (m *PreSignRound4Message2) GetDecProof() [][]byte {
+	if m != nil {
+		return m.DecProof
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PreSignRound1Message1)(nil), "PreSignRound1Message1")
+	proto.RegisterType((*PreSignRound1Message2)(nil), "PreSignRound1Message2")
+	proto.RegisterType((*PreSignRound2Message1)(nil), "PreSignRound2Message1")
+	proto.RegisterType((*PreSignRound2Message2)(nil), "PreSignRound2Message2")
+	proto.RegisterType((*PreSignRound3Message1)(nil), "PreSignRound3Message1")
+	proto.RegisterType((*PreSignRound3Message2)(nil), "PreSignRound3Message2")
+	proto.RegisterType((*PreSignRound4Message1)(nil), "PreSignRound4Message1")
+	proto.RegisterType((*PreSignRound4Message2)(nil), "PreSignRound4Message2")
+}
+
+func init() {
+	proto.RegisterFile("protob/ecdsa-cggmp-presigning.proto", fileDescriptor_232caa62c06b757f)
+}
+
+var fileDescriptor_232caa62c06b757f = []byte{
+	// 374 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcb, 0x6b, 0xc2, 0x40,
+	0x10, 0xc6, 0x59, 0x5f, 0xd5, 0x6d, 0x68, 0x21, 0x7d, 0x2d, 0x14, 0x8a, 0xa4, 0x2d, 0x78, 0xb1,
+	0x62, 0xf4, 0xda, 0x8b, 0x14, 0xea, 0xa5, 0x20, 0x7a, 0xd1, 0x5e, 0xc2, 0x26, 0xbb, 0x3b, 0x06,
+	0xf3, 0x22, 0x89, 0xa0, 0xf7, 0xde, 0xfb, 0x2f, 0x97, 0xdd, 0x4d, 0xd5, 0x94, 0xf4, 0xf8, 0xcd,
+	0xb7, 0x33, 0xf3, 0xed, 0xf0, 0xc3, 0x8f, 0x49, 0x1a, 0xe7, 0xb1, 0x3b, 0xe0, 0x1e, 0xcb, 0x68,
+	0xdf, 0x03, 0x08, 0x93, 0x7e, 0x92, 0xf2, 0xcc, 0x87, 0xc8, 0x8f, 0xe0, 0x45, 0xb9, 0xd6, 0x08,
+	0xdf, 0xcc, 0x52, 0xbe, 0xf0, 0x21, 0x9a, 0xc7, 0xdb, 0x88, 0x0d, 0x3f, 0x78, 0x96, 0x51, 0xe0,
+	0x43, 0xd3, 0xc0, 0x68, 0x43, 0x50, 0x17, 0xf5, 0x8c, 0x39, 0xda, 0x48, 0x05, 0xa4, 0xa6, 0x15,
+	0x58, 0xe3, 0xea, 0x26, 0xdb, 0xbc, 0xc7, 0x1d, 0x1e, 0x79, 0x4e, 0x92, 0xc6, 0xb1, 0x20, 0xa8,
+	0x5b, 0xef, 0x19, 0xf3, 0x36, 0x8f, 0xbc, 0x99, 0xd4, 0xd6, 0x37, 0x2a, 0xb7, 0xd9, 0x87, 0x5d,
+	0x77, 0xf8, 0x0c, 0x68, 0x18, 0x52, 0x67, 0x57, 0x6c, 0x6c, 0x29, 0xb9, 0x3c, 0x1a, 0x7b, 0x52,
+	0x3b, 0x31, 0x56, 0x32, 0x0f, 0x23, 0x75, 0xb5, 0x00, 0x31, 0xa9, 0x04, 0x69, 0x68, 0x25, 0xcc,
+	0x2b, 0xdc, 0x64, 0xce, 0x9a, 0xe6, 0xa4, 0xa9, 0x2a, 0x0d, 0x36, 0xa5, 0xb9, 0x2c, 0x0a, 0x55,
+	0x6c, 0xe9, 0xa2, 0x98, 0xd2, 0xdc, 0xfa, 0xfa, 0x27, 0x91, 0x6d, 0x3e, 0xe0, 0x73, 0x2a, 0x84,
+	0x03, 0xa5, 0xaf, 0x74, 0xa8, 0x10, 0xef, 0xea, 0x2f, 0xe6, 0x33, 0xbe, 0x3c, 0xf1, 0xd5, 0xe0,
+	0x9a, 0x7a, 0x63, 0x1c, 0xde, 0xc8, 0xad, 0x4f, 0xf8, 0x22, 0x88, 0xc1, 0xc9, 0x72, 0x9a, 0x16,
+	0x93, 0x74, 0x66, 0x23, 0x88, 0x61, 0x91, 0xd3, 0x54, 0x1f, 0x26, 0x2c, 0xa7, 0x18, 0x1d, 0xee,
+	0x72, 0x8d, 0x9b, 0x8c, 0x07, 0x39, 0x2d, 0xae, 0xa2, 0x85, 0xcc, 0xe6, 0xfa, 0xe0, 0x28, 0xe1,
+	0xec, 0x8a, 0xc3, 0x74, 0x5c, 0x1f, 0xde, 0x64, 0x65, 0x59, 0xf6, 0xf7, 0xa4, 0x5e, 0xf6, 0x57,
+	0xd6, 0x6b, 0xf5, 0x3a, 0xbb, 0x22, 0x2d, 0xaa, 0x48, 0x3b, 0x29, 0xb7, 0x8f, 0x4f, 0x89, 0x59,
+	0xff, 0x12, 0xb3, 0x96, 0x28, 0x84, 0xdb, 0xa0, 0x98, 0xa3, 0x6f, 0xd3, 0x0e, 0xb7, 0x81, 0x9e,
+	0x31, 0xae, 0x9e, 0xa1, 0x00, 0x62, 0xfc, 0x0f, 0x40, 0x8c, 0x6b, 0x80, 0x26, 0xe4, 0xf3, 0x56,
+	0xb1, 0x3c, 0x50, 0x2c, 0x0f, 0x8e, 0x2c, 0xbb, 0x2d, 0x05, 0xf3, 0xe8, 0x67, 0x00, 0xd7, 0x87,
+	0x2d, 0x2c, 0xf3, 0x02, 0x00, 0x00,
+}
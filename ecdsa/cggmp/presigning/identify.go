@@ -0,0 +1,81 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package presigning
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+func (round *identification) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 5
+	round.started = true
+
+	Ps := round.Parties().IDs()
+	i := round.PartyID().Index
+
+	// verify that H_j encrypts k_j*gamma_j (Πmul) and that delta_j is the plaintext mod q of
+	// H_j * prod(D_jk * F_kj^-1) (Πdec, made with our NTilde, h1, h2); the parties that fail are the culprits
+	failed := make([]bool, len(Ps))
+	wg := sync.WaitGroup{}
+	verifiers := round.VerifierPool()
+	for j, Pj := range Ps {
+		if j == i {
+			continue
+		}
+		j, Pj := j, Pj
+		wg.Add(1)
+		verifiers.Go(func() {
+			defer wg.Done()
+			pkJ := round.key.PaillierPKs[j]
+			r1msg1 := round.temp.preSignRound1Message1s[j].Content().(*PreSignRound1Message1)
+			r3msg1 := round.temp.preSignRound3Message1s[j].Content().(*PreSignRound3Message1)
+			r4msg1 := round.temp.preSignRound4Message1s[j].Content().(*PreSignRound4Message1)
+			r4msg2 := round.temp.preSignRound4Message2s[j].Content().(*PreSignRound4Message2)
+			H := r4msg1.UnmarshalH()
+			mulProof, err := r4msg1.UnmarshalMulProof()
+			if err != nil || !mulProof.Verify(pkJ, r1msg1.UnmarshalK(), r1msg1.UnmarshalG(), H, Pj.KeyInt()) {
+				failed[j] = true
+				return
+			}
+			C, err := round.deltaCiphertext(j, H)
+			if err != nil {
+				failed[j] = true
+				return
+			}
+			decProof, err := r4msg2.UnmarshalDecProof()
+			if err != nil || !decProof.Verify(
+				pkJ, C, r3msg1.UnmarshalDelta(), round.key.NTildej[i], round.key.H1j[i], round.key.H2j[i], Pj.KeyInt()) {
+				failed[j] = true
+			}
+		})
+	}
+	wg.Wait()
+	if culprits := round.culprits(failed); len(culprits) > 0 {
+		return round.WrapError(errors.New("identified the parties whose delta_j does not match their MtA shares"), culprits...)
+	}
+	return round.WrapError(errors.New("delta*G does not match the sum of Delta_j, but every party proved its delta_j"))
+}
+
+func (round *identification) CanAccept(msg tss.ParsedMessage) bool {
+	// not expecting any incoming messages in this round
+	return false
+}
+
+func (round *identification) Update() (bool, *tss.Error) {
+	// not expecting any incoming messages in this round
+	return false, nil
+}
+
+func (round *identification) NextRound() tss.Round {
+	return nil // finished!
+}
@@ -0,0 +1,215 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package presigning
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+	"github.com/binance-chain/tss-lib/ecdsa/keygen"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+// Implements Party
+// Implements Stringer
+var _ tss.Party = (*LocalParty)(nil)
+var _ tss.Zeroizer = (*LocalParty)(nil)
+var _ fmt.Stringer = (*LocalParty)(nil)
+
+type (
+	LocalParty struct {
+		*tss.BaseParty
+		params *tss.Parameters
+
+		keys keygen.LocalPartySaveData
+		temp localTempData
+		data PreSignatureData
+
+		// outbound messaging
+		out chan<- tss.Message
+		end chan<- PreSignatureData
+	}
+
+	localMessageStore struct {
+		preSignRound1Message1s,
+		preSignRound1Message2s,
+		preSignRound2Message1s,
+		preSignRound2Message2s,
+		preSignRound3Message1s,
+		preSignRound3Message2s,
+		preSignRound4Message1s,
+		preSignRound4Message2s []tss.ParsedMessage
+	}
+
+	localTempData struct {
+		localMessageStore
+
+		// temp data (thrown away after presigning) / round 1
+		w,
+		k,
+		rho,
+		gamma,
+		nu *big.Int
+		K, // Enc(k; rho)
+		G *big.Int // Enc(gamma; nu)
+		bigWs []*crypto.ECPoint
+
+		// round 2
+		pointGamma *crypto.ECPoint
+		// the MtA masks of this party for each Pj and their ciphertexts: D_ji = K_j^gamma_i * Enc_j(beta_ij) and
+		// F_ji = Enc_i(beta_ij), and the same with a hat for w_i
+		betas,
+		betaHats,
+		Ds,
+		Fs,
+		DHats,
+		FHats []*big.Int
+
+		// round 3
+		delta,
+		chi *big.Int
+		bigGamma,
+		bigDelta *crypto.ECPoint
+
+		// round 4
+		identifying bool
+	}
+)
+
+// NewLocalParty returns a party for the presigning of CGGMP21 (Canetti, Gennaro, Goldfeder, Makriyannis, Peled;
+// 2021), figure 7, with the Πenc, Πaff-g and Πlog* proofs. When the final consistency check fails, the parties run
+// the identification phase, in which each proves its delta_i with Πmul and Πdec, and report the parties whose proofs
+// fail as the culprits. The signers are the parties in `params`; `key` must have been through the aux-info protocol
+// in `cggmp/auxinfo`. The presignature is sent on `end` and may later be used to sign one message with a single
+// round, see `cggmp/signing`.
+func NewLocalParty(
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	out chan<- tss.Message,
+	end chan<- PreSignatureData,
+) tss.Party {
+	partyCount := len(params.Parties().IDs())
+	p := &LocalParty{
+		BaseParty: new(tss.BaseParty),
+		params:    params,
+		keys:      keygen.BuildLocalSaveDataSubset(key, params.Parties().IDs()),
+		temp:      localTempData{},
+		data:      PreSignatureData{},
+		out:       out,
+		end:       end,
+	}
+	// msgs init
+	p.temp.preSignRound1Message1s = make([]tss.ParsedMessage, partyCount)
+	p.temp.preSignRound1Message2s = make([]tss.ParsedMessage, partyCount)
+	p.temp.preSignRound2Message1s = make([]tss.ParsedMessage, partyCount)
+	p.temp.preSignRound2Message2s = make([]tss.ParsedMessage, partyCount)
+	p.temp.preSignRound3Message1s = make([]tss.ParsedMessage, partyCount)
+	p.temp.preSignRound3Message2s = make([]tss.ParsedMessage, partyCount)
+	p.temp.preSignRound4Message1s = make([]tss.ParsedMessage, partyCount)
+	p.temp.preSignRound4Message2s = make([]tss.ParsedMessage, partyCount)
+	// temp data init
+	p.temp.betas = make([]*big.Int, partyCount)
+	p.temp.betaHats = make([]*big.Int, partyCount)
+	p.temp.Ds = make([]*big.Int, partyCount)
+	p.temp.Fs = make([]*big.Int, partyCount)
+	p.temp.DHats = make([]*big.Int, partyCount)
+	p.temp.FHats = make([]*big.Int, partyCount)
+	return p
+}
+
+func (p *LocalParty) FirstRound() tss.Round {
+	return newRound1(p.params, &p.keys, &p.data, &p.temp, p.out, p.end)
+}
+
+func (p *LocalParty) Start() *tss.Error {
+	return tss.BaseStart(p, TaskName, func(round tss.Round) *tss.Error {
+		round1, ok := round.(*round1)
+		if !ok {
+			return round.WrapError(errors.New("unable to Start(). party is in an unexpected round"))
+		}
+		return round1.prepare()
+	})
+}
+
+func (p *LocalParty) Update(msg tss.ParsedMessage) (ok bool, err *tss.Error) {
+	return tss.BaseUpdate(p, msg, TaskName)
+}
+
+func (p *LocalParty) UpdateFromBytes(wireBytes []byte, from *tss.PartyID, isBroadcast bool) (bool, *tss.Error) {
+	msg, err := tss.ParseWireMessage(wireBytes, from, isBroadcast)
+	if err != nil {
+		return false, p.WrapError(err)
+	}
+	return p.Update(msg)
+}
+
+func (p *LocalParty) ValidateMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
+	if ok, err := p.BaseParty.ValidateMessage(msg); !ok || err != nil {
+		return ok, err
+	}
+	// check that the message's "from index" will fit into the array
+	if maxFromIdx := len(p.params.Parties().IDs()) - 1; maxFromIdx < msg.GetFrom().Index {
+		return false, p.WrapError(fmt.Errorf("received msg with a sender index too great (%d <= %d)",
+			maxFromIdx, msg.GetFrom().Index), msg.GetFrom())
+	}
+	return true, nil
+}
+
+func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
+	// ValidateBasic is cheap; double-check the message here in case the public StoreMessage was called externally
+	if ok, err := p.ValidateMessage(msg); !ok || err != nil {
+		return ok, err
+	}
+	fromPIdx := msg.GetFrom().Index
+
+	// switch/case is necessary to store any messages beyond current round
+	// this does not handle message replays. we expect the caller to apply replay and spoofing protection.
+	switch msg.Content().(type) {
+	case *PreSignRound1Message1:
+		p.temp.preSignRound1Message1s[fromPIdx] = msg
+	case *PreSignRound1Message2:
+		p.temp.preSignRound1Message2s[fromPIdx] = msg
+	case *PreSignRound2Message1:
+		p.temp.preSignRound2Message1s[fromPIdx] = msg
+	case *PreSignRound2Message2:
+		p.temp.preSignRound2Message2s[fromPIdx] = msg
+	case *PreSignRound3Message1:
+		p.temp.preSignRound3Message1s[fromPIdx] = msg
+	case *PreSignRound3Message2:
+		p.temp.preSignRound3Message2s[fromPIdx] = msg
+	case *PreSignRound4Message1:
+		p.temp.preSignRound4Message1s[fromPIdx] = msg
+	case *PreSignRound4Message2:
+		p.temp.preSignRound4Message2s[fromPIdx] = msg
+	default: // unrecognised message, just ignore!
+		common.Logger.Warningf("unrecognised message ignored: %v", msg)
+		return false, nil
+	}
+	return true, nil
+}
+
+// Zeroize overwrites the secrets of the presigning session: the additive share wi, gamma, the Paillier randomness of
+// K and G and the MtA masks, as well as the presignature shares if presigning did not finish
+func (p *LocalParty) Zeroize() {
+	common.Zeroize(p.temp.w, p.temp.rho, p.temp.gamma, p.temp.nu)
+	common.Zeroize(p.temp.betas...)
+	common.Zeroize(p.temp.betaHats...)
+	if p.Running() {
+		common.Zeroize(p.temp.k, p.temp.chi)
+	}
+}
+
+func (p *LocalParty) PartyID() *tss.PartyID {
+	return p.params.PartyID()
+}
+
+func (p *LocalParty) String() string {
+	return fmt.Sprintf("id: %s, %s", p.PartyID(), p.BaseParty.String())
+}
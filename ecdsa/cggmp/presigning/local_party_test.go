@@ -0,0 +1,191 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package presigning
+
+import (
+	"fmt"
+	"math/big"
+	"runtime"
+	"testing"
+
+	"github.com/ipfs/go-log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+	cggmpkeygen "github.com/binance-chain/tss-lib/ecdsa/cggmp/keygen"
+	"github.com/binance-chain/tss-lib/ecdsa/keygen"
+	"github.com/binance-chain/tss-lib/test"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+const (
+	testParticipants = test.TestParticipants
+	testThreshold    = test.TestThreshold
+)
+
+func setUp(level string) {
+	if err := log.SetLogLevel("tss-lib", level); err != nil {
+		panic(err)
+	}
+}
+
+func TestE2EConcurrent(t *testing.T) {
+	setUp("info")
+
+	// PHASE: load keygen fixtures and import them
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	for i := range keys {
+		keys[i], err = cggmpkeygen.ImportGG18(keys[i])
+		assert.NoError(t, err)
+	}
+
+	// PHASE: presigning
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	parties := make([]*LocalParty, 0, len(signPIDs))
+
+	errCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan PreSignatureData, len(signPIDs))
+
+	updater := test.SharedPartyUpdater
+
+	// init the parties
+	for i := 0; i < len(signPIDs); i++ {
+		params := tss.NewParameters(p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+		P := NewLocalParty(params, keys[i], outCh, endCh).(*LocalParty)
+		parties = append(parties, P)
+		go func(P *LocalParty) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+
+	preSigs := make([]PreSignatureData, 0, len(signPIDs))
+presigning:
+	for {
+		fmt.Printf("ACTIVE GOROUTINES: %d\n", runtime.NumGoroutine())
+		select {
+		case err := <-errCh:
+			common.Logger.Errorf("Error: %s", err)
+			assert.FailNow(t, err.Error())
+			break presigning
+
+		case msg := <-outCh:
+			dest := msg.GetTo()
+			if dest == nil {
+				for _, P := range parties {
+					if P.PartyID().Index == msg.GetFrom().Index {
+						continue
+					}
+					go updater(P, msg, errCh)
+				}
+			} else {
+				if dest[0].Index == msg.GetFrom().Index {
+					t.Fatalf("party %d tried to send a message to itself (%d)", dest[0].Index, msg.GetFrom().Index)
+				}
+				go updater(parties[dest[0].Index], msg, errCh)
+			}
+
+		case preSig := <-endCh:
+			preSigs = append(preSigs, preSig)
+			if len(preSigs) == len(signPIDs) {
+				t.Logf("Done. Received presignature data from %d participants", len(preSigs))
+				break presigning
+			}
+		}
+	}
+
+	// k = sum(k_i) and chi = sum(chi_i) must satisfy k*R = G and chi*G = k*X
+	modN := common.ModInt(tss.EC().Params().N)
+	k, chi := big.NewInt(0), big.NewInt(0)
+	for _, preSig := range preSigs {
+		assert.True(t, preSig.BigR.Equals(preSigs[0].BigR))
+		assert.False(t, preSig.Used())
+		k = modN.Add(k, preSig.KI)
+		chi = modN.Add(chi, preSig.ChiI)
+	}
+	g := crypto.ScalarBaseMult(tss.EC(), big.NewInt(1))
+	assert.True(t, preSigs[0].BigR.ScalarMult(k).Equals(g), "R must be k^-1*G")
+	assert.True(t, crypto.ScalarBaseMult(tss.EC(), chi).Equals(keys[0].ECDSAPub.ScalarMult(k)), "chi must be k*x")
+
+	for _, preSig := range preSigs {
+		preSig.Zeroize()
+		assert.True(t, preSig.Used(), "a zeroized presignature counts as used")
+	}
+}
+
+func TestIdentifyInvalidDelta(t *testing.T) {
+	setUp("info")
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(3, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	for i := range keys {
+		keys[i], err = cggmpkeygen.ImportGG18(keys[i])
+		assert.NoError(t, err)
+	}
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	errCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan PreSignatureData, len(signPIDs))
+
+	parties := make([]*LocalParty, 0, len(signPIDs))
+	for i := range signPIDs {
+		params := tss.NewParameters(p2pCtx, signPIDs[i], len(signPIDs), 1)
+		P := NewLocalParty(params, keys[i], outCh, endCh).(*LocalParty)
+		parties = append(parties, P)
+		go func(P *LocalParty) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+
+	// party 1 broadcasts delta_1 + 1 and, so that it also runs the identification phase, receives delta_0 + 1
+	culprit := signPIDs[1]
+	modQ := common.ModInt(tss.EC().Params().N)
+	tampered := func(msg tss.ParsedMessage) tss.ParsedMessage {
+		r3msg1 := msg.Content().(*PreSignRound3Message1)
+		bigDelta, err := r3msg1.UnmarshalBigDelta()
+		assert.NoError(t, err)
+		return NewPreSignRound3Message1(msg.GetFrom(), modQ.Add(r3msg1.UnmarshalDelta(), big.NewInt(1)), bigDelta)
+	}
+	honestErrs := 0
+	for {
+		select {
+		case err := <-errCh:
+			if err.Victim() == culprit {
+				continue
+			}
+			assert.Equal(t, 5, err.Round())
+			assert.Equal(t, []*tss.PartyID{culprit}, err.Culprits())
+			if honestErrs++; honestErrs == len(signPIDs)-1 {
+				return
+			}
+		case msg := <-outCh:
+			dest := msg.GetTo()
+			if dest != nil {
+				go test.SharedPartyUpdater(parties[dest[0].Index], msg, errCh)
+				continue
+			}
+			_, isDelta := msg.(tss.ParsedMessage).Content().(*PreSignRound3Message1)
+			for _, P := range parties {
+				if P.PartyID().Index == msg.GetFrom().Index {
+					continue
+				}
+				m := msg.(tss.ParsedMessage)
+				if isDelta && (msg.GetFrom() == culprit || (msg.GetFrom().Index == 0 && P.PartyID() == culprit)) {
+					m = tampered(m)
+				}
+				go test.SharedPartyUpdater(P, m, errCh)
+			}
+		case <-endCh:
+			t.Fatal("presigning should not complete")
+		}
+	}
+}
@@ -0,0 +1,287 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package presigning
+
+import (
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+	"github.com/binance-chain/tss-lib/crypto/affgproof"
+	"github.com/binance-chain/tss-lib/crypto/decproof"
+	"github.com/binance-chain/tss-lib/crypto/encproof"
+	"github.com/binance-chain/tss-lib/crypto/logstarproof"
+	"github.com/binance-chain/tss-lib/crypto/mulproof"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+// These messages were generated from Protocol Buffers definitions into ecdsa-cggmp-presigning.pb.go
+// The following messages are registered on the Protocol Buffers "wire"
+
+var (
+	// Ensure that presigning messages implement ValidateBasic
+	_ = []tss.MessageContent{
+		(*PreSignRound1Message1)(nil),
+		(*PreSignRound1Message2)(nil),
+		(*PreSignRound2Message1)(nil),
+		(*PreSignRound2Message2)(nil),
+		(*PreSignRound3Message1)(nil),
+		(*PreSignRound3Message2)(nil),
+		(*PreSignRound4Message1)(nil),
+		(*PreSignRound4Message2)(nil),
+	}
+)
+
+func init() {
+	proto.RegisterType((*PreSignRound1Message1)(nil), tss.ECDSAProtoNamePrefix+"cggmp.presigning.PreSignRound1Message1")
+	proto.RegisterType((*PreSignRound1Message2)(nil), tss.ECDSAProtoNamePrefix+"cggmp.presigning.PreSignRound1Message2")
+	proto.RegisterType((*PreSignRound2Message1)(nil), tss.ECDSAProtoNamePrefix+"cggmp.presigning.PreSignRound2Message1")
+	proto.RegisterType((*PreSignRound2Message2)(nil), tss.ECDSAProtoNamePrefix+"cggmp.presigning.PreSignRound2Message2")
+	proto.RegisterType((*PreSignRound3Message1)(nil), tss.ECDSAProtoNamePrefix+"cggmp.presigning.PreSignRound3Message1")
+	proto.RegisterType((*PreSignRound3Message2)(nil), tss.ECDSAProtoNamePrefix+"cggmp.presigning.PreSignRound3Message2")
+	proto.RegisterType((*PreSignRound4Message1)(nil), tss.ECDSAProtoNamePrefix+"cggmp.presigning.PreSignRound4Message1")
+	proto.RegisterType((*PreSignRound4Message2)(nil), tss.ECDSAProtoNamePrefix+"cggmp.presigning.PreSignRound4Message2")
+}
+
+// ----- //
+
+func NewPreSignRound1Message1(from *tss.PartyID, K, G *big.Int) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &PreSignRound1Message1{
+		K: K.Bytes(),
+		G: G.Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *PreSignRound1Message1) ValidateBasic() bool {
+	return m != nil && common.NonEmptyBytes(m.GetK()) && common.NonEmptyBytes(m.GetG())
+}
+
+func (m *PreSignRound1Message1) UnmarshalK() *big.Int {
+	return new(big.Int).SetBytes(m.GetK())
+}
+
+func (m *PreSignRound1Message1) UnmarshalG() *big.Int {
+	return new(big.Int).SetBytes(m.GetG())
+}
+
+// ----- //
+
+func NewPreSignRound1Message2(to, from *tss.PartyID, proof *encproof.Proof) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		To:          []*tss.PartyID{to},
+		IsBroadcast: false,
+	}
+	content := &PreSignRound1Message2{
+		EncProof: proof.Serialize(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *PreSignRound1Message2) ValidateBasic() bool {
+	return m != nil && common.NonEmptyMultiBytes(m.GetEncProof(), encproof.ProofBytesParts)
+}
+
+func (m *PreSignRound1Message2) UnmarshalEncProof() (*encproof.Proof, error) {
+	return encproof.UnmarshalProof(m.GetEncProof())
+}
+
+// ----- //
+
+// NewPreSignRound2Message1 broadcasts Gamma_i and the MtA ciphertexts D_ji, F_ji, DHat_ji and FHat_ji for each Pj,
+// at index j, so that every party can check the delta_j and chi_j of the others in the identification phases
+func NewPreSignRound2Message1(from *tss.PartyID, bigGamma *crypto.ECPoint, Ds, Fs, DHats, FHats []*big.Int) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &PreSignRound2Message1{
+		GammaX: bigGamma.X().Bytes(),
+		GammaY: bigGamma.Y().Bytes(),
+		D:      common.BigIntsToBytes(Ds),
+		F:      common.BigIntsToBytes(Fs),
+		DHat:   common.BigIntsToBytes(DHats),
+		FHat:   common.BigIntsToBytes(FHats),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *PreSignRound2Message1) ValidateBasic() bool {
+	if m == nil || !common.NonEmptyBytes(m.GetGammaX()) || !common.NonEmptyBytes(m.GetGammaY()) {
+		return false
+	}
+	n := len(m.GetD())
+	return n > 1 && len(m.GetF()) == n && len(m.GetDHat()) == n && len(m.GetFHat()) == n
+}
+
+func (m *PreSignRound2Message1) UnmarshalGamma() (*crypto.ECPoint, error) {
+	return crypto.NewECPoint(
+		tss.EC(),
+		new(big.Int).SetBytes(m.GetGammaX()),
+		new(big.Int).SetBytes(m.GetGammaY()))
+}
+
+// UnmarshalCiphertexts returns D, F, DHat and FHat, each indexed by party
+func (m *PreSignRound2Message1) UnmarshalCiphertexts() (Ds, Fs, DHats, FHats []*big.Int) {
+	return common.MultiBytesToBigInts(m.GetD()), common.MultiBytesToBigInts(m.GetF()),
+		common.MultiBytesToBigInts(m.GetDHat()), common.MultiBytesToBigInts(m.GetFHat())
+}
+
+// ----- //
+
+func NewPreSignRound2Message2(to, from *tss.PartyID, psi, psiHat *affgproof.Proof, psiPrime *logstarproof.Proof) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		To:          []*tss.PartyID{to},
+		IsBroadcast: false,
+	}
+	content := &PreSignRound2Message2{
+		AffGProof:    psi.Serialize(),
+		AffGProofHat: psiHat.Serialize(),
+		LogStarProof: psiPrime.Serialize(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *PreSignRound2Message2) ValidateBasic() bool {
+	return m != nil &&
+		common.NonEmptyMultiBytes(m.GetAffGProof(), affgproof.ProofBytesParts) &&
+		common.NonEmptyMultiBytes(m.GetAffGProofHat(), affgproof.ProofBytesParts) &&
+		common.NonEmptyMultiBytes(m.GetLogStarProof(), logstarproof.ProofBytesParts)
+}
+
+func (m *PreSignRound2Message2) UnmarshalAffGProof() (*affgproof.Proof, error) {
+	return affgproof.UnmarshalProof(m.GetAffGProof())
+}
+
+func (m *PreSignRound2Message2) UnmarshalAffGProofHat() (*affgproof.Proof, error) {
+	return affgproof.UnmarshalProof(m.GetAffGProofHat())
+}
+
+func (m *PreSignRound2Message2) UnmarshalLogStarProof() (*logstarproof.Proof, error) {
+	return logstarproof.UnmarshalProof(m.GetLogStarProof())
+}
+
+// ----- //
+
+func NewPreSignRound3Message1(from *tss.PartyID, delta *big.Int, bigDelta *crypto.ECPoint) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &PreSignRound3Message1{
+		Delta:     delta.Bytes(),
+		BigDeltaX: bigDelta.X().Bytes(),
+		BigDeltaY: bigDelta.Y().Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *PreSignRound3Message1) ValidateBasic() bool {
+	return m != nil &&
+		common.NonEmptyBytes(m.GetDelta()) &&
+		common.NonEmptyBytes(m.GetBigDeltaX()) &&
+		common.NonEmptyBytes(m.GetBigDeltaY())
+}
+
+func (m *PreSignRound3Message1) UnmarshalDelta() *big.Int {
+	return new(big.Int).SetBytes(m.GetDelta())
+}
+
+func (m *PreSignRound3Message1) UnmarshalBigDelta() (*crypto.ECPoint, error) {
+	return crypto.NewECPoint(
+		tss.EC(),
+		new(big.Int).SetBytes(m.GetBigDeltaX()),
+		new(big.Int).SetBytes(m.GetBigDeltaY()))
+}
+
+// ----- //
+
+func NewPreSignRound3Message2(to, from *tss.PartyID, proof *logstarproof.Proof) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		To:          []*tss.PartyID{to},
+		IsBroadcast: false,
+	}
+	content := &PreSignRound3Message2{
+		LogStarProof: proof.Serialize(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *PreSignRound3Message2) ValidateBasic() bool {
+	return m != nil && common.NonEmptyMultiBytes(m.GetLogStarProof(), logstarproof.ProofBytesParts)
+}
+
+func (m *PreSignRound3Message2) UnmarshalLogStarProof() (*logstarproof.Proof, error) {
+	return logstarproof.UnmarshalProof(m.GetLogStarProof())
+}
+
+// ----- //
+
+func NewPreSignRound4Message1(from *tss.PartyID, H *big.Int, proof *mulproof.Proof) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &PreSignRound4Message1{
+		H:        H.Bytes(),
+		MulProof: proof.Serialize(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *PreSignRound4Message1) ValidateBasic() bool {
+	return m != nil &&
+		common.NonEmptyBytes(m.GetH()) &&
+		common.NonEmptyMultiBytes(m.GetMulProof(), mulproof.ProofBytesParts)
+}
+
+func (m *PreSignRound4Message1) UnmarshalH() *big.Int {
+	return new(big.Int).SetBytes(m.GetH())
+}
+
+func (m *PreSignRound4Message1) UnmarshalMulProof() (*mulproof.Proof, error) {
+	return mulproof.UnmarshalProof(m.GetMulProof())
+}
+
+// ----- //
+
+func NewPreSignRound4Message2(to, from *tss.PartyID, proof *decproof.Proof) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		To:          []*tss.PartyID{to},
+		IsBroadcast: false,
+	}
+	content := &PreSignRound4Message2{
+		DecProof: proof.Serialize(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *PreSignRound4Message2) ValidateBasic() bool {
+	return m != nil && common.NonEmptyMultiBytes(m.GetDecProof(), decproof.ProofBytesParts)
+}
+
+func (m *PreSignRound4Message2) UnmarshalDecProof() (*decproof.Proof, error) {
+	return decproof.UnmarshalProof(m.GetDecProof())
+}
@@ -0,0 +1,57 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package presigning
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto/paillier"
+)
+
+// affine returns D = C^x * (1 + N)^y * rho^N mod N^2, an encryption of c*x + y for the ciphertext C of c under `pk`,
+// and its randomness rho. x is secret; y may be negative.
+func affine(pk *paillier.PublicKey, C, x, y *big.Int) (D, rho *big.Int, err error) {
+	if err = pk.ValidateCiphertext(C); err != nil {
+		return nil, nil, err
+	}
+	modNSquare := common.ModInt(pk.NSquare())
+	rho, rhoN := pk.Randomness()
+	D = modNSquare.Mul(modNSquare.Mul(modNSquare.ExpSecret(C, x), pk.ExpGamma(y)), rhoN)
+	return D, rho, nil
+}
+
+// encryptSigned returns an encryption of m under `pk`, which may be negative, and its randomness
+func encryptSigned(pk *paillier.PublicKey, m *big.Int) (c, rho *big.Int) {
+	rho, rhoN := pk.Randomness()
+	return common.ModInt(pk.NSquare()).Mul(pk.ExpGamma(m), rhoN), rho
+}
+
+// mtaCiphertext returns the product of Ds[j] * Fs[j]^-1 mod N^2 over the parties j other than i. For the D_ij that
+// Pi received and the F_ji that it sent, all under its key `pk`, it encrypts the sum of alpha_ij - beta_ij, i.e.
+// delta_i - k_i*gamma_i, or chi_i - k_i*w_i for the ciphertexts with a hat.
+func mtaCiphertext(pk *paillier.PublicKey, i int, Ds, Fs []*big.Int) (*big.Int, error) {
+	if len(Ds) != len(Fs) {
+		return nil, errors.New("mtaCiphertext: the ciphertexts differ in number")
+	}
+	modNSquare := common.ModInt(pk.NSquare())
+	C := big.NewInt(1)
+	for j := range Ds {
+		if j == i {
+			continue
+		}
+		if err := pk.ValidateCiphertext(Ds[j]); err != nil {
+			return nil, err
+		}
+		if err := pk.ValidateCiphertext(Fs[j]); err != nil {
+			return nil, err
+		}
+		C = modNSquare.Mul(C, modNSquare.Mul(Ds[j], modNSquare.ModInverse(Fs[j])))
+	}
+	return C, nil
+}
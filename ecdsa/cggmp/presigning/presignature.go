@@ -0,0 +1,47 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package presigning
+
+import (
+	"math/big"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+)
+
+type (
+	// PreSignatureData is a party's share of a presignature: (R, k_i, chi_i) in CGGMP21, where k = sum(k_i) is the
+	// nonce, R = k^-1*G and chi = sum(chi_i) = k*x. It must be used by the same set of signers, and for one message
+	// only: signing two messages with it reveals the key.
+	PreSignatureData struct {
+		// the keys of the signers, in the sorted order of the presigning parties, and the key of this party
+		Ks      []*big.Int
+		ShareID *big.Int
+
+		BigR *crypto.ECPoint
+
+		// secret fields (not shared, but stored locally)
+		KI, ChiI *big.Int
+
+		ECDSAPub *crypto.ECPoint
+
+		// for the identification phase of signing, indexed like Ks: K_j = Enc_j(k_j) and the encryption of
+		// chi_j - k_j*w_j, both under the Paillier key of Pj
+		KCiphertexts,
+		ChiCiphertexts []*big.Int
+	}
+)
+
+// Used reports whether the presignature has been consumed by signing or wiped with Zeroize
+func (pre PreSignatureData) Used() bool {
+	return pre.KI == nil || pre.KI.Sign() == 0
+}
+
+// Zeroize overwrites the secret shares of the presignature, after which it can no longer be used
+func (pre PreSignatureData) Zeroize() {
+	common.Zeroize(pre.KI, pre.ChiI)
+}
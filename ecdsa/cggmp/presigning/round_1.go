@@ -0,0 +1,143 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package presigning
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto/encproof"
+	"github.com/binance-chain/tss-lib/ecdsa/keygen"
+	"github.com/binance-chain/tss-lib/ecdsa/signing"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+// round 1 represents round 1 of the presigning of CGGMP21 (Canetti, Gennaro, Goldfeder, Makriyannis, Peled; 2021),
+// figure 7
+func newRound1(params *tss.Parameters, key *keygen.LocalPartySaveData, data *PreSignatureData, temp *localTempData, out chan<- tss.Message, end chan<- PreSignatureData) tss.Round {
+	return &round1{
+		&base{params, key, data, temp, out, end, make([]bool, len(params.Parties().IDs())), false, 1}}
+}
+
+func (round *round1) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	if err := keygen.CheckCurve(round.Params(), tss.EC()); err != nil {
+		return round.WrapError(err, round.PartyID())
+	}
+
+	round.number = 1
+	round.started = true
+	round.resetOK()
+
+	Pi := round.PartyID()
+	i := Pi.Index
+	round.ok[i] = true
+
+	// 1. sample the nonce share k_i and the mask gamma_i, and encrypt them: K_i = Enc_i(k_i; rho_i), G_i = Enc_i(gamma_i; nu_i)
+	q := tss.EC().Params().N
+	k := common.GetRandomPositiveInt(q)
+	gamma := common.GetRandomPositiveInt(q)
+	K, rho, err := round.key.PaillierPKs[i].EncryptAndReturnRandomness(k)
+	if err != nil {
+		return round.WrapError(fmt.Errorf("failed to encrypt k: %v", err), Pi)
+	}
+	G, nu, err := round.key.PaillierPKs[i].EncryptAndReturnRandomness(gamma)
+	if err != nil {
+		return round.WrapError(fmt.Errorf("failed to encrypt gamma: %v", err), Pi)
+	}
+	round.temp.k = k
+	round.temp.gamma = gamma
+	round.temp.K = K
+	round.temp.rho = rho
+	round.temp.G = G
+	round.temp.nu = nu
+
+	// 2. P2P send the proof that K_i is the encryption of a value in range (Πenc), made with each Pj's NTilde, h1, h2
+	for j, Pj := range round.Parties().IDs() {
+		if j == i {
+			continue
+		}
+		pf, err := encproof.NewProof(round.key.PaillierPKs[i], K, k, rho, round.key.NTildej[j], round.key.H1j[j], round.key.H2j[j], Pi.KeyInt())
+		if err != nil {
+			return round.WrapError(fmt.Errorf("failed to prove the range of k: %v", err), Pi)
+		}
+		round.out <- NewPreSignRound1Message2(Pj, Pi, pf)
+	}
+
+	// BROADCAST K_i and G_i
+	r1msg1 := NewPreSignRound1Message1(Pi, K, G)
+	round.temp.preSignRound1Message1s[i] = r1msg1
+	round.out <- r1msg1
+	return nil
+}
+
+func (round *round1) Update() (bool, *tss.Error) {
+	for j, msg1 := range round.temp.preSignRound1Message1s {
+		if round.ok[j] {
+			continue
+		}
+		if msg1 == nil || !round.CanAccept(msg1) {
+			return false, nil
+		}
+		msg2 := round.temp.preSignRound1Message2s[j]
+		if msg2 == nil || !round.CanAccept(msg2) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round1) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*PreSignRound1Message1); ok {
+		return msg.IsBroadcast()
+	}
+	if _, ok := msg.Content().(*PreSignRound1Message2); ok {
+		return !msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round1) NextRound() tss.Round {
+	round.started = false
+	return &round2{round}
+}
+
+// ----- //
+
+// helper to call into signing.PrepareForSigning()
+func (round *round1) prepare() *tss.Error {
+	i := round.PartyID().Index
+
+	xi := round.key.Xi
+	ks := round.key.Ks
+	bigXs := round.key.BigXj
+
+	if round.Threshold()+1 > len(ks) {
+		return round.WrapError(fmt.Errorf("t+1=%d is not satisfied by the key count of %d", round.Threshold()+1, len(ks)))
+	}
+	if !round.key.LocalPreParams.Validate() || round.key.PaillierPKs[i] == nil {
+		return round.WrapError(errors.New("the key share has no aux-info; run the aux-info protocol on it first"))
+	}
+	// the range proofs with each peer are only sound if its moduli are large enough
+	for j, Pj := range round.Parties().IDs() {
+		if j == i {
+			continue
+		}
+		if err := keygen.CheckPeerModuli(round.Params(), round.key.PaillierPKs[j], round.key.NTildej[j], round.key.H1j[j], round.key.H2j[j]); err != nil {
+			return round.WrapError(err, Pj)
+		}
+	}
+	wi, bigWs := signing.PrepareForSigning(i, len(ks), xi, ks, bigXs)
+
+	round.temp.w = wi
+	round.temp.bigWs = bigWs
+	return nil
+}
@@ -0,0 +1,177 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package presigning
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+
+	errorspkg "github.com/pkg/errors"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+	"github.com/binance-chain/tss-lib/crypto/affgproof"
+	"github.com/binance-chain/tss-lib/crypto/logstarproof"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+func (round *round2) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 2
+	round.started = true
+	round.resetOK()
+
+	Ps := round.Parties().IDs()
+	Pi := round.PartyID()
+	i := Pi.Index
+	round.ok[i] = true
+
+	// 1. verify the proofs that each K_j is the encryption of a value in range (Πenc, made with our NTilde, h1, h2)
+	failed := make([]bool, len(Ps))
+	wg := sync.WaitGroup{}
+	verifiers := round.VerifierPool()
+	for j, Pj := range Ps {
+		if j == i {
+			continue
+		}
+		j, Pj := j, Pj
+		wg.Add(1)
+		verifiers.Go(func() {
+			defer wg.Done()
+			r1msg1 := round.temp.preSignRound1Message1s[j].Content().(*PreSignRound1Message1)
+			r1msg2 := round.temp.preSignRound1Message2s[j].Content().(*PreSignRound1Message2)
+			pkJ := round.key.PaillierPKs[j]
+			if pkJ.ValidateCiphertext(r1msg1.UnmarshalG()) != nil {
+				failed[j] = true
+				return
+			}
+			proof, err := r1msg2.UnmarshalEncProof()
+			if err != nil || !proof.Verify(pkJ, r1msg1.UnmarshalK(), round.key.NTildej[i], round.key.H1j[i], round.key.H2j[i], Pj.KeyInt()) {
+				failed[j] = true
+			}
+		})
+	}
+	wg.Wait()
+	if culprits := round.culprits(failed); len(culprits) > 0 {
+		return round.WrapError(errors.New("failed to verify the proof that K_j is in range"), culprits...)
+	}
+
+	// 2. Gamma_i = gamma_i*G
+	pointGamma := crypto.ScalarBaseMultSecret(tss.EC(), round.temp.gamma)
+	round.temp.pointGamma = pointGamma
+	generator := crypto.ScalarBaseMult(tss.EC(), big.NewInt(1))
+
+	// 3. for each Pj, D_ji = K_j^gamma_i * Enc_j(beta_ij) and F_ji = Enc_i(beta_ij), and the same with w_i and a hat,
+	// with the proofs that they are consistent with Gamma_i and W_i (Πaff-g) and that G_i encrypts the discrete
+	// logarithm of Gamma_i (Πlog*), made with Pj's NTilde, h1, h2
+	pkI := round.key.PaillierPKs[i]
+	betaBound := new(big.Int).Lsh(big.NewInt(1), affgproof.LPrime)
+	errChs := make(chan *tss.Error, len(Ps)-1)
+	msgs := make([]tss.ParsedMessage, len(Ps))
+	mta := func(j int, Pj *tss.PartyID) {
+		defer wg.Done()
+		pkJ := round.key.PaillierPKs[j]
+		NTildej, h1j, h2j := round.key.NTildej[j], round.key.H1j[j], round.key.H2j[j]
+		Kj := round.temp.preSignRound1Message1s[j].Content().(*PreSignRound1Message1).UnmarshalK()
+
+		beta, betaHat := common.GetRandomSymmetricInt(betaBound), common.GetRandomSymmetricInt(betaBound)
+		D, s, err := affine(pkJ, Kj, round.temp.gamma, beta)
+		if err != nil {
+			errChs <- round.WrapError(errorspkg.Wrapf(err, "invalid K_j"), Pj)
+			return
+		}
+		DHat, sHat, err := affine(pkJ, Kj, round.temp.w, betaHat)
+		if err != nil {
+			errChs <- round.WrapError(errorspkg.Wrapf(err, "invalid K_j"), Pj)
+			return
+		}
+		F, r := encryptSigned(pkI, beta)
+		FHat, rHat := encryptSigned(pkI, betaHat)
+		psi, err := affgproof.NewProof(pkJ, pkI, Kj, D, F, pointGamma, round.temp.gamma, beta, s, r, NTildej, h1j, h2j, Pi.KeyInt())
+		if err != nil {
+			errChs <- round.WrapError(err, Pi)
+			return
+		}
+		psiHat, err := affgproof.NewProof(pkJ, pkI, Kj, DHat, FHat, round.temp.bigWs[i], round.temp.w, betaHat, sHat, rHat, NTildej, h1j, h2j, Pi.KeyInt())
+		if err != nil {
+			errChs <- round.WrapError(err, Pi)
+			return
+		}
+		psiPrime, err := logstarproof.NewProof(pkI, round.temp.G, pointGamma, generator, round.temp.gamma, round.temp.nu, NTildej, h1j, h2j, Pi.KeyInt())
+		if err != nil {
+			errChs <- round.WrapError(err, Pi)
+			return
+		}
+		common.Zeroize(s, sHat, r, rHat)
+		// should be thread safe as these are pre-allocated
+		round.temp.betas[j], round.temp.betaHats[j] = beta, betaHat
+		round.temp.Ds[j], round.temp.Fs[j], round.temp.DHats[j], round.temp.FHats[j] = D, F, DHat, FHat
+		msgs[j] = NewPreSignRound2Message2(Pj, Pi, psi, psiHat, psiPrime)
+	}
+	for j, Pj := range Ps {
+		if j == i {
+			continue
+		}
+		j, Pj := j, Pj
+		wg.Add(1)
+		verifiers.Go(func() { mta(j, Pj) })
+	}
+	wg.Wait()
+	close(errChs)
+	if err := round.collectMtAErrors(errChs, "failed to run the MtA"); err != nil {
+		return err
+	}
+
+	// P2P send the proofs
+	for j := range Ps {
+		if j == i {
+			continue
+		}
+		round.out <- msgs[j]
+	}
+
+	// BROADCAST Gamma_i and the MtA ciphertexts
+	r2msg1 := NewPreSignRound2Message1(Pi, pointGamma, round.temp.Ds, round.temp.Fs, round.temp.DHats, round.temp.FHats)
+	round.temp.preSignRound2Message1s[i] = r2msg1
+	round.out <- r2msg1
+	return nil
+}
+
+func (round *round2) Update() (bool, *tss.Error) {
+	for j, msg1 := range round.temp.preSignRound2Message1s {
+		if round.ok[j] {
+			continue
+		}
+		if msg1 == nil || !round.CanAccept(msg1) {
+			return false, nil
+		}
+		msg2 := round.temp.preSignRound2Message2s[j]
+		if msg2 == nil || !round.CanAccept(msg2) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round2) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*PreSignRound2Message1); ok {
+		return msg.IsBroadcast()
+	}
+	if _, ok := msg.Content().(*PreSignRound2Message2); ok {
+		return !msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round2) NextRound() tss.Round {
+	round.started = false
+	return &round3{round}
+}
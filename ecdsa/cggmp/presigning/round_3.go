@@ -0,0 +1,245 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package presigning
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	errorspkg "github.com/pkg/errors"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+	"github.com/binance-chain/tss-lib/crypto/affgproof"
+	"github.com/binance-chain/tss-lib/crypto/logstarproof"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+func (round *round3) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 3
+	round.started = true
+	round.resetOK()
+
+	Ps := round.Parties().IDs()
+	Pi := round.PartyID()
+	i := Pi.Index
+	round.ok[i] = true
+
+	// 1. check the MtA ciphertexts broadcast by each Pj, which the identification phases rely on
+	bigGammas := make([]*crypto.ECPoint, len(Ps))
+	bigGammas[i] = round.temp.pointGamma
+	failed := make([]bool, len(Ps))
+	for j := range Ps {
+		if j == i {
+			continue
+		}
+		r2msg1 := round.temp.preSignRound2Message1s[j].Content().(*PreSignRound2Message1)
+		bigGammaJ, err := r2msg1.UnmarshalGamma()
+		if err != nil || round.validateCiphertexts(j, r2msg1) != nil {
+			failed[j] = true
+			continue
+		}
+		bigGammas[j] = bigGammaJ
+	}
+	if culprits := round.culprits(failed); len(culprits) > 0 {
+		return round.WrapError(errors.New("received invalid Gamma_j or MtA ciphertexts"), culprits...)
+	}
+	bigGamma := bigGammas[i]
+	for j, Pj := range Ps {
+		if j == i {
+			continue
+		}
+		var err error
+		if bigGamma, err = bigGamma.Add(bigGammas[j]); err != nil {
+			return round.WrapError(errorspkg.Wrapf(err, "bigGamma.Add(bigGammaJ)"), Pj)
+		}
+	}
+
+	// 2. verify the proofs of each Pj that D_ij and DHat_ij are affine operations on K_i by the discrete logarithms
+	// of Gamma_j and W_j (Πaff-g), and that G_j encrypts the discrete logarithm of Gamma_j (Πlog*), then decrypt
+	// alpha_ij and alphaHat_ij
+	pkI := round.key.PaillierPKs[i]
+	NTildei, h1i, h2i := round.key.NTildej[i], round.key.H1j[i], round.key.H2j[i]
+	generator := crypto.ScalarBaseMult(tss.EC(), big.NewInt(1))
+	q := tss.EC().Params().N
+	alphaBound := new(big.Int).Add(new(big.Int).Lsh(big.NewInt(1), affgproof.LPrime), new(big.Int).Mul(q, q))
+	alphas := make([]*big.Int, len(Ps))
+	alphaHats := make([]*big.Int, len(Ps))
+	errChs := make(chan *tss.Error, len(Ps)-1)
+	wg := sync.WaitGroup{}
+	aliceEnd := func(j int, Pj *tss.PartyID) {
+		defer wg.Done()
+		pkJ := round.key.PaillierPKs[j]
+		r1msg1 := round.temp.preSignRound1Message1s[j].Content().(*PreSignRound1Message1)
+		r2msg1 := round.temp.preSignRound2Message1s[j].Content().(*PreSignRound2Message1)
+		r2msg2 := round.temp.preSignRound2Message2s[j].Content().(*PreSignRound2Message2)
+		Ds, Fs, DHats, FHats := r2msg1.UnmarshalCiphertexts()
+
+		psi, err := r2msg2.UnmarshalAffGProof()
+		if err != nil || !psi.Verify(pkI, pkJ, round.temp.K, Ds[i], Fs[i], bigGammas[j], NTildei, h1i, h2i, Pj.KeyInt()) {
+			errChs <- round.WrapError(errors.New("failed to verify the affine operation with Gamma_j"), Pj)
+			return
+		}
+		psiHat, err := r2msg2.UnmarshalAffGProofHat()
+		if err != nil || !psiHat.Verify(pkI, pkJ, round.temp.K, DHats[i], FHats[i], round.temp.bigWs[j], NTildei, h1i, h2i, Pj.KeyInt()) {
+			errChs <- round.WrapError(errors.New("failed to verify the affine operation with W_j"), Pj)
+			return
+		}
+		psiPrime, err := r2msg2.UnmarshalLogStarProof()
+		if err != nil || !psiPrime.Verify(pkJ, r1msg1.UnmarshalG(), bigGammas[j], generator, NTildei, h1i, h2i, Pj.KeyInt()) {
+			errChs <- round.WrapError(errors.New("failed to verify the proof that G_j encrypts the discrete logarithm of Gamma_j"), Pj)
+			return
+		}
+		alpha, err := round.key.PaillierSK.DecryptSigned(Ds[i])
+		if err != nil {
+			errChs <- round.WrapError(err, Pj)
+			return
+		}
+		alphaHat, err := round.key.PaillierSK.DecryptSigned(DHats[i])
+		if err != nil {
+			errChs <- round.WrapError(err, Pj)
+			return
+		}
+		if new(big.Int).Abs(alpha).Cmp(alphaBound) > 0 || new(big.Int).Abs(alphaHat).Cmp(alphaBound) > 0 {
+			errChs <- round.WrapError(fmt.Errorf("the plaintext of D_ij is out of range"), Pj)
+			return
+		}
+		// should be thread safe as these are pre-allocated
+		alphas[j], alphaHats[j] = alpha, alphaHat
+	}
+	verifiers := round.VerifierPool()
+	for j, Pj := range Ps {
+		if j == i {
+			continue
+		}
+		j, Pj := j, Pj
+		wg.Add(1)
+		verifiers.Go(func() { aliceEnd(j, Pj) })
+	}
+	wg.Wait()
+	close(errChs)
+	if err := round.collectMtAErrors(errChs, "failed to verify the MtA"); err != nil {
+		return err
+	}
+
+	// 3. delta_i = k_i*gamma_i + sum(alpha_ij - beta_ij), chi_i = k_i*w_i + sum(alphaHat_ij - betaHat_ij)
+	modQ := common.ModInt(q)
+	delta := modQ.Mul(round.temp.k, round.temp.gamma)
+	chi := modQ.Mul(round.temp.k, round.temp.w)
+	for j := range Ps {
+		if j == i {
+			continue
+		}
+		delta = modQ.Add(delta, modQ.Sub(alphas[j], round.temp.betas[j]))
+		chi = modQ.Add(chi, modQ.Sub(alphaHats[j], round.temp.betaHats[j]))
+	}
+	common.Zeroize(alphas...)
+	common.Zeroize(alphaHats...)
+	round.temp.delta = delta
+	round.temp.chi = chi
+	round.temp.bigGamma = bigGamma
+
+	// 4. Delta_i = k_i*Gamma, with a proof to each Pj that it has the same k_i as K (Πlog*)
+	bigDelta := bigGamma.ScalarMultSecret(round.temp.k)
+	round.temp.bigDelta = bigDelta
+	proofs := make([]*logstarproof.Proof, len(Ps))
+	proofErrs := make([]error, len(Ps))
+	for j := range Ps {
+		if j == i {
+			continue
+		}
+		wg.Add(1)
+		go func(j int) {
+			defer wg.Done()
+			proofs[j], proofErrs[j] = logstarproof.NewProof(
+				pkI, round.temp.K, bigDelta, bigGamma, round.temp.k, round.temp.rho,
+				round.key.NTildej[j], round.key.H1j[j], round.key.H2j[j], Pi.KeyInt())
+		}(j)
+	}
+	wg.Wait()
+	for _, err := range proofErrs {
+		if err != nil {
+			return round.WrapError(err, Pi)
+		}
+	}
+	for j, Pj := range Ps {
+		if j == i {
+			continue
+		}
+		round.out <- NewPreSignRound3Message2(Pj, Pi, proofs[j])
+	}
+
+	// BROADCAST delta_i and Delta_i
+	r3msg1 := NewPreSignRound3Message1(Pi, delta, bigDelta)
+	round.temp.preSignRound3Message1s[i] = r3msg1
+	round.out <- r3msg1
+	return nil
+}
+
+func (round *round3) Update() (bool, *tss.Error) {
+	for j, msg1 := range round.temp.preSignRound3Message1s {
+		if round.ok[j] {
+			continue
+		}
+		if msg1 == nil || !round.CanAccept(msg1) {
+			return false, nil
+		}
+		msg2 := round.temp.preSignRound3Message2s[j]
+		if msg2 == nil || !round.CanAccept(msg2) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round3) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*PreSignRound3Message1); ok {
+		return msg.IsBroadcast()
+	}
+	if _, ok := msg.Content().(*PreSignRound3Message2); ok {
+		return !msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round3) NextRound() tss.Round {
+	round.started = false
+	return &round4{round}
+}
+
+// ----- //
+
+// validateCiphertexts checks that Pj sent a D_kj and DHat_kj under the key of each other Pk, and the matching F_kj
+// and FHat_kj under its own key
+func (round *round3) validateCiphertexts(j int, msg *PreSignRound2Message1) error {
+	Ds, Fs, DHats, FHats := msg.UnmarshalCiphertexts()
+	if len(Ds) != len(round.Parties().IDs()) {
+		return fmt.Errorf("expected %d ciphertexts but got %d", len(round.Parties().IDs()), len(Ds))
+	}
+	pkJ := round.key.PaillierPKs[j]
+	for k := range Ds {
+		if k == j {
+			continue
+		}
+		pkK := round.key.PaillierPKs[k]
+		for _, err := range []error{
+			pkK.ValidateCiphertext(Ds[k]), pkK.ValidateCiphertext(DHats[k]),
+			pkJ.ValidateCiphertext(Fs[k]), pkJ.ValidateCiphertext(FHats[k]),
+		} {
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
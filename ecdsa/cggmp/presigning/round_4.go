@@ -0,0 +1,282 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package presigning
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+
+	errorspkg "github.com/pkg/errors"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+	"github.com/binance-chain/tss-lib/crypto/decproof"
+	"github.com/binance-chain/tss-lib/crypto/mulproof"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+func (round *round4) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 4
+	round.started = true
+	round.resetOK()
+
+	Ps := round.Parties().IDs()
+	Pi := round.PartyID()
+	i := Pi.Index
+
+	// 1. verify that each Delta_j = k_j*Gamma for the k_j encrypted in K_j (Πlog*, made with our NTilde, h1, h2)
+	bigDeltas := make([]*crypto.ECPoint, len(Ps))
+	bigDeltas[i] = round.temp.bigDelta
+	failed := make([]bool, len(Ps))
+	wg := sync.WaitGroup{}
+	verifiers := round.VerifierPool()
+	for j, Pj := range Ps {
+		round.ok[j] = true
+		if j == i {
+			continue
+		}
+		r3msg1 := round.temp.preSignRound3Message1s[j].Content().(*PreSignRound3Message1)
+		bigDeltaJ, err := r3msg1.UnmarshalBigDelta()
+		if err != nil {
+			failed[j] = true
+			continue
+		}
+		bigDeltas[j] = bigDeltaJ
+		j, Pj := j, Pj
+		wg.Add(1)
+		verifiers.Go(func() {
+			defer wg.Done()
+			r1msg1 := round.temp.preSignRound1Message1s[j].Content().(*PreSignRound1Message1)
+			r3msg2 := round.temp.preSignRound3Message2s[j].Content().(*PreSignRound3Message2)
+			proof, err := r3msg2.UnmarshalLogStarProof()
+			if err != nil || !proof.Verify(
+				round.key.PaillierPKs[j], r1msg1.UnmarshalK(), bigDeltas[j], round.temp.bigGamma,
+				round.key.NTildej[i], round.key.H1j[i], round.key.H2j[i], Pj.KeyInt()) {
+				failed[j] = true
+			}
+		})
+	}
+	wg.Wait()
+	if culprits := round.culprits(failed); len(culprits) > 0 {
+		for _, Pj := range culprits {
+			round.ok[Pj.Index] = false
+		}
+		return round.WrapError(errors.New("failed to verify the proof that Delta_j matches K_j"), culprits...)
+	}
+
+	// 2. delta = sum(delta_j) = k*gamma, which must match sum(Delta_j) = k*Gamma
+	modQ := common.ModInt(tss.EC().Params().N)
+	delta := round.temp.delta
+	bigDelta := bigDeltas[i]
+	for j, Pj := range Ps {
+		if j == i {
+			continue
+		}
+		r3msg1 := round.temp.preSignRound3Message1s[j].Content().(*PreSignRound3Message1)
+		delta = modQ.Add(delta, r3msg1.UnmarshalDelta())
+		var err error
+		if bigDelta, err = bigDelta.Add(bigDeltas[j]); err != nil {
+			return round.WrapError(errorspkg.Wrapf(err, "bigDelta.Add(bigDeltaJ)"), Pj)
+		}
+	}
+	if !crypto.ScalarBaseMult(tss.EC(), delta).Equals(bigDelta) {
+		// some party broadcast a delta_j that does not match its MtA shares; find it
+		round.temp.identifying = true
+		return round.startIdentification()
+	}
+
+	// 3. R = delta^-1 * Gamma
+	bigR := round.temp.bigGamma.ScalarMult(modQ.ModInverse(delta))
+
+	// 4. the ciphertexts that the signers need to identify an invalid signature share
+	KCiphertexts := make([]*big.Int, len(Ps))
+	ChiCiphertexts := make([]*big.Int, len(Ps))
+	for j, Pj := range Ps {
+		if j == i {
+			KCiphertexts[j] = round.temp.K
+		} else {
+			KCiphertexts[j] = round.temp.preSignRound1Message1s[j].Content().(*PreSignRound1Message1).UnmarshalK()
+		}
+		Ds, Fs := round.mtaCiphertextsOf(j, true)
+		var err error
+		if ChiCiphertexts[j], err = mtaCiphertext(round.key.PaillierPKs[j], j, Ds, Fs); err != nil {
+			return round.WrapError(err, Pj)
+		}
+	}
+
+	round.data.Ks = round.key.Ks
+	round.data.ShareID = Pi.KeyInt()
+	round.data.BigR = bigR
+	round.data.KI = round.temp.k
+	round.data.ChiI = round.temp.chi
+	round.data.ECDSAPub = round.key.ECDSAPub
+	round.data.KCiphertexts = KCiphertexts
+	round.data.ChiCiphertexts = ChiCiphertexts
+	round.end <- *round.data
+
+	return nil
+}
+
+// startIdentification proves to each Pj that delta_i is the plaintext mod q of
+// H_i * prod(D_ij * F_ji^-1) = Enc_i(k_i*gamma_i + sum(alpha_ij - beta_ij)), where H_i = G_i^k_i * rho^N (Πmul, Πdec)
+func (round *round4) startIdentification() *tss.Error {
+	Ps := round.Parties().IDs()
+	Pi := round.PartyID()
+	i := Pi.Index
+
+	pkI := round.key.PaillierPKs[i]
+	modNSquare := common.ModInt(pkI.NSquare())
+	rhoH, rhoHN := pkI.Randomness()
+	H := modNSquare.Mul(modNSquare.ExpSecret(round.temp.G, round.temp.k), rhoHN)
+	mulProof, err := mulproof.NewProof(pkI, round.temp.K, round.temp.G, H, round.temp.k, round.temp.rho, rhoH, Pi.KeyInt())
+	common.Zeroize(rhoH)
+	if err != nil {
+		return round.WrapError(err, Pi)
+	}
+	C, err := round.deltaCiphertext(i, H)
+	if err != nil {
+		return round.WrapError(err)
+	}
+	y, err := round.key.PaillierSK.DecryptSigned(C)
+	if err != nil {
+		return round.WrapError(err, Pi)
+	}
+	rho, err := round.key.PaillierSK.RecoverRandomness(C)
+	if err != nil {
+		return round.WrapError(err, Pi)
+	}
+	defer common.Zeroize(y, rho)
+
+	proofs := make([]*decproof.Proof, len(Ps))
+	proofErrs := make([]error, len(Ps))
+	wg := sync.WaitGroup{}
+	for j := range Ps {
+		round.ok[j] = j == i
+		if j == i {
+			continue
+		}
+		wg.Add(1)
+		go func(j int) {
+			defer wg.Done()
+			proofs[j], proofErrs[j] = decproof.NewProof(
+				pkI, C, round.temp.delta, y, rho, round.key.NTildej[j], round.key.H1j[j], round.key.H2j[j], Pi.KeyInt())
+		}(j)
+	}
+	wg.Wait()
+	for _, err := range proofErrs {
+		if err != nil {
+			return round.WrapError(err, Pi)
+		}
+	}
+	for j, Pj := range Ps {
+		if j == i {
+			continue
+		}
+		round.out <- NewPreSignRound4Message2(Pj, Pi, proofs[j])
+	}
+
+	// BROADCAST H_i and the proof that it encrypts k_i*gamma_i
+	r4msg1 := NewPreSignRound4Message1(Pi, H, mulProof)
+	round.temp.preSignRound4Message1s[i] = r4msg1
+	round.out <- r4msg1
+	return nil
+}
+
+func (round *round4) Update() (bool, *tss.Error) {
+	if !round.temp.identifying {
+		// not expecting any incoming messages in this round
+		return false, nil
+	}
+	for j, msg1 := range round.temp.preSignRound4Message1s {
+		if round.ok[j] {
+			continue
+		}
+		if msg1 == nil || !round.CanAccept(msg1) {
+			return false, nil
+		}
+		msg2 := round.temp.preSignRound4Message2s[j]
+		if msg2 == nil || !round.CanAccept(msg2) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round4) CanAccept(msg tss.ParsedMessage) bool {
+	if !round.temp.identifying {
+		// not expecting any incoming messages in this round
+		return false
+	}
+	if _, ok := msg.Content().(*PreSignRound4Message1); ok {
+		return msg.IsBroadcast()
+	}
+	if _, ok := msg.Content().(*PreSignRound4Message2); ok {
+		return !msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round4) NextRound() tss.Round {
+	if !round.temp.identifying {
+		return nil // finished!
+	}
+	round.started = false
+	return &identification{round}
+}
+
+// ----- //
+
+// mtaCiphertextsOf returns the MtA ciphertexts of Pj as Alice, under its key: the D_jk that each Pk sent it and the
+// F_kj that it sent to each Pk, or DHat_jk and FHat_kj with `hat`
+func (round *base) mtaCiphertextsOf(j int, hat bool) (Ds, Fs []*big.Int) {
+	Ps := round.Parties().IDs()
+	Ds = make([]*big.Int, len(Ps))
+	for k := range Ps {
+		kDs, kFs := round.mtaCiphertextsFrom(k, hat)
+		if k == j {
+			Fs = kFs
+			continue
+		}
+		Ds[k] = kDs[j]
+	}
+	return Ds, Fs
+}
+
+// mtaCiphertextsFrom returns the ciphertexts that Pk broadcast in round 2, or computed if it is this party
+func (round *base) mtaCiphertextsFrom(k int, hat bool) (Ds, Fs []*big.Int) {
+	if k == round.PartyID().Index {
+		if hat {
+			return round.temp.DHats, round.temp.FHats
+		}
+		return round.temp.Ds, round.temp.Fs
+	}
+	Ds, Fs, DHats, FHats := round.temp.preSignRound2Message1s[k].Content().(*PreSignRound2Message1).UnmarshalCiphertexts()
+	if hat {
+		return DHats, FHats
+	}
+	return Ds, Fs
+}
+
+// deltaCiphertext returns H_j * prod(D_jk * F_kj^-1), the encryption under the key of Pj of the delta_j that it should
+// have broadcast
+func (round *base) deltaCiphertext(j int, H *big.Int) (*big.Int, error) {
+	pkJ := round.key.PaillierPKs[j]
+	if err := pkJ.ValidateCiphertext(H); err != nil {
+		return nil, err
+	}
+	Ds, Fs := round.mtaCiphertextsOf(j, false)
+	C, err := mtaCiphertext(pkJ, j, Ds, Fs)
+	if err != nil {
+		return nil, err
+	}
+	return common.ModInt(pkJ.NSquare()).Mul(H, C), nil
+}
@@ -0,0 +1,137 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package presigning
+
+import (
+	"github.com/hashicorp/go-multierror"
+	errorspkg "github.com/pkg/errors"
+
+	"github.com/binance-chain/tss-lib/ecdsa/keygen"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+const (
+	TaskName = "ecdsa-cggmp-presigning"
+)
+
+type (
+	base struct {
+		*tss.Parameters
+		key     *keygen.LocalPartySaveData
+		data    *PreSignatureData
+		temp    *localTempData
+		out     chan<- tss.Message
+		end     chan<- PreSignatureData
+		ok      []bool // `ok` tracks parties which have been verified by Update()
+		started bool
+		number  int
+	}
+	round1 struct {
+		*base
+	}
+	round2 struct {
+		*round1
+	}
+	round3 struct {
+		*round2
+	}
+	round4 struct {
+		*round3
+	}
+	identification struct {
+		*round4
+	}
+)
+
+var (
+	_ tss.Round = (*round1)(nil)
+	_ tss.Round = (*round2)(nil)
+	_ tss.Round = (*round3)(nil)
+	_ tss.Round = (*round4)(nil)
+	_ tss.Round = (*identification)(nil)
+)
+
+// ----- //
+
+func (round *base) Params() *tss.Parameters {
+	return round.Parameters
+}
+
+func (round *base) RoundNumber() int {
+	return round.number
+}
+
+// CanProceed is inherited by other rounds
+func (round *base) CanProceed() bool {
+	if !round.started {
+		return false
+	}
+	for _, ok := range round.ok {
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// WaitingFor is called by a Party for reporting back to the caller
+func (round *base) WaitingFor() []*tss.PartyID {
+	Ps := round.Parties().IDs()
+	ids := make([]*tss.PartyID, 0, len(round.ok))
+	for j, ok := range round.ok {
+		if ok {
+			continue
+		}
+		ids = append(ids, Ps[j])
+	}
+	return ids
+}
+
+func (round *base) WrapError(err error, culprits ...*tss.PartyID) *tss.Error {
+	return tss.NewError(err, TaskName, round.number, round.PartyID(), culprits...)
+}
+
+// ----- //
+
+// `ok` tracks parties which have been verified by Update()
+func (round *base) resetOK() {
+	for j := range round.ok {
+		round.ok[j] = false
+	}
+}
+
+// collectMtAErrors drains the errors of the MtA routines, keeping the cause of each and naming each culprit once
+func (round *base) collectMtAErrors(errChs <-chan *tss.Error, msg string) *tss.Error {
+	var multiErr error
+	culprits := make([]*tss.PartyID, 0, len(round.Parties().IDs()))
+	seen := make(map[*tss.PartyID]struct{}, len(round.Parties().IDs()))
+	for err := range errChs {
+		multiErr = multierror.Append(multiErr, err.Cause())
+		for _, culprit := range err.Culprits() {
+			if _, found := seen[culprit]; !found {
+				seen[culprit] = struct{}{}
+				culprits = append(culprits, culprit)
+			}
+		}
+	}
+	if multiErr == nil {
+		return nil
+	}
+	return round.WrapError(errorspkg.Wrap(multiErr, msg), culprits...)
+}
+
+// culprits returns the parties whose entry in `failed` is set
+func (round *base) culprits(failed []bool) []*tss.PartyID {
+	Ps := round.Parties().IDs()
+	culprits := make([]*tss.PartyID, 0, len(Ps))
+	for j, f := range failed {
+		if f {
+			culprits = append(culprits, Ps[j])
+		}
+	}
+	return culprits
+}
@@ -0,0 +1,176 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: protob/ecdsa-cggmp-signing.proto
+
+package signing
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// Represents a BROADCAST message sent to all parties during Round 1 of the ECDSA signing with a presignature.
+type SignRound1Message struct {
+	Sigma                []byte   `protobuf:"bytes,1,opt,name=sigma,proto3" json:"sigma,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignRound1Message) Reset()         { *m = SignRound1Message{} }
+func (m *SignRound1Message) String() string { return proto.CompactTextString(m) }
+func (*SignRound1Message) ProtoMessage()    {}
+func (*SignRound1Message) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f841d82733429d22, []int{0}
+}
+
+func (m *SignRound1Message) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignRound1Message.Unmarshal(m, b)
+}
+func (m *SignRound1Message) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignRound1Message.Marshal(b, m, deterministic)
+}
+func (m *SignRound1Message) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignRound1Message.Merge(m, src)
+}
+func (m *SignRound1Message) XXX_Size() int {
+	return xxx_messageInfo_SignRound1Message.Size(m)
+}
+func (m *SignRound1Message) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignRound1Message.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignRound1Message proto.InternalMessageInfo
+
+func (m *SignRound1Message) GetSigma() []byte {
+	if m != nil {
+		return m.Sigma
+	}
+	return nil
+}
+
+// Represents a BROADCAST message sent to all parties during the identification phase of the ECDSA signing with a
+// presignature.
+type SignRound2Message1 struct {
+	HHat                 []byte   `protobuf:"bytes,1,opt,name=h_hat,json=hHat,proto3" json:"h_hat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignRound2Message1) Reset()         { *m = SignRound2Message1{} }
+func (m *SignRound2Message1) String() string { return proto.CompactTextString(m) }
+func (*SignRound2Message1) ProtoMessage()    {}
+func (*SignRound2Message1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f841d82733429d22, []int{1}
+}
+
+func (m *SignRound2Message1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignRound2Message1.Unmarshal(m, b)
+}
+func (m *SignRound2Message1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignRound2Message1.Marshal(b, m, deterministic)
+}
+func (m *SignRound2Message1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignRound2Message1.Merge(m, src)
+}
+func (m *SignRound2Message1) XXX_Size() int {
+	return xxx_messageInfo_SignRound2Message1.Size(m)
+}
+func (m *SignRound2Message1) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignRound2Message1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignRound2Message1 proto.InternalMessageInfo
+
+func (m *SignRound2Message1) GetHHat() []byte {
+	if m != nil {
+		return m.HHat
+	}
+	return nil
+}
+
+// Represents a P2P message sent to each party during the identification phase of the ECDSA signing with a
+// presignature.
+type SignRound2Message2 struct {
+	MulStarProof         [][]byte `protobuf:"bytes,1,rep,name=mul_star_proof,json=mulStarProof,proto3" json:"mul_star_proof,omitempty"`
+	DecProof             [][]byte `protobuf:"bytes,2,rep,name=dec_proof,json=decProof,proto3" json:"dec_proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignRound2Message2) Reset()         { *m = SignRound2Message2{} }
+func (m *SignRound2Message2) String() string { return proto.CompactTextString(m) }
+func (*SignRound2Message2) ProtoMessage()    {}
+func (*SignRound2Message2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f841d82733429d22, []int{2}
+}
+
+func (m *SignRound2Message2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignRound2Message2.Unmarshal(m, b)
+}
+func (m *SignRound2Message2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignRound2Message2.Marshal(b, m, deterministic)
+}
+func (m *SignRound2Message2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignRound2Message2.Merge(m, src)
+}
+func (m *SignRound2Message2) XXX_Size() int {
+	return xxx_messageInfo_SignRound2Message2.Size(m)
+}
+func (m *SignRound2Message2) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignRound2Message2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignRound2Message2 proto.InternalMessageInfo
+
+func (m *SignRound2Message2) GetMulStarProof() [][]byte {
+	if m != nil {
+		return m.MulStarProof
+	}
+	return nil
+}
+
+func (m *SignRound2Message2) GetDecProof() [][]byte {
+	if m != nil {
+		return m.DecProof
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SignRound1Message)(nil), "SignRound1Message")
+	proto.RegisterType((*SignRound2Message1)(nil), "SignRound2Message1")
+	proto.RegisterType((*SignRound2Message2)(nil), "SignRound2Message2")
+}
+
+func init() { proto.RegisterFile("protob/ecdsa-cggmp-signing.proto", fileDescriptor_f841d82733429d22) }
+
+var fileDescriptor_f841d82733429d22 = []byte{
+	// 194 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x28, 0x28, 0xca, 0x2f,
+	0xc9, 0x4f, 0xd2, 0x4f, 0x4d, 0x4e, 0x29, 0x4e, 0xd4, 0x4d, 0x4e, 0x4f, 0xcf, 0x2d, 0xd0, 0x2d,
+	0xce, 0x4c, 0xcf, 0xcb, 0xcc, 0x4b, 0xd7, 0x03, 0x4b, 0x29, 0x69, 0x72, 0x09, 0x06, 0x67, 0xa6,
+	0xe7, 0x05, 0xe5, 0x97, 0xe6, 0xa5, 0x18, 0xfa, 0xa6, 0x16, 0x17, 0x27, 0xa6, 0xa7, 0x0a, 0x89,
+	0x70, 0xb1, 0x16, 0x67, 0xa6, 0xe7, 0x26, 0x4a, 0x30, 0x2a, 0x30, 0x6a, 0xf0, 0x04, 0x41, 0x38,
+	0x4a, 0x9a, 0x5c, 0x42, 0x70, 0xa5, 0x46, 0x50, 0xa5, 0x86, 0x42, 0xc2, 0x5c, 0xac, 0x19, 0xf1,
+	0x19, 0x89, 0x25, 0x50, 0xb5, 0x2c, 0x19, 0x1e, 0x89, 0x25, 0x4a, 0xe1, 0x58, 0x94, 0x1a, 0x09,
+	0xa9, 0x70, 0xf1, 0xe5, 0x96, 0xe6, 0xc4, 0x17, 0x97, 0x24, 0x16, 0xc5, 0x17, 0x14, 0xe5, 0xe7,
+	0xa7, 0x49, 0x30, 0x2a, 0x30, 0x6b, 0xf0, 0x04, 0xf1, 0xe4, 0x96, 0xe6, 0x04, 0x97, 0x24, 0x16,
+	0x05, 0x80, 0xc4, 0x84, 0xa4, 0xb9, 0x38, 0x53, 0x52, 0x93, 0xa1, 0x0a, 0x98, 0xc0, 0x0a, 0x38,
+	0x52, 0x52, 0x93, 0xc1, 0x92, 0x4e, 0xa2, 0x51, 0xc2, 0x60, 0xbf, 0xe8, 0x83, 0xfd, 0xa2, 0x0f,
+	0xf5, 0x4b, 0x12, 0x1b, 0xd8, 0x33, 0xc6, 0x80, 0x01, 0x00, 0x99, 0x36, 0xab, 0x4f, 0xf0, 0x00,
+	0x00, 0x00,
+}
@@ -0,0 +1,222 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"sync"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto/decproof"
+	"github.com/binance-chain/tss-lib/crypto/mulstarproof"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+func (round *finalization) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 2
+	round.started = true
+	round.resetOK()
+
+	sumS := round.temp.sigma
+	modN := common.ModInt(tss.EC().Params().N)
+
+	for j := range round.Parties().IDs() {
+		round.ok[j] = true
+		if j == round.PartyID().Index {
+			continue
+		}
+		r1msg := round.temp.signRound1Messages[j].Content().(*SignRound1Message)
+		sumS = modN.Add(sumS, r1msg.UnmarshalSigma())
+	}
+
+	// the recovery ID is computed as in GG18 signing:
+	// bit 0 is set when R.Y is odd and bit 1 when R.X >= N, i.e. the reduction changed it.
+	N := tss.EC().Params().N
+	r := round.temp.r
+	recid := 0
+	if round.preSig.BigR.X().Cmp(N) >= 0 {
+		recid = 2
+	}
+	if round.preSig.BigR.Y().Bit(0) != 0 {
+		recid |= 1
+	}
+
+	// (N - s, r) is the signature for the nonce -k whose point -R has the opposite Y parity, so bit 0 of the recovery ID flips.
+	if round.SNormalization() == tss.LowS {
+		halfN := new(big.Int).Rsh(N, 1)
+		if sumS.Cmp(halfN) > 0 {
+			sumS = new(big.Int).Sub(N, sumS)
+			recid ^= 1
+		}
+	}
+
+	// save the signature for final output
+	bitSizeInBytes := (N.BitLen() + 7) / 8
+	round.data.R = padToLengthBytesInPlace(r.Bytes(), bitSizeInBytes)
+	round.data.S = padToLengthBytesInPlace(sumS.Bytes(), bitSizeInBytes)
+	round.data.Signature = append(round.data.R, round.data.S...)
+	round.data.SignatureRecovery = []byte{byte(recid)}
+	round.data.M = round.temp.m.Bytes()
+
+	pk := ecdsa.PublicKey{
+		Curve: tss.EC(),
+		X:     round.preSig.ECDSAPub.X(),
+		Y:     round.preSig.ECDSAPub.Y(),
+	}
+	ok := ecdsa.Verify(&pk, round.data.M, r, sumS)
+	if !ok {
+		// some party broadcast a sigma_j that does not match its presignature; find it
+		round.temp.identifying = true
+		return round.startIdentification()
+	}
+
+	round.end <- *round.data
+
+	return nil
+}
+
+// startIdentification proves to each Pj that sigma_i is the plaintext mod q of K_i^m * (HHat_i * ChiHat_i)^r, where
+// HHat_i = K_i^w_i * rho^N and ChiHat_i is the presignature's encryption of chi_i - k_i*w_i (Πmul*, Πdec)
+func (round *finalization) startIdentification() *tss.Error {
+	Ps := round.Parties().IDs()
+	Pi := round.PartyID()
+	i := Pi.Index
+
+	pkI := round.key.PaillierPKs[i]
+	Ki := round.preSig.KCiphertexts[i]
+	if err := pkI.ValidateCiphertext(Ki); err != nil {
+		return round.WrapError(err, Pi)
+	}
+	modNSquare := common.ModInt(pkI.NSquare())
+	rhoHHat, rhoHHatN := pkI.Randomness()
+	defer common.Zeroize(rhoHHat)
+	HHat := modNSquare.Mul(modNSquare.ExpSecret(Ki, round.temp.w), rhoHHatN)
+	Q, err := round.sigmaCiphertext(i, HHat)
+	if err != nil {
+		return round.WrapError(err, Pi)
+	}
+	y, err := round.key.PaillierSK.DecryptSigned(Q)
+	if err != nil {
+		return round.WrapError(err, Pi)
+	}
+	rho, err := round.key.PaillierSK.RecoverRandomness(Q)
+	if err != nil {
+		return round.WrapError(err, Pi)
+	}
+	defer common.Zeroize(y, rho)
+
+	mulStarProofs := make([]*mulstarproof.Proof, len(Ps))
+	decProofs := make([]*decproof.Proof, len(Ps))
+	proofErrs := make([]error, len(Ps))
+	wg := sync.WaitGroup{}
+	for j := range Ps {
+		round.ok[j] = j == i
+		if j == i {
+			continue
+		}
+		wg.Add(1)
+		go func(j int) {
+			defer wg.Done()
+			NTildej, h1j, h2j := round.key.NTildej[j], round.key.H1j[j], round.key.H2j[j]
+			if mulStarProofs[j], proofErrs[j] = mulstarproof.NewProof(
+				pkI, Ki, HHat, round.temp.bigWs[i], round.temp.w, rhoHHat, NTildej, h1j, h2j, Pi.KeyInt()); proofErrs[j] != nil {
+				return
+			}
+			decProofs[j], proofErrs[j] = decproof.NewProof(pkI, Q, round.temp.sigma, y, rho, NTildej, h1j, h2j, Pi.KeyInt())
+		}(j)
+	}
+	wg.Wait()
+	for _, err := range proofErrs {
+		if err != nil {
+			return round.WrapError(err, Pi)
+		}
+	}
+	for j, Pj := range Ps {
+		if j == i {
+			continue
+		}
+		round.out <- NewSignRound2Message2(Pj, Pi, mulStarProofs[j], decProofs[j])
+	}
+
+	// BROADCAST HHat_i
+	r2msg1 := NewSignRound2Message1(Pi, HHat)
+	round.temp.signRound2Message1s[i] = r2msg1
+	round.out <- r2msg1
+	return nil
+}
+
+func (round *finalization) CanAccept(msg tss.ParsedMessage) bool {
+	if !round.temp.identifying {
+		// not expecting any incoming messages in this round
+		return false
+	}
+	if _, ok := msg.Content().(*SignRound2Message1); ok {
+		return msg.IsBroadcast()
+	}
+	if _, ok := msg.Content().(*SignRound2Message2); ok {
+		return !msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *finalization) Update() (bool, *tss.Error) {
+	if !round.temp.identifying {
+		// not expecting any incoming messages in this round
+		return false, nil
+	}
+	for j, msg1 := range round.temp.signRound2Message1s {
+		if round.ok[j] {
+			continue
+		}
+		if msg1 == nil || !round.CanAccept(msg1) {
+			return false, nil
+		}
+		msg2 := round.temp.signRound2Message2s[j]
+		if msg2 == nil || !round.CanAccept(msg2) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *finalization) NextRound() tss.Round {
+	if !round.temp.identifying {
+		return nil // finished!
+	}
+	round.started = false
+	return &identification{round}
+}
+
+// sigmaCiphertext returns K_j^m * (HHat_j * ChiHat_j)^r, the encryption under the key of Pj of the sigma_j that it
+// should have broadcast
+func (round *base) sigmaCiphertext(j int, HHat *big.Int) (*big.Int, error) {
+	pkJ := round.key.PaillierPKs[j]
+	Kj, ChiHatJ := round.preSig.KCiphertexts[j], round.preSig.ChiCiphertexts[j]
+	for _, c := range []*big.Int{Kj, ChiHatJ, HHat} {
+		if err := pkJ.ValidateCiphertext(c); err != nil {
+			return nil, err
+		}
+	}
+	modNSquare := common.ModInt(pkJ.NSquare())
+	return modNSquare.Mul(modNSquare.Exp(Kj, round.temp.m), modNSquare.Exp(modNSquare.Mul(HHat, ChiHatJ), round.temp.r)), nil
+}
+
+func padToLengthBytesInPlace(src []byte, length int) []byte {
+	oriLen := len(src)
+	if oriLen < length {
+		for i := 0; i < length-oriLen; i++ {
+			src = append([]byte{0}, src...)
+		}
+	}
+	return src
+}
@@ -0,0 +1,87 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+func (round *identification) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 3
+	round.started = true
+
+	Ps := round.Parties().IDs()
+	i := round.PartyID().Index
+
+	// verify that HHat_j multiplies K_j by the discrete logarithm of W_j (Πmul*) and that sigma_j is the plaintext
+	// mod q of K_j^m * (HHat_j * ChiHat_j)^r (Πdec, made with our NTilde, h1, h2); the parties that fail are the culprits
+	failed := make([]bool, len(Ps))
+	wg := sync.WaitGroup{}
+	verifiers := round.VerifierPool()
+	for j, Pj := range Ps {
+		if j == i {
+			continue
+		}
+		j, Pj := j, Pj
+		wg.Add(1)
+		verifiers.Go(func() {
+			defer wg.Done()
+			pkJ := round.key.PaillierPKs[j]
+			NTildei, h1i, h2i := round.key.NTildej[i], round.key.H1j[i], round.key.H2j[i]
+			r1msg := round.temp.signRound1Messages[j].Content().(*SignRound1Message)
+			r2msg1 := round.temp.signRound2Message1s[j].Content().(*SignRound2Message1)
+			r2msg2 := round.temp.signRound2Message2s[j].Content().(*SignRound2Message2)
+			HHat := r2msg1.UnmarshalHHat()
+			Q, err := round.sigmaCiphertext(j, HHat)
+			if err != nil {
+				failed[j] = true
+				return
+			}
+			mulStarProof, err := r2msg2.UnmarshalMulStarProof()
+			if err != nil || !mulStarProof.Verify(
+				pkJ, round.preSig.KCiphertexts[j], HHat, round.temp.bigWs[j], NTildei, h1i, h2i, Pj.KeyInt()) {
+				failed[j] = true
+				return
+			}
+			decProof, err := r2msg2.UnmarshalDecProof()
+			if err != nil || !decProof.Verify(pkJ, Q, r1msg.UnmarshalSigma(), NTildei, h1i, h2i, Pj.KeyInt()) {
+				failed[j] = true
+			}
+		})
+	}
+	wg.Wait()
+	culprits := make([]*tss.PartyID, 0, len(Ps))
+	for j, Pj := range Ps {
+		if failed[j] {
+			culprits = append(culprits, Pj)
+		}
+	}
+	if len(culprits) > 0 {
+		return round.WrapError(errors.New("identified the parties whose sigma_j does not match their presignature"), culprits...)
+	}
+	return round.WrapError(errors.New("signature verification failed, but every party proved its sigma_j"))
+}
+
+func (round *identification) CanAccept(msg tss.ParsedMessage) bool {
+	// not expecting any incoming messages in this round
+	return false
+}
+
+func (round *identification) Update() (bool, *tss.Error) {
+	// not expecting any incoming messages in this round
+	return false, nil
+}
+
+func (round *identification) NextRound() tss.Round {
+	return nil // finished!
+}
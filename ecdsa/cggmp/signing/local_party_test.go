@@ -0,0 +1,237 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"runtime"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ipfs/go-log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/binance-chain/tss-lib/common"
+	cggmpkeygen "github.com/binance-chain/tss-lib/ecdsa/cggmp/keygen"
+	"github.com/binance-chain/tss-lib/ecdsa/cggmp/presigning"
+	"github.com/binance-chain/tss-lib/ecdsa/keygen"
+	"github.com/binance-chain/tss-lib/test"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+const (
+	testParticipants = test.TestParticipants
+	testThreshold    = test.TestThreshold
+)
+
+func setUp(level string) {
+	if err := log.SetLogLevel("tss-lib", level); err != nil {
+		panic(err)
+	}
+}
+
+// route delivers a message to its recipients, as a network would
+func route(t *testing.T, parties []tss.Party, msg tss.Message, errCh chan<- *tss.Error) {
+	updater := test.SharedPartyUpdater
+	dest := msg.GetTo()
+	if dest == nil {
+		for _, P := range parties {
+			if P.PartyID().Index == msg.GetFrom().Index {
+				continue
+			}
+			go updater(P, msg, errCh)
+		}
+		return
+	}
+	if dest[0].Index == msg.GetFrom().Index {
+		t.Fatalf("party %d tried to send a message to itself (%d)", dest[0].Index, msg.GetFrom().Index)
+	}
+	go updater(parties[dest[0].Index], msg, errCh)
+}
+
+func start(parties []tss.Party, errCh chan<- *tss.Error) {
+	for _, P := range parties {
+		go func(P tss.Party) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+}
+
+// presign runs presigning with the imported `keys` of the signers
+func presign(t *testing.T, keys []keygen.LocalPartySaveData, signPIDs tss.SortedPartyIDs, threshold int) []presigning.PreSignatureData {
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	errCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs))
+	preSigCh := make(chan presigning.PreSignatureData, len(signPIDs))
+	parties := make([]tss.Party, 0, len(signPIDs))
+	for i := 0; i < len(signPIDs); i++ {
+		params := tss.NewParameters(p2pCtx, signPIDs[i], len(signPIDs), threshold)
+		parties = append(parties, presigning.NewLocalParty(params, keys[i], outCh, preSigCh))
+	}
+	start(parties, errCh)
+
+	preSigs := make([]presigning.PreSignatureData, len(signPIDs))
+	var presigned int32
+	for {
+		fmt.Printf("ACTIVE GOROUTINES: %d\n", runtime.NumGoroutine())
+		select {
+		case err := <-errCh:
+			common.Logger.Errorf("Error: %s", err)
+			assert.FailNow(t, err.Error())
+			return nil
+
+		case msg := <-outCh:
+			route(t, parties, msg, errCh)
+
+		case preSig := <-preSigCh:
+			for i, Pi := range signPIDs {
+				if Pi.KeyInt().Cmp(preSig.ShareID) == 0 {
+					preSigs[i] = preSig
+				}
+			}
+			atomic.AddInt32(&presigned, 1)
+			if atomic.LoadInt32(&presigned) == int32(len(signPIDs)) {
+				t.Logf("Done. Received presignature data from %d participants", presigned)
+				return preSigs
+			}
+		}
+	}
+}
+
+func TestE2EConcurrent(t *testing.T) {
+	setUp("info")
+	threshold := testThreshold
+
+	// PHASE: load keygen fixtures and import them
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	for i := range keys {
+		keys[i], err = cggmpkeygen.ImportGG18(keys[i])
+		assert.NoError(t, err)
+	}
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	errCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs))
+
+	// PHASE: presigning
+	preSigs := presign(t, keys, signPIDs, threshold)
+
+	// PHASE: signing
+	msg := big.NewInt(42)
+	endCh := make(chan common.SignatureData, len(signPIDs))
+	parties := make([]tss.Party, 0, len(signPIDs))
+	for i := 0; i < len(signPIDs); i++ {
+		params := tss.NewParameters(p2pCtx, signPIDs[i], len(signPIDs), threshold)
+		parties = append(parties, NewLocalParty(msg, params, keys[i], preSigs[i], outCh, endCh))
+	}
+	start(parties, errCh)
+
+	var ended int32
+signing:
+	for {
+		fmt.Printf("ACTIVE GOROUTINES: %d\n", runtime.NumGoroutine())
+		select {
+		case err := <-errCh:
+			common.Logger.Errorf("Error: %s", err)
+			assert.FailNow(t, err.Error())
+			break signing
+
+		case msg := <-outCh:
+			route(t, parties, msg, errCh)
+
+		case sig := <-endCh:
+			// BEGIN ECDSA verify
+			pk := ecdsa.PublicKey{
+				Curve: tss.EC(),
+				X:     keys[0].ECDSAPub.X(),
+				Y:     keys[0].ECDSAPub.Y(),
+			}
+			ok := ecdsa.Verify(&pk, msg.Bytes(), new(big.Int).SetBytes(sig.R), new(big.Int).SetBytes(sig.S))
+			assert.True(t, ok, "ecdsa verify must pass")
+			// END ECDSA verify
+
+			atomic.AddInt32(&ended, 1)
+			if atomic.LoadInt32(&ended) == int32(len(signPIDs)) {
+				t.Logf("Done. Received signature data from %d participants", ended)
+				break signing
+			}
+		}
+	}
+
+	// the presignatures were consumed and may not sign again
+	for _, preSig := range preSigs {
+		assert.True(t, preSig.Used())
+	}
+	params := tss.NewParameters(p2pCtx, signPIDs[0], len(signPIDs), threshold)
+	P := NewLocalParty(big.NewInt(43), params, keys[0], preSigs[0], outCh, endCh)
+	assert.Error(t, P.Start(), "a presignature may only be used once")
+}
+
+func TestIdentifyInvalidSigma(t *testing.T) {
+	setUp("info")
+	threshold := testThreshold
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	for i := range keys {
+		keys[i], err = cggmpkeygen.ImportGG18(keys[i])
+		assert.NoError(t, err)
+	}
+	preSigs := presign(t, keys, signPIDs, threshold)
+
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	errCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan common.SignatureData, len(signPIDs))
+	parties := make([]tss.Party, 0, len(signPIDs))
+	for i := 0; i < len(signPIDs); i++ {
+		params := tss.NewParameters(p2pCtx, signPIDs[i], len(signPIDs), threshold)
+		parties = append(parties, NewLocalParty(big.NewInt(42), params, keys[i], preSigs[i], outCh, endCh))
+	}
+	start(parties, errCh)
+
+	// party 1 broadcasts sigma_1 + 1 and, so that it also runs the identification phase, receives sigma_0 + 1
+	culprit := signPIDs[1]
+	modQ := common.ModInt(tss.EC().Params().N)
+	honestErrs := 0
+	for {
+		select {
+		case err := <-errCh:
+			if err.Victim() == culprit {
+				continue
+			}
+			assert.Equal(t, 3, err.Round())
+			assert.Equal(t, []*tss.PartyID{culprit}, err.Culprits())
+			if honestErrs++; honestErrs == len(signPIDs)-1 {
+				return
+			}
+		case msg := <-outCh:
+			r1msg, isSigma := msg.(tss.ParsedMessage).Content().(*SignRound1Message)
+			if !isSigma {
+				route(t, parties, msg, errCh)
+				continue
+			}
+			tampered := NewSignRound1Message(msg.GetFrom(), modQ.Add(r1msg.UnmarshalSigma(), big.NewInt(1)))
+			for _, P := range parties {
+				if P.PartyID().Index == msg.GetFrom().Index {
+					continue
+				}
+				m := msg.(tss.ParsedMessage)
+				if msg.GetFrom() == culprit || (msg.GetFrom().Index == 0 && P.PartyID() == culprit) {
+					m = tampered
+				}
+				go test.SharedPartyUpdater(P, m, errCh)
+			}
+		case <-endCh:
+			t.Fatal("signing should not complete")
+		}
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto/decproof"
+	"github.com/binance-chain/tss-lib/crypto/mulstarproof"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+// These messages were generated from Protocol Buffers definitions into ecdsa-cggmp-signing.pb.go
+// The following messages are registered on the Protocol Buffers "wire"
+
+var (
+	// Ensure that signing messages implement ValidateBasic
+	_ = []tss.MessageContent{
+		(*SignRound1Message)(nil),
+		(*SignRound2Message1)(nil),
+		(*SignRound2Message2)(nil),
+	}
+)
+
+func init() {
+	proto.RegisterType((*SignRound1Message)(nil), tss.ECDSAProtoNamePrefix+"cggmp.signing.SignRound1Message")
+	proto.RegisterType((*SignRound2Message1)(nil), tss.ECDSAProtoNamePrefix+"cggmp.signing.SignRound2Message1")
+	proto.RegisterType((*SignRound2Message2)(nil), tss.ECDSAProtoNamePrefix+"cggmp.signing.SignRound2Message2")
+}
+
+// ----- //
+
+func NewSignRound1Message(from *tss.PartyID, sigma *big.Int) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &SignRound1Message{
+		Sigma: sigma.Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *SignRound1Message) ValidateBasic() bool {
+	return m != nil && common.NonEmptyBytes(m.GetSigma())
+}
+
+func (m *SignRound1Message) UnmarshalSigma() *big.Int {
+	return new(big.Int).SetBytes(m.GetSigma())
+}
+
+// ----- //
+
+func NewSignRound2Message1(from *tss.PartyID, HHat *big.Int) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &SignRound2Message1{
+		HHat: HHat.Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *SignRound2Message1) ValidateBasic() bool {
+	return m != nil && common.NonEmptyBytes(m.GetHHat())
+}
+
+func (m *SignRound2Message1) UnmarshalHHat() *big.Int {
+	return new(big.Int).SetBytes(m.GetHHat())
+}
+
+// ----- //
+
+func NewSignRound2Message2(to, from *tss.PartyID, mulStarProof *mulstarproof.Proof, decProof *decproof.Proof) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		To:          []*tss.PartyID{to},
+		IsBroadcast: false,
+	}
+	content := &SignRound2Message2{
+		MulStarProof: mulStarProof.Serialize(),
+		DecProof:     decProof.Serialize(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *SignRound2Message2) ValidateBasic() bool {
+	return m != nil &&
+		common.NonEmptyMultiBytes(m.GetMulStarProof(), mulstarproof.ProofBytesParts) &&
+		common.NonEmptyMultiBytes(m.GetDecProof(), decproof.ProofBytesParts)
+}
+
+func (m *SignRound2Message2) UnmarshalMulStarProof() (*mulstarproof.Proof, error) {
+	return mulstarproof.UnmarshalProof(m.GetMulStarProof())
+}
+
+func (m *SignRound2Message2) UnmarshalDecProof() (*decproof.Proof, error) {
+	return decproof.UnmarshalProof(m.GetDecProof())
+}
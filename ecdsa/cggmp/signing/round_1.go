@@ -0,0 +1,135 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/ecdsa/cggmp/presigning"
+	"github.com/binance-chain/tss-lib/ecdsa/keygen"
+	ecdsasigning "github.com/binance-chain/tss-lib/ecdsa/signing"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+// round 1 represents the single round of the signing with a presignature, as in figure 8 of CGGMP21 (Canetti,
+// Gennaro, Goldfeder, Makriyannis, Peled; 2021)
+func newRound1(params *tss.Parameters, key *keygen.LocalPartySaveData, preSig *presigning.PreSignatureData, data *common.SignatureData, temp *localTempData, out chan<- tss.Message, end chan<- common.SignatureData) tss.Round {
+	return &round1{
+		&base{params, key, preSig, data, temp, out, end, make([]bool, len(params.Parties().IDs())), false, 1}}
+}
+
+func (round *round1) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	if err := keygen.CheckCurve(round.Params(), tss.EC()); err != nil {
+		return round.WrapError(err, round.PartyID())
+	}
+	// as in GG18 signing, the message must already be hashed and reduced to an element of Zq
+	if round.temp.m == nil || round.temp.m.Cmp(tss.EC().Params().N) >= 0 {
+		return round.WrapError(errors.New("hashed message is not valid"))
+	}
+	if round.preSig.Used() {
+		return round.WrapError(errors.New("the presignature was already used"))
+	}
+	if round.preSig.BigR == nil || round.preSig.ChiI == nil || round.preSig.ECDSAPub == nil {
+		return round.WrapError(errors.New("the presignature is incomplete"))
+	}
+	if !round.preSig.ECDSAPub.Equals(round.key.ECDSAPub) {
+		return round.WrapError(errors.New("the presignature was made with another key"))
+	}
+	Ps := round.Parties().IDs()
+	if len(round.preSig.Ks) != len(Ps) {
+		return round.WrapError(fmt.Errorf("the presignature was made by %d parties, not %d", len(round.preSig.Ks), len(Ps)))
+	}
+	if len(round.preSig.KCiphertexts) != len(Ps) || len(round.preSig.ChiCiphertexts) != len(Ps) {
+		return round.WrapError(errors.New("the presignature has no ciphertexts for the identification phase"))
+	}
+	for j, Pj := range Ps {
+		if round.preSig.Ks[j] == nil || round.preSig.Ks[j].Cmp(Pj.KeyInt()) != 0 {
+			return round.WrapError(fmt.Errorf("party %s did not take part in the presignature", Pj))
+		}
+	}
+	if round.preSig.ShareID == nil || round.preSig.ShareID.Cmp(round.PartyID().KeyInt()) != 0 {
+		return round.WrapError(errors.New("the presignature does not belong to this party"))
+	}
+
+	round.number = 1
+	round.started = true
+	round.resetOK()
+
+	Pi := round.PartyID()
+	i := Pi.Index
+	round.ok[i] = true
+
+	// 1. sigma_i = k_i*m + r*chi_i, where r is the x coordinate of R
+	modN := common.ModInt(tss.EC().Params().N)
+	r := new(big.Int).Mod(round.preSig.BigR.X(), tss.EC().Params().N)
+	sigma := modN.Add(modN.Mul(round.preSig.KI, round.temp.m), modN.Mul(r, round.preSig.ChiI))
+	round.temp.r = r
+	round.temp.sigma = sigma
+
+	// the presignature must not be used again
+	round.preSig.Zeroize()
+
+	// BROADCAST sigma_i
+	r1msg := NewSignRound1Message(Pi, sigma)
+	round.temp.signRound1Messages[i] = r1msg
+	round.out <- r1msg
+	return nil
+}
+
+func (round *round1) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.signRound1Messages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round1) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*SignRound1Message); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round1) NextRound() tss.Round {
+	round.started = false
+	return &finalization{round}
+}
+
+// ----- //
+
+// helper to call into ecdsasigning.PrepareForSigning(), for the W_j that the identification phase checks against
+func (round *round1) prepare() *tss.Error {
+	i := round.PartyID().Index
+
+	xi := round.key.Xi
+	ks := round.key.Ks
+	bigXs := round.key.BigXj
+
+	if round.Threshold()+1 > len(ks) {
+		return round.WrapError(fmt.Errorf("t+1=%d is not satisfied by the key count of %d", round.Threshold()+1, len(ks)))
+	}
+	if !round.key.LocalPreParams.Validate() || round.key.PaillierPKs[i] == nil {
+		return round.WrapError(errors.New("the key share has no aux-info; run the aux-info protocol on it first"))
+	}
+	wi, bigWs := ecdsasigning.PrepareForSigning(i, len(ks), xi, ks, bigXs)
+
+	round.temp.w = wi
+	round.temp.bigWs = bigWs
+	return nil
+}
@@ -0,0 +1,290 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+// Package encoding converts the secp256k1 ECDSA signatures in `common.SignatureData` to and from the formats used by
+// Bitcoin (DER and compact), Ethereum (v, r, s with EIP-155) and Cosmos SDK chains, and recovers public keys.
+package encoding
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+)
+
+const (
+	// the byte length of R and S
+	scalarLen = 32
+
+	// CompactSignatureLen is the length of a compact recoverable signature: header || R || S
+	CompactSignatureLen = 1 + 2*scalarLen
+
+	// compactHeaderBase is added to the recovery ID in the header byte of a compact signature
+	compactHeaderBase = 27
+	// compactHeaderCompressed is added to the header byte when the signer's public key is compressed
+	compactHeaderCompressed = 4
+
+	// ethereumLegacyV is added to the recovery ID for pre-EIP-155 Ethereum signatures
+	ethereumLegacyV = 27
+	// ethereumEIP155V is added to 2*chainID + recovery ID for EIP-155 Ethereum signatures
+	ethereumEIP155V = 35
+)
+
+var (
+	ErrInvalidSignature  = errors.New("the signature has an invalid R or S value")
+	ErrInvalidRecoveryID = errors.New("the signature has an invalid recovery ID")
+	ErrHighS             = errors.New("the signature has a high S value")
+)
+
+// derSignature is the ASN.1 structure of a DER signature
+type derSignature struct {
+	R, S *big.Int
+}
+
+// ----- //
+
+// ToDER returns the strict DER encoding used by Bitcoin (without the trailing sighash type byte).
+// S is encoded as is; see `NormalizeS` to produce the low-S signatures required by Bitcoin's standardness rules.
+func ToDER(sig *common.SignatureData) ([]byte, error) {
+	r, s, err := rs(sig)
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(derSignature{r, s})
+}
+
+// ParseDER parses a strict DER signature. The returned SignatureData has no recovery ID.
+func ParseDER(der []byte) (*common.SignatureData, error) {
+	var parsed derSignature
+	rest, err := asn1.Unmarshal(der, &parsed)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, errors.New("ParseDER: trailing bytes after the signature")
+	}
+	// reject non-canonical encodings (e.g. excess padding) that asn1 accepts
+	if canonical, err := asn1.Marshal(parsed); err != nil || !bytes.Equal(canonical, der) {
+		return nil, errors.New("ParseDER: the signature is not strictly DER encoded")
+	}
+	return newSignatureData(parsed.R, parsed.S, nil)
+}
+
+// ----- //
+
+// ToEthereum returns the Ethereum `v, r, s` values. With a nil `chainID` the pre-EIP-155 v (27 or 28) is returned,
+// otherwise v = recovery ID + 2*chainID + 35 as specified by EIP-155.
+func ToEthereum(sig *common.SignatureData, chainID *big.Int) (v, r, s *big.Int, err error) {
+	if r, s, err = rs(sig); err != nil {
+		return
+	}
+	recID, err := recoveryID(sig)
+	if err != nil {
+		return
+	}
+	if recID > 1 {
+		// Ethereum cannot express an R whose x coordinate overflowed the group order
+		return nil, nil, nil, ErrInvalidRecoveryID
+	}
+	if chainID == nil {
+		v = big.NewInt(int64(recID) + ethereumLegacyV)
+		return
+	}
+	v = new(big.Int).Lsh(chainID, 1)
+	v.Add(v, big.NewInt(int64(recID)+ethereumEIP155V))
+	return
+}
+
+// ParseEthereum builds a SignatureData from Ethereum `v, r, s` values; `chainID` must be nil for pre-EIP-155 signatures
+func ParseEthereum(v, r, s, chainID *big.Int) (*common.SignatureData, error) {
+	if v == nil {
+		return nil, ErrInvalidRecoveryID
+	}
+	recID := new(big.Int)
+	if chainID == nil {
+		recID.Sub(v, big.NewInt(ethereumLegacyV))
+	} else {
+		recID.Sub(v, new(big.Int).Lsh(chainID, 1))
+		recID.Sub(recID, big.NewInt(ethereumEIP155V))
+	}
+	if !recID.IsInt64() || recID.Int64() < 0 || recID.Int64() > 1 {
+		return nil, ErrInvalidRecoveryID
+	}
+	return newSignatureData(r, s, []byte{byte(recID.Int64())})
+}
+
+// ----- //
+
+// ToCompact returns the 65-byte compact recoverable signature `header || R || S` used by Bitcoin message signing,
+// where the header is 27 + recovery ID, plus 4 if the signer's public key is used in its compressed form
+func ToCompact(sig *common.SignatureData, compressedPubKey bool) ([]byte, error) {
+	r, s, err := rs(sig)
+	if err != nil {
+		return nil, err
+	}
+	recID, err := recoveryID(sig)
+	if err != nil {
+		return nil, err
+	}
+	header := byte(compactHeaderBase) + recID
+	if compressedPubKey {
+		header += compactHeaderCompressed
+	}
+	compact := make([]byte, 0, CompactSignatureLen)
+	compact = append(compact, header)
+	compact = append(compact, padTo32Bytes(r)...)
+	return append(compact, padTo32Bytes(s)...), nil
+}
+
+// ParseCompact parses a 65-byte compact recoverable signature and reports whether it refers to a compressed public key
+func ParseCompact(compact []byte) (sig *common.SignatureData, compressedPubKey bool, err error) {
+	if len(compact) != CompactSignatureLen {
+		return nil, false, fmt.Errorf("ParseCompact: expected %d bytes, got %d", CompactSignatureLen, len(compact))
+	}
+	header := compact[0]
+	if header < compactHeaderBase || header >= compactHeaderBase+2*compactHeaderCompressed {
+		return nil, false, ErrInvalidRecoveryID
+	}
+	header -= compactHeaderBase
+	compressedPubKey = header&compactHeaderCompressed != 0
+	recID := header &^ compactHeaderCompressed
+	r := new(big.Int).SetBytes(compact[1 : 1+scalarLen])
+	s := new(big.Int).SetBytes(compact[1+scalarLen:])
+	sig, err = newSignatureData(r, s, []byte{recID})
+	return
+}
+
+// ----- //
+
+// ToCosmos returns the 64-byte `R || S` signature used by Cosmos SDK chains. Tendermint only accepts low-S signatures,
+// so a high S is an error; see `NormalizeS`.
+func ToCosmos(sig *common.SignatureData) ([]byte, error) {
+	r, s, err := rs(sig)
+	if err != nil {
+		return nil, err
+	}
+	if s.Cmp(halfOrder()) > 0 {
+		return nil, ErrHighS
+	}
+	return append(padTo32Bytes(r), padTo32Bytes(s)...), nil
+}
+
+// ParseCosmos parses a 64-byte `R || S` signature, which must have a low S. The returned SignatureData has no recovery ID.
+func ParseCosmos(bz []byte) (*common.SignatureData, error) {
+	if len(bz) != 2*scalarLen {
+		return nil, fmt.Errorf("ParseCosmos: expected %d bytes, got %d", 2*scalarLen, len(bz))
+	}
+	s := new(big.Int).SetBytes(bz[scalarLen:])
+	if s.Cmp(halfOrder()) > 0 {
+		return nil, ErrHighS
+	}
+	return newSignatureData(new(big.Int).SetBytes(bz[:scalarLen]), s, nil)
+}
+
+// ----- //
+
+// NormalizeS returns a copy of the signature with S replaced by N - S if S is greater than N/2.
+// The recovery ID is updated to match because negating S negates the nonce point R.
+func NormalizeS(sig *common.SignatureData) (*common.SignatureData, error) {
+	r, s, err := rs(sig)
+	if err != nil {
+		return nil, err
+	}
+	var recID []byte
+	if len(sig.SignatureRecovery) > 0 {
+		recID = []byte{sig.SignatureRecovery[0]}
+	}
+	if s.Cmp(halfOrder()) > 0 {
+		s = new(big.Int).Sub(btcec.S256().N, s)
+		if recID != nil {
+			recID[0] ^= 1
+		}
+	}
+	normalized, err := newSignatureData(r, s, recID)
+	if err != nil {
+		return nil, err
+	}
+	normalized.M = sig.M
+	return normalized, nil
+}
+
+// RecoverPublicKey recovers the signer's public key from a signature with a recovery ID and the signed digest.
+// The recovered key is checked against the signature.
+func RecoverPublicKey(sig *common.SignatureData, digest []byte) (*crypto.ECPoint, error) {
+	compact, err := ToCompact(sig, false)
+	if err != nil {
+		return nil, err
+	}
+	pk, _, err := btcec.RecoverCompact(btcec.S256(), compact, digest)
+	if err != nil {
+		return nil, err
+	}
+	r, s, _ := rs(sig)
+	if !ecdsa.Verify(pk.ToECDSA(), digest, r, s) {
+		return nil, errors.New("RecoverPublicKey: the signature does not verify under the recovered public key")
+	}
+	return crypto.NewECPoint(btcec.S256(), pk.X, pk.Y)
+}
+
+// ----- //
+
+// rs returns the R and S values of a signature after checking that they are in [1, N-1]
+func rs(sig *common.SignatureData) (r, s *big.Int, err error) {
+	if sig == nil {
+		return nil, nil, ErrInvalidSignature
+	}
+	r, s = new(big.Int).SetBytes(sig.R), new(big.Int).SetBytes(sig.S)
+	if !inRange(r) || !inRange(s) {
+		return nil, nil, ErrInvalidSignature
+	}
+	return
+}
+
+func recoveryID(sig *common.SignatureData) (byte, error) {
+	if len(sig.SignatureRecovery) == 0 || sig.SignatureRecovery[0] > 3 {
+		return 0, ErrInvalidRecoveryID
+	}
+	return sig.SignatureRecovery[0], nil
+}
+
+func newSignatureData(r, s *big.Int, recID []byte) (*common.SignatureData, error) {
+	if !inRange(r) || !inRange(s) {
+		return nil, ErrInvalidSignature
+	}
+	if len(recID) > 0 && recID[0] > 3 {
+		return nil, ErrInvalidRecoveryID
+	}
+	rBz, sBz := padTo32Bytes(r), padTo32Bytes(s)
+	return &common.SignatureData{
+		Signature:         append(append([]byte{}, rBz...), sBz...),
+		SignatureRecovery: recID,
+		R:                 rBz,
+		S:                 sBz,
+	}, nil
+}
+
+func inRange(n *big.Int) bool {
+	return n != nil && n.Sign() > 0 && n.Cmp(btcec.S256().N) < 0
+}
+
+func halfOrder() *big.Int {
+	return new(big.Int).Rsh(btcec.S256().N, 1)
+}
+
+func padTo32Bytes(n *big.Int) []byte {
+	bz := n.Bytes()
+	if len(bz) >= scalarLen {
+		return bz
+	}
+	return append(make([]byte, scalarLen-len(bz)), bz...)
+}
@@ -0,0 +1,142 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package encoding_test
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/binance-chain/tss-lib/common"
+	. "github.com/binance-chain/tss-lib/ecdsa/encoding"
+)
+
+// newTestSignature signs a digest with a fresh key and returns the signature with its recovery ID
+func newTestSignature(t *testing.T) (*common.SignatureData, *btcec.PrivateKey, []byte) {
+	key, err := btcec.NewPrivateKey(btcec.S256())
+	assert.NoError(t, err)
+	digest := sha256.Sum256([]byte("tss-lib"))
+	compact, err := btcec.SignCompact(btcec.S256(), key, digest[:], true)
+	assert.NoError(t, err)
+	sig, compressed, err := ParseCompact(compact)
+	assert.NoError(t, err)
+	assert.True(t, compressed)
+	return sig, key, digest[:]
+}
+
+func TestCompactRoundTrip(t *testing.T) {
+	sig, key, digest := newTestSignature(t)
+	compact, err := ToCompact(sig, true)
+	assert.NoError(t, err)
+
+	// btcec recovers the same key from our encoding
+	pk, compressed, err := btcec.RecoverCompact(btcec.S256(), compact, digest)
+	assert.NoError(t, err)
+	assert.True(t, compressed)
+	assert.True(t, pk.IsEqual(key.PubKey()))
+
+	recovered, err := RecoverPublicKey(sig, digest)
+	assert.NoError(t, err)
+	assert.Equal(t, key.PubKey().X, recovered.X())
+	assert.Equal(t, key.PubKey().Y, recovered.Y())
+
+	// a different digest does not recover the signer
+	other := sha256.Sum256([]byte("other"))
+	if recovered, err := RecoverPublicKey(sig, other[:]); err == nil {
+		assert.NotEqual(t, key.PubKey().X, recovered.X())
+	}
+}
+
+func TestDER(t *testing.T) {
+	sig, key, digest := newTestSignature(t)
+	der, err := ToDER(sig)
+	assert.NoError(t, err)
+
+	// btcec produces low-S signatures, so its serialization must match ours
+	btcSig, err := btcec.ParseDERSignature(der, btcec.S256())
+	assert.NoError(t, err)
+	assert.Equal(t, btcSig.Serialize(), der)
+	assert.True(t, btcSig.Verify(digest, key.PubKey()))
+
+	parsed, err := ParseDER(der)
+	assert.NoError(t, err)
+	assert.Equal(t, sig.R, parsed.R)
+	assert.Equal(t, sig.S, parsed.S)
+	assert.Nil(t, parsed.SignatureRecovery)
+
+	// trailing bytes and non-canonical lengths are rejected
+	_, err = ParseDER(append(der, 0x01))
+	assert.Error(t, err)
+	padded := append([]byte{der[0], der[1] + 1, der[2], der[3] + 1, 0x00}, der[4:]...)
+	_, err = ParseDER(padded)
+	assert.Error(t, err)
+}
+
+func TestEthereum(t *testing.T) {
+	sig, _, _ := newTestSignature(t)
+	recID := int64(sig.SignatureRecovery[0])
+
+	v, r, s, err := ToEthereum(sig, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 27+recID, v.Int64())
+	assert.Equal(t, 0, new(big.Int).SetBytes(sig.R).Cmp(r))
+
+	parsed, err := ParseEthereum(v, r, s, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, sig.SignatureRecovery, parsed.SignatureRecovery)
+
+	// EIP-155 on mainnet: v is 37 or 38
+	v, r, s, err = ToEthereum(sig, big.NewInt(1))
+	assert.NoError(t, err)
+	assert.Equal(t, 37+recID, v.Int64())
+	parsed, err = ParseEthereum(v, r, s, big.NewInt(1))
+	assert.NoError(t, err)
+	assert.Equal(t, sig.SignatureRecovery, parsed.SignatureRecovery)
+
+	// the wrong chain ID is rejected
+	_, err = ParseEthereum(v, r, s, big.NewInt(56))
+	assert.Equal(t, ErrInvalidRecoveryID, err)
+}
+
+func TestCosmosAndNormalizeS(t *testing.T) {
+	sig, key, digest := newTestSignature(t)
+	bz, err := ToCosmos(sig)
+	assert.NoError(t, err)
+	assert.Len(t, bz, 64)
+	parsed, err := ParseCosmos(bz)
+	assert.NoError(t, err)
+	assert.Equal(t, sig.Signature, parsed.Signature)
+
+	// a high-S signature is rejected until normalized, and normalizing keeps the recovery ID correct
+	highS := new(big.Int).Sub(btcec.S256().N, new(big.Int).SetBytes(sig.S))
+	high := &common.SignatureData{R: sig.R, S: highS.Bytes(), SignatureRecovery: []byte{sig.SignatureRecovery[0] ^ 1}}
+	_, err = ToCosmos(high)
+	assert.Equal(t, ErrHighS, err)
+	_, err = ParseCosmos(append(append([]byte{}, sig.R...), highS.FillBytes(make([]byte, 32))...))
+	assert.Equal(t, ErrHighS, err)
+
+	recovered, err := RecoverPublicKey(high, digest)
+	assert.NoError(t, err)
+	assert.Equal(t, key.PubKey().X, recovered.X())
+
+	normalized, err := NormalizeS(high)
+	assert.NoError(t, err)
+	assert.Equal(t, sig.S, normalized.S)
+	assert.Equal(t, sig.SignatureRecovery, normalized.SignatureRecovery)
+}
+
+func TestInvalidSignature(t *testing.T) {
+	_, err := ToDER(&common.SignatureData{R: []byte{0}, S: []byte{1}})
+	assert.Equal(t, ErrInvalidSignature, err)
+	_, err = ToCompact(&common.SignatureData{R: []byte{1}, S: []byte{1}}, false)
+	assert.Equal(t, ErrInvalidRecoveryID, err)
+	_, _, err = ParseCompact(make([]byte, 64))
+	assert.Error(t, err)
+}
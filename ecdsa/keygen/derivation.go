@@ -0,0 +1,35 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/binance-chain/tss-lib/crypto/ckd"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+// DerivationPath identifies a non-hardened BIP32 child key of ECDSAPub.
+// The chain code is not produced by keygen; the parties must agree on it (e.g. from an existing xpub).
+type DerivationPath struct {
+	ChainCode []byte   // 32 bytes
+	Indices   []uint32 // each must be < ckd.HardenedKeyStart
+}
+
+// DeriveChildKey derives the child public key and chain code at `path` from ECDSAPub.
+// The returned `delta` is the sum of the BIP32 tweaks along the path; the child public key is ECDSAPub + delta*G.
+func (save LocalPartySaveData) DeriveChildKey(path DerivationPath) (delta *big.Int, child *ckd.ExtendedKey, err error) {
+	if save.ECDSAPub == nil {
+		return nil, nil, errors.New("DeriveChildKey: the save data has no ECDSAPub")
+	}
+	master, err := ckd.NewExtendedKey(save.ECDSAPub, path.ChainCode)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ckd.DeriveChildKeyFromHierarchy(path.Indices, master, tss.EC().Params().N)
+}
@@ -20,7 +20,6 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
-//
 // Represents a BROADCAST message sent during Round 1 of the ECDSA TSS keygen protocol.
 type KGRound1Message struct {
 	Commitment           []byte   `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
@@ -30,6 +29,8 @@ type KGRound1Message struct {
 	H2                   []byte   `protobuf:"bytes,5,opt,name=h2,proto3" json:"h2,omitempty"`
 	Dlnproof_1           [][]byte `protobuf:"bytes,6,rep,name=dlnproof_1,json=dlnproof1,proto3" json:"dlnproof_1,omitempty"`
 	Dlnproof_2           [][]byte `protobuf:"bytes,7,rep,name=dlnproof_2,json=dlnproof2,proto3" json:"dlnproof_2,omitempty"`
+	ModProof             [][]byte `protobuf:"bytes,8,rep,name=mod_proof,json=modProof,proto3" json:"mod_proof,omitempty"`
+	PrmProof             [][]byte `protobuf:"bytes,9,rep,name=prm_proof,json=prmProof,proto3" json:"prm_proof,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -109,10 +110,24 @@ func (m *KGRound1Message) GetDlnproof_2() [][]byte {
 	return nil
 }
 
-//
+func (m *KGRound1Message) GetModProof() [][]byte {
+	if m != nil {
+		return m.ModProof
+	}
+	return nil
+}
+
+func (m *KGRound1Message) GetPrmProof() [][]byte {
+	if m != nil {
+		return m.PrmProof
+	}
+	return nil
+}
+
 // Represents a P2P message sent to each party during Round 2 of the ECDSA TSS keygen protocol.
 type KGRound2Message1 struct {
 	Share                []byte   `protobuf:"bytes,1,opt,name=share,proto3" json:"share,omitempty"`
+	FacProof             [][]byte `protobuf:"bytes,2,rep,name=fac_proof,json=facProof,proto3" json:"fac_proof,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -150,7 +165,13 @@ func (m *KGRound2Message1) GetShare() []byte {
 	return nil
 }
 
-//
+func (m *KGRound2Message1) GetFacProof() [][]byte {
+	if m != nil {
+		return m.FacProof
+	}
+	return nil
+}
+
 // Represents a BROADCAST message sent to each party during Round 2 of the ECDSA TSS keygen protocol.
 type KGRound2Message2 struct {
 	DeCommitment         [][]byte `protobuf:"bytes,1,rep,name=de_commitment,json=deCommitment,proto3" json:"de_commitment,omitempty"`
@@ -191,7 +212,6 @@ func (m *KGRound2Message2) GetDeCommitment() [][]byte {
 	return nil
 }
 
-//
 // Represents a BROADCAST message sent to each party during Round 3 of the ECDSA TSS keygen protocol.
 type KGRound3Message struct {
 	PaillierProof        [][]byte `protobuf:"bytes,1,rep,name=paillier_proof,json=paillierProof,proto3" json:"paillier_proof,omitempty"`
@@ -242,22 +262,24 @@ func init() {
 func init() { proto.RegisterFile("protob/ecdsa-keygen.proto", fileDescriptor_1a2e19e981cdbb01) }
 
 var fileDescriptor_1a2e19e981cdbb01 = []byte{
-	// 263 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0x41, 0x4b, 0xc3, 0x40,
-	0x10, 0x85, 0x49, 0x6a, 0x53, 0x1c, 0xd2, 0x28, 0x8b, 0xe0, 0x7a, 0x50, 0x4a, 0x44, 0xe8, 0x45,
-	0xcb, 0x6e, 0x0f, 0x7a, 0xd6, 0x83, 0x07, 0x51, 0xa4, 0x78, 0xf2, 0x12, 0xd2, 0xee, 0xd8, 0x04,
-	0x93, 0xdd, 0x90, 0xc4, 0x83, 0xbf, 0xd0, 0xbf, 0x25, 0x3b, 0xd9, 0x04, 0x83, 0xc7, 0xf7, 0xbd,
-	0x64, 0xd8, 0xf7, 0xc1, 0x59, 0x55, 0x9b, 0xd6, 0x6c, 0x57, 0xb8, 0x53, 0x4d, 0x7a, 0xfd, 0x89,
-	0xdf, 0x7b, 0xd4, 0x37, 0xc4, 0xe2, 0x1f, 0x0f, 0x8e, 0x9e, 0x1e, 0x37, 0xe6, 0x4b, 0x2b, 0xf1,
-	0x8c, 0x4d, 0x93, 0xee, 0x91, 0x5d, 0x00, 0xec, 0x4c, 0x59, 0xe6, 0x6d, 0x89, 0xba, 0xe5, 0xde,
-	0xc2, 0x5b, 0x86, 0x9b, 0x3f, 0x84, 0x9d, 0x03, 0x54, 0x69, 0x5e, 0x14, 0x39, 0xd6, 0x89, 0xe6,
-	0x3e, 0xf5, 0x87, 0x3d, 0x79, 0x61, 0xa7, 0x30, 0xd3, 0x49, 0x9b, 0x17, 0x0a, 0xf9, 0x84, 0xba,
-	0x40, 0xbf, 0xd9, 0xc4, 0x22, 0xf0, 0x33, 0xc1, 0x0f, 0x88, 0xf9, 0x99, 0xa0, 0x2c, 0xf9, 0xd4,
-	0x65, 0x69, 0xef, 0xaa, 0x42, 0x57, 0xb5, 0x31, 0x1f, 0x89, 0xe0, 0xc1, 0x62, 0x62, 0xef, 0xf6,
-	0x44, 0x8c, 0x6a, 0xc9, 0x67, 0xe3, 0x5a, 0xc6, 0x4b, 0x38, 0x76, 0x43, 0xa4, 0x1b, 0x22, 0xd8,
-	0x09, 0x4c, 0x9b, 0x2c, 0xad, 0xd1, 0x8d, 0xe8, 0x42, 0x7c, 0xfb, 0xef, 0x4b, 0xc9, 0x2e, 0x61,
-	0xae, 0x30, 0x19, 0xcd, 0xb6, 0xf7, 0x43, 0x85, 0x0f, 0x03, 0x8b, 0xef, 0x06, 0x57, 0xeb, 0xde,
-	0xd5, 0x15, 0x44, 0x83, 0x0b, 0x7a, 0x88, 0xfb, 0x71, 0xde, 0xd3, 0x57, 0x0b, 0xef, 0xa3, 0xf7,
-	0x90, 0xe4, 0xaf, 0x3a, 0xf9, 0xdb, 0x80, 0xec, 0xaf, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xe9,
-	0x13, 0x63, 0x79, 0x9a, 0x01, 0x00, 0x00,
+	// 298 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xcd, 0x4b, 0xf4, 0x30,
+	0x18, 0xc4, 0xd9, 0xee, 0xbb, 0x5f, 0x0f, 0xbb, 0xfb, 0x4a, 0x10, 0x8c, 0x88, 0xb2, 0x54, 0x04,
+	0x2f, 0xba, 0x24, 0x7b, 0xd0, 0xb3, 0x22, 0x1e, 0x44, 0x91, 0xc5, 0x93, 0x97, 0x92, 0x6d, 0xd2,
+	0x6d, 0xb1, 0x49, 0x4a, 0x5a, 0x0f, 0x1e, 0xfd, 0xcf, 0x25, 0x1f, 0x2d, 0x16, 0x8f, 0x33, 0x3f,
+	0x32, 0x0f, 0x99, 0x81, 0xe3, 0xca, 0xe8, 0x46, 0xef, 0xd6, 0x22, 0xe5, 0x35, 0xbb, 0xfa, 0x10,
+	0x5f, 0x7b, 0xa1, 0xae, 0x9d, 0x17, 0x7f, 0x47, 0xf0, 0xff, 0xe9, 0x71, 0xab, 0x3f, 0x15, 0x27,
+	0xcf, 0xa2, 0xae, 0xd9, 0x5e, 0xa0, 0x33, 0x80, 0x54, 0x4b, 0x59, 0x34, 0x52, 0xa8, 0x06, 0x0f,
+	0x56, 0x83, 0xcb, 0xf9, 0xf6, 0x97, 0x83, 0x4e, 0x01, 0x2a, 0x56, 0x94, 0x65, 0x21, 0x4c, 0xa2,
+	0x70, 0xe4, 0xf8, 0xac, 0x75, 0x5e, 0xd0, 0x11, 0x4c, 0x54, 0xd2, 0x14, 0x25, 0x17, 0x78, 0xe8,
+	0xd8, 0x58, 0xbd, 0x59, 0x85, 0x96, 0x10, 0xe5, 0x04, 0xff, 0x73, 0x5e, 0x94, 0x13, 0xa7, 0x29,
+	0x1e, 0x05, 0x4d, 0x6d, 0x2e, 0x2f, 0x55, 0x65, 0xb4, 0xce, 0x12, 0x82, 0xc7, 0xab, 0xa1, 0xcd,
+	0x6d, 0x1d, 0xd2, 0xc3, 0x14, 0x4f, 0xfa, 0x98, 0xa2, 0x13, 0x98, 0x49, 0xcd, 0x13, 0xa7, 0xf0,
+	0xd4, 0xd1, 0xa9, 0xd4, 0xfc, 0xd5, 0x6a, 0x0b, 0x2b, 0x23, 0x03, 0x9c, 0x79, 0x58, 0x19, 0xe9,
+	0x60, 0xfc, 0x00, 0x07, 0xa1, 0x02, 0x1a, 0x2a, 0x20, 0xe8, 0x10, 0x46, 0x75, 0xce, 0x8c, 0x08,
+	0xdf, 0xf7, 0xc2, 0xc6, 0x64, 0x2c, 0x0d, 0x31, 0x91, 0x8f, 0xc9, 0x58, 0xea, 0x63, 0x6e, 0xfe,
+	0xc4, 0x50, 0x74, 0x0e, 0x0b, 0x2e, 0x92, 0x5e, 0x9b, 0xf6, 0xd1, 0x9c, 0x8b, 0xfb, 0xce, 0x8b,
+	0x6f, 0xbb, 0x09, 0x36, 0xed, 0x04, 0x17, 0xb0, 0xec, 0x2a, 0xf6, 0xd7, 0xfc, 0xc3, 0x45, 0xeb,
+	0xba, 0x93, 0x77, 0xcb, 0xf7, 0xb9, 0xdb, 0x74, 0xed, 0x37, 0xdd, 0x8d, 0xdd, 0xa8, 0x9b, 0x9f,
+	0x01, 0x00, 0x68, 0xed, 0x0e, 0xf6, 0xf1, 0x01, 0x00, 0x00,
 }
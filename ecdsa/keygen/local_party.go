@@ -20,6 +20,7 @@ import (
 // Implements Party
 // Implements Stringer
 var _ tss.Party = (*LocalParty)(nil)
+var _ tss.Zeroizer = (*LocalParty)(nil)
 var _ fmt.Stringer = (*LocalParty)(nil)
 
 type (
@@ -165,6 +166,16 @@ func (save LocalPartySaveData) OriginalIndex() (int, error) {
 	return index, nil
 }
 
+// Zeroize overwrites the secret polynomial constant and the shares that were dealt, as well as the key share in the
+// save data if keygen did not finish
+func (p *LocalParty) Zeroize() {
+	common.Zeroize(p.temp.ui)
+	p.temp.shares.Zeroize()
+	if p.Running() {
+		p.data.LocalSecrets.Zeroize()
+	}
+}
+
 func (p *LocalParty) PartyID() *tss.PartyID {
 	return p.params.PartyID()
 }
@@ -8,6 +8,7 @@ package keygen
 
 import (
 	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rand"
 	"encoding/json"
 	"fmt"
@@ -17,6 +18,7 @@ import (
 	"sync/atomic"
 	"testing"
 
+	"github.com/btcsuite/btcd/btcec"
 	"github.com/ipfs/go-log"
 	"github.com/stretchr/testify/assert"
 
@@ -152,7 +154,7 @@ func TestBadMessageCulprits(t *testing.T) {
 		assert.FailNow(t, err.Error())
 	}
 
-	badMsg, _ := NewKGRound1Message(pIDs[1], zero, &paillier.PublicKey{N: zero}, zero, zero, zero, new(dlnproof.Proof), new(dlnproof.Proof))
+	badMsg, _ := NewKGRound1Message(pIDs[1], zero, &paillier.PublicKey{N: zero}, zero, zero, zero, new(dlnproof.Proof), new(dlnproof.Proof), nil, new(paillier.ModProof))
 	ok, err2 := lp.Update(badMsg)
 	t.Log(err2)
 	assert.False(t, ok)
@@ -169,15 +171,60 @@ func TestBadMessageCulprits(t *testing.T) {
 func TestE2EConcurrentAndSaveFixtures(t *testing.T) {
 	setUp("info")
 
-	// tss.SetCurve(elliptic.P256())
+	fixtures, pIDs, err := LoadKeygenTestFixtures(testParticipants)
+	if err != nil {
+		common.Logger.Info("No test fixtures were found, so the safe primes will be generated from scratch. This may take a while...")
+		pIDs = tss.GenerateTestPartyIDs(testParticipants)
+	}
+	e2eConcurrentAndSaveFixtures(t, fixtures, pIDs)
+}
 
-	threshold := testThreshold
+func TestE2EConcurrentAndSaveFixturesP256(t *testing.T) {
+	setUp("info")
+
+	// the pre-params do not depend on the curve, so those of the secp256k1 fixtures are reused
 	fixtures, pIDs, err := LoadKeygenTestFixtures(testParticipants)
 	if err != nil {
 		common.Logger.Info("No test fixtures were found, so the safe primes will be generated from scratch. This may take a while...")
 		pIDs = tss.GenerateTestPartyIDs(testParticipants)
 	}
 
+	tss.SetCurve(elliptic.P256())
+	defer tss.SetCurve(btcec.S256())
+
+	e2eConcurrentAndSaveFixtures(t, fixtures, pIDs)
+}
+
+func TestCheckCurve(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(2)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	params := tss.NewParameters(p2pCtx, pIDs[0], len(pIDs), 1)
+	assert.NoError(t, CheckCurve(params, btcec.S256()))
+	assert.NoError(t, CheckCurve(params, elliptic.P256()))
+	assert.Error(t, CheckCurve(params, elliptic.P384()))
+	assert.Error(t, CheckCurve(params, elliptic.P521()))
+
+	// a 256-bit order needs moduli of at least 2048 bits, whichever minimum is lowered
+	lowered := tss.NewParameters(p2pCtx, pIDs[0], len(pIDs), 1)
+	lowered.SetMinModulusBitLens(1024, 2048)
+	assert.Error(t, CheckCurve(lowered, btcec.S256()))
+	lowered.SetMinModulusBitLens(2048, 1024)
+	assert.Error(t, CheckCurve(lowered, elliptic.P256()))
+
+	tss.SetCurve(elliptic.P384())
+	defer tss.SetCurve(btcec.S256())
+
+	out := make(chan tss.Message, len(pIDs))
+	lp := NewLocalParty(params, out, nil).(*LocalParty)
+	if err := lp.Start(); assert.NotNil(t, err) {
+		assert.Equal(t, 1, err.Round())
+		assert.Contains(t, err.Error(), "too large")
+	}
+}
+
+func e2eConcurrentAndSaveFixtures(t *testing.T, fixtures []LocalPartySaveData, pIDs tss.SortedPartyIDs) {
+	threshold := testThreshold
+
 	p2pCtx := tss.NewPeerContext(pIDs)
 	parties := make([]*LocalParty, 0, len(pIDs))
 
@@ -266,7 +313,6 @@ keygen:
 					assert.NoError(t, err, "vss.ReConstruct should not throw error")
 
 					// uG test: u*G[j] == V[0]
-					assert.Equal(t, uj, Pj.temp.ui)
 					uG := crypto.ScalarBaseMult(tss.EC(), uj)
 					assert.True(t, uG.Equals(Pj.temp.vs[0]), "ensure u*G[j] == V_0")
 
@@ -280,9 +326,10 @@ keygen:
 					{
 						badShares := pShares[:threshold]
 						badShares[len(badShares)-1].Share.Set(big.NewInt(0))
+						goodUj := uj
 						uj, err := pShares[:threshold].ReConstruct()
 						assert.NoError(t, err)
-						assert.NotEqual(t, parties[j].temp.ui, uj)
+						assert.NotEqual(t, goodUj, uj)
 						BigXjX, BigXjY := tss.EC().ScalarBaseMult(uj.Bytes())
 						assert.NotEqual(t, BigXjX, Pj.temp.vs[0].X())
 						assert.NotEqual(t, BigXjY, Pj.temp.vs[0].Y())
@@ -299,7 +346,7 @@ keygen:
 				}
 				sk := ecdsa.PrivateKey{
 					PublicKey: pk,
-					D:         u,
+					D:         new(big.Int).Mod(u, tss.EC().Params().N),
 				}
 				// test pub key, should be on curve and match pkX, pkY
 				assert.True(t, sk.IsOnCurve(pkX, pkY), "public key must be on curve")
@@ -15,6 +15,7 @@ import (
 	cmt "github.com/binance-chain/tss-lib/crypto/commitments"
 	"github.com/binance-chain/tss-lib/crypto/dlnproof"
 	"github.com/binance-chain/tss-lib/crypto/paillier"
+	"github.com/binance-chain/tss-lib/crypto/prmproof"
 	"github.com/binance-chain/tss-lib/crypto/vss"
 	"github.com/binance-chain/tss-lib/tss"
 )
@@ -41,33 +42,41 @@ func init() {
 
 // ----- //
 
+// NewKGRound1Message makes the round 1 message; either both DLN proofs or the Πprm proof should be given, depending
+// on the proof system that is used for the ring-Pedersen parameters
 func NewKGRound1Message(
 	from *tss.PartyID,
 	ct cmt.HashCommitment,
 	paillierPK *paillier.PublicKey,
 	nTildeI, h1I, h2I *big.Int,
 	dlnProof1, dlnProof2 *dlnproof.Proof,
+	prmProof *prmproof.Proof,
+	modProof *paillier.ModProof,
 ) (tss.ParsedMessage, error) {
 	meta := tss.MessageRouting{
 		From:        from,
 		IsBroadcast: true,
 	}
-	dlnProof1Bz, err := dlnProof1.Serialize()
-	if err != nil {
-		return nil, err
-	}
-	dlnProof2Bz, err := dlnProof2.Serialize()
-	if err != nil {
-		return nil, err
-	}
 	content := &KGRound1Message{
 		Commitment: ct.Bytes(),
 		PaillierN:  paillierPK.N.Bytes(),
 		NTilde:     nTildeI.Bytes(),
 		H1:         h1I.Bytes(),
 		H2:         h2I.Bytes(),
-		Dlnproof_1: dlnProof1Bz,
-		Dlnproof_2: dlnProof2Bz,
+		ModProof:   modProof.Serialize(),
+	}
+	if prmProof != nil {
+		content.PrmProof = prmProof.Serialize()
+	} else {
+		dlnProof1Bz, err := dlnProof1.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		dlnProof2Bz, err := dlnProof2.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		content.Dlnproof_1, content.Dlnproof_2 = dlnProof1Bz, dlnProof2Bz
 	}
 	msg := tss.NewMessageWrapper(meta, content)
 	return tss.NewMessage(meta, content, msg), nil
@@ -80,11 +89,28 @@ func (m *KGRound1Message) ValidateBasic() bool {
 		common.NonEmptyBytes(m.GetNTilde()) &&
 		common.NonEmptyBytes(m.GetH1()) &&
 		common.NonEmptyBytes(m.GetH2()) &&
-		// expected len of dln proof = sizeof(int64) + len(alpha) + len(t)
-		common.NonEmptyMultiBytes(m.GetDlnproof_1(), 2+(dlnproof.Iterations*2)) &&
+		m.validateRingPedersenProof() &&
+		common.NonEmptyMultiBytes(m.GetModProof(), paillier.ModProofBytesParts)
+}
+
+func (m *KGRound1Message) validateRingPedersenProof() bool {
+	if m.RingPedersenProof() == tss.PrmProof {
+		return len(m.GetDlnproof_1()) == 0 && len(m.GetDlnproof_2()) == 0 &&
+			common.NonEmptyMultiBytes(m.GetPrmProof(), prmproof.ProofBytesParts)
+	}
+	// expected len of dln proof = sizeof(int64) + len(alpha) + len(t)
+	return common.NonEmptyMultiBytes(m.GetDlnproof_1(), 2+(dlnproof.Iterations*2)) &&
 		common.NonEmptyMultiBytes(m.GetDlnproof_2(), 2+(dlnproof.Iterations*2))
 }
 
+// RingPedersenProof returns the proof system that the sender used for its ring-Pedersen parameters
+func (m *KGRound1Message) RingPedersenProof() tss.RingPedersenProof {
+	if len(m.GetPrmProof()) > 0 {
+		return tss.PrmProof
+	}
+	return tss.DLNProofs
+}
+
 func (m *KGRound1Message) UnmarshalCommitment() *big.Int {
 	return new(big.Int).SetBytes(m.GetCommitment())
 }
@@ -113,11 +139,20 @@ func (m *KGRound1Message) UnmarshalDLNProof2() (*dlnproof.Proof, error) {
 	return dlnproof.UnmarshalDLNProof(m.GetDlnproof_2())
 }
 
+func (m *KGRound1Message) UnmarshalPrmProof() (*prmproof.Proof, error) {
+	return prmproof.UnmarshalProof(m.GetPrmProof())
+}
+
+func (m *KGRound1Message) UnmarshalModProof() (*paillier.ModProof, error) {
+	return paillier.UnmarshalModProof(m.GetModProof())
+}
+
 // ----- //
 
 func NewKGRound2Message1(
 	to, from *tss.PartyID,
 	share *vss.Share,
+	facProof *paillier.FacProof,
 ) tss.ParsedMessage {
 	meta := tss.MessageRouting{
 		From:        from,
@@ -127,19 +162,27 @@ func NewKGRound2Message1(
 	content := &KGRound2Message1{
 		Share: share.Share.Bytes(),
 	}
+	if facProof != nil { // nil in the message kept by the sender
+		content.FacProof = facProof.Serialize()
+	}
 	msg := tss.NewMessageWrapper(meta, content)
 	return tss.NewMessage(meta, content, msg)
 }
 
 func (m *KGRound2Message1) ValidateBasic() bool {
 	return m != nil &&
-		common.NonEmptyBytes(m.GetShare())
+		common.NonEmptyBytes(m.GetShare()) &&
+		common.NonEmptyMultiBytes(m.GetFacProof(), paillier.FacProofBytesParts)
 }
 
 func (m *KGRound2Message1) UnmarshalShare() *big.Int {
 	return new(big.Int).SetBytes(m.Share)
 }
 
+func (m *KGRound2Message1) UnmarshalFacProof() (*paillier.FacProof, error) {
+	return paillier.UnmarshalFacProof(m.GetFacProof())
+}
+
 // ----- //
 
 func NewKGRound2Message2(
@@ -0,0 +1,306 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/binance-chain/tss-lib/common"
+)
+
+const (
+	defaultPreParamsPoolSize       = 2
+	defaultPreParamsPoolGenTimeout = 10 * time.Minute
+	// wait before retrying after a failed generation so that a persistent error does not spin the workers
+	preParamsPoolRetryDelay = 5 * time.Second
+)
+
+var (
+	ErrPreParamsPoolStopped = errors.New("the pre-params pool has been stopped")
+	ErrPreParamsPoolTimeout = errors.New("timed out waiting for pre-params from the pool")
+
+	defaultPool   *PreParamsPool
+	defaultPoolMu sync.RWMutex
+)
+
+type (
+	// PreParamsPoolConfig configures a PreParamsPool. The zero value is usable.
+	PreParamsPoolConfig struct {
+		// Size is the number of LocalPreParams that the pool keeps ready (default 2)
+		Size int
+		// Workers is the maximum number of LocalPreParams generated at the same time (default 1)
+		Workers int
+		// Concurrency is passed to GeneratePreParams by each worker (default: the number of CPUs divided by Workers)
+		Concurrency int
+		// GenTimeout bounds the time taken to generate one LocalPreParams (default 10 minutes)
+		GenTimeout time.Duration
+		// Store persists the ready LocalPreParams, e.g. a FilePreParamsStore. They are only kept in memory when nil.
+		Store PreParamsStore
+	}
+
+	// PreParamsPool generates LocalPreParams in the background and keeps `Size` of them ready for keygen and
+	// re-sharing, so that the safe prime generation is taken out of the protocol's critical path.
+	// Each LocalPreParams is handed out once.
+	PreParamsPool struct {
+		cfg      PreParamsPoolConfig
+		generate func(context.Context, ...int) (*LocalPreParams, error)
+
+		mtx      sync.Mutex
+		ready    []*LocalPreParams
+		avail    chan struct{}   // signalled when params become ready
+		room     chan struct{}   // signalled when params are taken
+		ctx      context.Context // cancelled by Stop
+		cancel   context.CancelFunc
+		stopped  bool
+		inFlight int
+		stats    PreParamsPoolHealth
+	}
+
+	// PreParamsPoolHealth is a snapshot of the state of a PreParamsPool
+	PreParamsPoolHealth struct {
+		Ready, Size, Generating int
+		// Generated and Failures count the successful and failed generations since the pool started
+		Generated, Failures int
+		// ConsecutiveFailures is reset by a successful generation
+		ConsecutiveFailures int
+		LastError           error
+		LastGenDuration     time.Duration
+		LastGenerated       time.Time
+		// StoreError is the last error returned by the store, if any
+		StoreError error
+		Stopped    bool
+	}
+)
+
+// NewPreParamsPool creates a pool and loads any LocalPreParams left in its store. Call Start to begin filling it.
+func NewPreParamsPool(cfg PreParamsPoolConfig) (*PreParamsPool, error) {
+	if cfg.Size <= 0 {
+		cfg.Size = defaultPreParamsPoolSize
+	}
+	if cfg.Workers <= 0 {
+		cfg.Workers = 1
+	}
+	if cfg.Concurrency <= 0 {
+		if cfg.Concurrency = runtime.NumCPU() / cfg.Workers; cfg.Concurrency < 1 {
+			cfg.Concurrency = 1
+		}
+	}
+	if cfg.GenTimeout <= 0 {
+		cfg.GenTimeout = defaultPreParamsPoolGenTimeout
+	}
+	pool := &PreParamsPool{
+		cfg: cfg,
+		generate: func(ctx context.Context, concurrency ...int) (*LocalPreParams, error) {
+			return GeneratePreParamsContext(ctx, nil, concurrency...)
+		},
+		avail: make(chan struct{}, 1),
+		room:  make(chan struct{}, cfg.Workers),
+	}
+	pool.ctx, pool.cancel = context.WithCancel(context.Background())
+	if cfg.Store != nil {
+		loaded, err := cfg.Store.Load()
+		if err != nil {
+			return nil, fmt.Errorf("could not load the pre-params store: %v", err)
+		}
+		for _, params := range loaded {
+			if params != nil && params.ValidateWithProof() {
+				pool.ready = append(pool.ready, params)
+			}
+		}
+		if len(pool.ready) > 0 {
+			pool.signal()
+		}
+	}
+	return pool, nil
+}
+
+// Start launches the refill workers
+func (pool *PreParamsPool) Start() {
+	for i := 0; i < pool.cfg.Workers; i++ {
+		go pool.refill()
+	}
+}
+
+// Stop stops the refill workers, aborting the generations in progress, and fails any pending Get.
+// The ready LocalPreParams stay in the store.
+func (pool *PreParamsPool) Stop() {
+	pool.mtx.Lock()
+	defer pool.mtx.Unlock()
+	if !pool.stopped {
+		pool.stopped = true
+		pool.cancel()
+	}
+}
+
+// Get takes ready LocalPreParams from the pool, waiting up to `timeout` for a worker to produce them
+func (pool *PreParamsPool) Get(timeout time.Duration) (*LocalPreParams, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		if params, err := pool.take(); params != nil || err != nil {
+			return params, err
+		}
+		select {
+		case <-pool.avail:
+		case <-pool.ctx.Done():
+			// something might have been added while stopping
+			if params, err := pool.take(); params != nil || err != nil {
+				return params, err
+			}
+			return nil, ErrPreParamsPoolStopped
+		case <-timer.C:
+			return nil, ErrPreParamsPoolTimeout
+		}
+	}
+}
+
+// Health returns a snapshot of the pool's state
+func (pool *PreParamsPool) Health() PreParamsPoolHealth {
+	pool.mtx.Lock()
+	defer pool.mtx.Unlock()
+	health := pool.stats
+	health.Ready, health.Size, health.Generating = len(pool.ready), pool.cfg.Size, pool.inFlight
+	health.Stopped = pool.stopped
+	return health
+}
+
+// Healthy reports whether the pool can be expected to serve a Get: it has params ready, or its workers are running
+// and the last generation succeeded
+func (h PreParamsPoolHealth) Healthy() bool {
+	return h.Ready > 0 || (!h.Stopped && h.ConsecutiveFailures == 0)
+}
+
+func (pool *PreParamsPool) take() (*LocalPreParams, error) {
+	pool.mtx.Lock()
+	defer pool.mtx.Unlock()
+	if len(pool.ready) == 0 {
+		return nil, nil
+	}
+	params := pool.ready[0]
+	pool.ready = pool.ready[1:]
+	// persist before handing out so that the same params cannot be served again after a restart
+	if err := pool.save(); err != nil {
+		pool.ready = append([]*LocalPreParams{params}, pool.ready...)
+		return nil, err
+	}
+	if len(pool.ready) > 0 {
+		pool.signal()
+	}
+	select {
+	case pool.room <- struct{}{}:
+	default:
+	}
+	return params, nil
+}
+
+func (pool *PreParamsPool) refill() {
+	for {
+		pool.mtx.Lock()
+		if pool.stopped {
+			pool.mtx.Unlock()
+			return
+		}
+		if len(pool.ready)+pool.inFlight >= pool.cfg.Size {
+			pool.mtx.Unlock()
+			// wait for a Get to make room
+			select {
+			case <-pool.ctx.Done():
+				return
+			case <-pool.room:
+			}
+			continue
+		}
+		pool.inFlight++
+		pool.mtx.Unlock()
+
+		start := time.Now()
+		ctx, cancel := context.WithTimeout(pool.ctx, pool.cfg.GenTimeout)
+		params, err := pool.generate(ctx, pool.cfg.Concurrency)
+		cancel()
+
+		pool.mtx.Lock()
+		pool.inFlight--
+		if err != nil && pool.stopped { // aborted by Stop
+			pool.mtx.Unlock()
+			return
+		}
+		if err != nil {
+			pool.stats.Failures++
+			pool.stats.ConsecutiveFailures++
+			pool.stats.LastError = err
+			pool.mtx.Unlock()
+			common.Logger.Warningf("pre-params pool: generation failed: %v", err)
+			select {
+			case <-pool.ctx.Done():
+				return
+			case <-time.After(preParamsPoolRetryDelay):
+			}
+			continue
+		}
+		pool.stats.Generated++
+		pool.stats.ConsecutiveFailures = 0
+		pool.stats.LastGenDuration = time.Since(start)
+		pool.stats.LastGenerated = time.Now()
+		pool.ready = append(pool.ready, params)
+		if err := pool.save(); err != nil {
+			common.Logger.Warningf("pre-params pool: could not save the store: %v", err)
+		}
+		pool.signal()
+		pool.mtx.Unlock()
+	}
+}
+
+// save must be called with the lock held
+func (pool *PreParamsPool) save() error {
+	if pool.cfg.Store == nil {
+		return nil
+	}
+	err := pool.cfg.Store.Save(pool.ready)
+	pool.stats.StoreError = err
+	return err
+}
+
+func (pool *PreParamsPool) signal() {
+	select {
+	case pool.avail <- struct{}{}:
+	default:
+	}
+}
+
+// ----- //
+
+// SetDefaultPreParamsPool sets the pool that keygen and re-sharing parties draw from when they were not given
+// LocalPreParams. Pass nil to generate them on the spot again.
+func SetDefaultPreParamsPool(pool *PreParamsPool) {
+	defaultPoolMu.Lock()
+	defer defaultPoolMu.Unlock()
+	defaultPool = pool
+}
+
+// DefaultPreParamsPool returns the pool set with SetDefaultPreParamsPool, or nil
+func DefaultPreParamsPool() *PreParamsPool {
+	defaultPoolMu.RLock()
+	defer defaultPoolMu.RUnlock()
+	return defaultPool
+}
+
+// GetPreParams takes LocalPreParams from the default pool if one is set and otherwise generates them with
+// GeneratePreParams. A stopped pool is bypassed.
+func GetPreParams(timeout time.Duration, optionalConcurrency ...int) (*LocalPreParams, error) {
+	if pool := DefaultPreParamsPool(); pool != nil {
+		params, err := pool.Get(timeout)
+		if err != ErrPreParamsPoolStopped {
+			return params, err
+		}
+	}
+	return GeneratePreParams(timeout, optionalConcurrency...)
+}
@@ -0,0 +1,112 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+const filePreParamsStoreKeyLen = 32 // AES-256
+
+type (
+	// PreParamsStore persists the ready LocalPreParams of a PreParamsPool between restarts.
+	// Save is given the full set each time it changes.
+	PreParamsStore interface {
+		Load() ([]*LocalPreParams, error)
+		Save([]*LocalPreParams) error
+	}
+
+	// FilePreParamsStore keeps the LocalPreParams in a single file encrypted with AES-256-GCM
+	FilePreParamsStore struct {
+		path string
+		aead cipher.AEAD
+	}
+)
+
+// NewFilePreParamsStore returns a store that writes to `path` with the 32-byte `key`.
+// The file does not need to exist yet.
+func NewFilePreParamsStore(path string, key []byte) (*FilePreParamsStore, error) {
+	if len(key) != filePreParamsStoreKeyLen {
+		return nil, fmt.Errorf("the pre-params store key must be %d bytes, got %d", filePreParamsStoreKeyLen, len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &FilePreParamsStore{path: path, aead: aead}, nil
+}
+
+// Load decrypts the file; a missing file is an empty store
+func (store *FilePreParamsStore) Load() ([]*LocalPreParams, error) {
+	bz, err := ioutil.ReadFile(store.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	nonceSize := store.aead.NonceSize()
+	if len(bz) < nonceSize {
+		return nil, errors.New("the pre-params store file is truncated")
+	}
+	plain, err := store.aead.Open(nil, bz[:nonceSize], bz[nonceSize:], nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt the pre-params store (wrong key?): %v", err)
+	}
+	var params []*LocalPreParams
+	if err := json.Unmarshal(plain, &params); err != nil {
+		return nil, err
+	}
+	return params, nil
+}
+
+// Save encrypts `params` with a fresh nonce and replaces the file atomically
+func (store *FilePreParamsStore) Save(params []*LocalPreParams) error {
+	plain, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, store.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return err
+	}
+	bz := store.aead.Seal(nonce, nonce, plain, nil)
+	for i := range plain {
+		plain[i] = 0
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(store.path), filepath.Base(store.path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // fails harmlessly after the rename
+	if _, err := tmp.Write(bz); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), store.path)
+}
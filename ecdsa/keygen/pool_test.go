@@ -0,0 +1,169 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fixturePreParamsGenerator hands out the pre-params of the test fixtures instead of generating new ones
+func fixturePreParamsGenerator(t *testing.T, qty int) func(context.Context, ...int) (*LocalPreParams, error) {
+	keys, _, err := LoadKeygenTestFixtures(qty)
+	if !assert.NoError(t, err, "should load keygen fixtures") {
+		t.FailNow()
+	}
+	var mtx sync.Mutex
+	next := 0
+	return func(context.Context, ...int) (*LocalPreParams, error) {
+		mtx.Lock()
+		defer mtx.Unlock()
+		if next == len(keys) {
+			return nil, errors.New("out of fixtures")
+		}
+		params := keys[next].LocalPreParams
+		next++
+		return &params, nil
+	}
+}
+
+func waitForHealth(t *testing.T, pool *PreParamsPool, cond func(PreParamsPoolHealth) bool) PreParamsPoolHealth {
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		health := pool.Health()
+		if cond(health) || time.Now().After(deadline) {
+			return health
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestPreParamsPoolGet(t *testing.T) {
+	pool, err := NewPreParamsPool(PreParamsPoolConfig{Size: 2, Workers: 2})
+	assert.NoError(t, err)
+	pool.generate = fixturePreParamsGenerator(t, 4)
+	pool.Start()
+	defer pool.Stop()
+
+	health := waitForHealth(t, pool, func(h PreParamsPoolHealth) bool { return h.Ready == 2 })
+	assert.Equal(t, 2, health.Ready)
+	assert.Equal(t, 2, health.Generated)
+	assert.True(t, health.Healthy())
+
+	p1, err := pool.Get(time.Second)
+	assert.NoError(t, err)
+	p2, err := pool.Get(time.Second)
+	assert.NoError(t, err)
+	assert.NotEqual(t, 0, p1.NTildei.Cmp(p2.NTildei), "each pre-params should be handed out once")
+
+	// the workers refill the pool after a Get
+	health = waitForHealth(t, pool, func(h PreParamsPoolHealth) bool { return h.Generated == 4 })
+	assert.Equal(t, 4, health.Generated)
+	assert.Equal(t, 2, health.Ready)
+}
+
+func TestPreParamsPoolGetTimeoutAndStop(t *testing.T) {
+	pool, err := NewPreParamsPool(PreParamsPoolConfig{Size: 1})
+	assert.NoError(t, err)
+	pool.generate = func(context.Context, ...int) (*LocalPreParams, error) {
+		return nil, errors.New("no primes today")
+	}
+	pool.Start()
+
+	_, err = pool.Get(50 * time.Millisecond)
+	assert.Equal(t, ErrPreParamsPoolTimeout, err)
+
+	health := waitForHealth(t, pool, func(h PreParamsPoolHealth) bool { return h.Failures > 0 })
+	assert.Equal(t, 1, health.ConsecutiveFailures)
+	assert.EqualError(t, health.LastError, "no primes today")
+	assert.False(t, health.Healthy())
+
+	pool.Stop()
+	_, err = pool.Get(time.Second)
+	assert.Equal(t, ErrPreParamsPoolStopped, err)
+	assert.True(t, pool.Health().Stopped)
+}
+
+func TestPreParamsPoolStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "preparams")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "preparams.bin")
+	key := make([]byte, 32)
+	key[0] = 1
+
+	store, err := NewFilePreParamsStore(path, key)
+	assert.NoError(t, err)
+	pool, err := NewPreParamsPool(PreParamsPoolConfig{Size: 2, Store: store})
+	assert.NoError(t, err)
+	pool.generate = fixturePreParamsGenerator(t, 2)
+	pool.Start()
+	waitForHealth(t, pool, func(h PreParamsPoolHealth) bool { return h.Ready == 2 })
+	pool.Stop()
+	taken, err := pool.Get(time.Second)
+	assert.NoError(t, err)
+
+	// a new pool picks up the params that were not taken
+	pool2, err := NewPreParamsPool(PreParamsPoolConfig{Size: 2, Store: store})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, pool2.Health().Ready)
+	left, err := pool2.Get(time.Second)
+	assert.NoError(t, err)
+	assert.NotEqual(t, 0, taken.NTildei.Cmp(left.NTildei))
+	assert.True(t, left.ValidateWithProof())
+
+	// the file is encrypted
+	key[0] = 2
+	store2, err := NewFilePreParamsStore(path, key)
+	assert.NoError(t, err)
+	_, err = NewPreParamsPool(PreParamsPoolConfig{Store: store2})
+	assert.Error(t, err)
+
+	_, err = NewFilePreParamsStore(path, key[:16])
+	assert.Error(t, err)
+}
+
+func TestGetPreParamsFromDefaultPool(t *testing.T) {
+	pool, err := NewPreParamsPool(PreParamsPoolConfig{Size: 1})
+	assert.NoError(t, err)
+	pool.generate = fixturePreParamsGenerator(t, 1)
+	pool.Start()
+	defer pool.Stop()
+
+	SetDefaultPreParamsPool(pool)
+	defer SetDefaultPreParamsPool(nil)
+	params, err := GetPreParams(10 * time.Second)
+	assert.NoError(t, err)
+	assert.True(t, params.ValidateWithProof())
+}
+
+func TestPreParamsPoolStopAbortsGeneration(t *testing.T) {
+	pool, err := NewPreParamsPool(PreParamsPoolConfig{Size: 1})
+	assert.NoError(t, err)
+	started := make(chan struct{})
+	pool.generate = func(ctx context.Context, _ ...int) (*LocalPreParams, error) {
+		close(started)
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	pool.Start()
+	<-started
+	assert.Equal(t, 1, pool.Health().Generating)
+
+	pool.Stop()
+	health := waitForHealth(t, pool, func(h PreParamsPoolHealth) bool { return h.Generating == 0 })
+	assert.Equal(t, 0, health.Generating)
+	assert.Equal(t, 0, health.Failures, "an aborted generation is not a failure")
+}
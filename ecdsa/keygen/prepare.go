@@ -7,13 +7,18 @@
 package keygen
 
 import (
+	"context"
+	"crypto/elliptic"
 	"errors"
+	"fmt"
 	"math/big"
 	"runtime"
+	"sync"
 	"time"
 
 	"github.com/binance-chain/tss-lib/common"
 	"github.com/binance-chain/tss-lib/crypto/paillier"
+	"github.com/binance-chain/tss-lib/tss"
 )
 
 const (
@@ -21,14 +26,95 @@ const (
 	paillierModulusLen = 2048
 	// Two 1024-bit safe primes to produce NTilde
 	safePrimeBitLen = 1024
-	// Ticker for printing log statements while generating primes/modulus
+	// Interval between the progress reports while generating primes/modulus
 	logProgressTickInterval = 8 * time.Second
+	// The commitments and Fiat-Shamir challenges use 256-bit hashes
+	maxCurveOrderBitLen = 256
 )
 
+// CheckCurve returns an error if the curve's order q is too large for the ECDSA protocols to be secure: the
+// challenges are reduced mod q from a 256-bit hash, and the MtA protocol and its range proofs assume that the Paillier
+// and ring-Pedersen moduli of the peers, which are at least the minimums of `params`, have 8 times the bits of q.
+// secp256k1 and P-256 are supported with the default minimums; e.g. P-384 and P-521 are not.
+func CheckCurve(params *tss.Parameters, curve elliptic.Curve) error {
+	if curve == nil {
+		return errors.New("the curve is nil")
+	}
+	bitLen := curve.Params().N.BitLen()
+	if bitLen > maxCurveOrderBitLen {
+		return fmt.Errorf("the %d-bit order of curve %s is too large for the 256-bit challenges (max %d bits)",
+			bitLen, curve.Params().Name, maxCurveOrderBitLen)
+	}
+	minModulusBitLen := params.MinPaillierBitLen()
+	if params.MinNTildeBitLen() < minModulusBitLen {
+		minModulusBitLen = params.MinNTildeBitLen()
+	}
+	if 8*bitLen > minModulusBitLen {
+		return fmt.Errorf("the %d-bit order of curve %s is too large for the MtA bounds with %d-bit moduli (max %d bits)",
+			bitLen, curve.Params().Name, minModulusBitLen, minModulusBitLen/8)
+	}
+	return nil
+}
+
+// CheckPeerModuli returns an error if a peer's Paillier public key or ring-Pedersen parameters (NTilde, h1, h2) are
+// malformed, or if a modulus is shorter than the minimum of `params`. The MtA range proofs and the random masks of
+// Bob are only sound when both moduli are much larger than q, which their own proofs do not check.
+func CheckPeerModuli(params *tss.Parameters, paillierPK *paillier.PublicKey, NTilde, h1, h2 *big.Int) error {
+	if paillierPK == nil || paillierPK.N == nil {
+		return errors.New("the paillier public key is missing")
+	}
+	if err := checkModulus("paillier", paillierPK.N, params.MinPaillierBitLen()); err != nil {
+		return err
+	}
+	if NTilde == nil || h1 == nil || h2 == nil {
+		return errors.New("the ring-Pedersen parameters are missing")
+	}
+	if err := checkModulus("NTilde", NTilde, params.MinNTildeBitLen()); err != nil {
+		return err
+	}
+	one := big.NewInt(1)
+	for _, h := range []*big.Int{h1, h2} {
+		if h.Cmp(one) <= 0 || h.Cmp(NTilde) >= 0 || new(big.Int).GCD(nil, nil, h, NTilde).Cmp(one) != 0 {
+			return errors.New("h1 or h2 is not a unit in (1, NTilde)")
+		}
+	}
+	return nil
+}
+
+func checkModulus(name string, N *big.Int, minBitLen int) error {
+	if N.Sign() <= 0 || N.Bit(0) == 0 {
+		return fmt.Errorf("the %s modulus is not a positive odd integer", name)
+	}
+	if bitLen := N.BitLen(); bitLen < minBitLen {
+		return fmt.Errorf("the %s modulus is %d bits, expected at least %d", name, bitLen, minBitLen)
+	}
+	return nil
+}
+
+// PreParamsProgress is given to the progress callback of GeneratePreParamsContext
+type PreParamsProgress struct {
+	Elapsed time.Duration
+	// PaillierDone and SafePrimesDone are set once the Paillier key and the two safe primes for NTilde are found
+	PaillierDone, SafePrimesDone bool
+}
+
 // GeneratePreParams finds two safe primes and computes the Paillier secret required for the protocol.
 // This can be a time consuming process so it is recommended to do it out-of-band.
 // If not specified, a concurrency value equal to the number of available CPU cores will be used.
 func GeneratePreParams(timeout time.Duration, optionalConcurrency ...int) (*LocalPreParams, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	preParams, err := GeneratePreParamsContext(ctx, logPreParamsProgress, optionalConcurrency...)
+	if err == context.DeadlineExceeded {
+		return nil, errors.New("timeout while generating the pre-params")
+	}
+	return preParams, err
+}
+
+// GeneratePreParamsContext is like GeneratePreParams but stops when `ctx` is done, in which case `ctx.Err()` is
+// returned and all of the generator routines have exited. `progress` may be nil; otherwise it is called every few
+// seconds and whenever a part is done.
+func GeneratePreParamsContext(ctx context.Context, progress func(PreParamsProgress), optionalConcurrency ...int) (*LocalPreParams, error) {
 	var concurrency int
 	if 0 < len(optionalConcurrency) {
 		if 1 < len(optionalConcurrency) {
@@ -41,70 +127,88 @@ func GeneratePreParams(timeout time.Duration, optionalConcurrency ...int) (*Loca
 	if concurrency /= 3; concurrency < 1 {
 		concurrency = 1
 	}
+	if progress == nil {
+		progress = func(PreParamsProgress) {}
+	}
 
 	// prepare for concurrent Paillier and safe prime generation
+	ctx, cancel := context.WithCancel(ctx)
 	paiCh := make(chan *paillier.PrivateKey, 1)
 	sgpCh := make(chan []*common.GermainSafePrime, 1)
+	paiErrCh, sgpErrCh := make(chan error, 1), make(chan error, 1)
+	var wg sync.WaitGroup
+	wg.Add(2)
+	// no generator outlives this call
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
 
 	// 4. generate Paillier public key E_i, private key and proof
-	go func(ch chan<- *paillier.PrivateKey) {
-		common.Logger.Info("generating the Paillier modulus, please wait...")
-		start := time.Now()
+	go func() {
+		defer wg.Done()
 		// more concurrency weight is assigned here because the paillier primes have a requirement of having "large" P-Q
-		PiPaillierSk, _, err := paillier.GenerateKeyPair(paillierModulusLen, timeout, concurrency*2)
+		PiPaillierSk, _, err := paillier.GenerateKeyPairContext(ctx, paillierModulusLen, concurrency*2)
 		if err != nil {
-			ch <- nil
+			paiErrCh <- err
 			return
 		}
-		common.Logger.Infof("paillier modulus generated. took %s\n", time.Since(start))
-		ch <- PiPaillierSk
-	}(paiCh)
+		paiCh <- PiPaillierSk
+	}()
 
 	// 5-7. generate safe primes for ZKPs used later on
-	go func(ch chan<- []*common.GermainSafePrime) {
-		var err error
-		common.Logger.Info("generating the safe primes for the signing proofs, please wait...")
-		start := time.Now()
-		sgps, err := common.GetRandomSafePrimesConcurrent(safePrimeBitLen, 2, timeout, concurrency)
+	go func() {
+		defer wg.Done()
+		sgps, err := common.GetRandomSafePrimesConcurrentContext(ctx, safePrimeBitLen, 2, concurrency)
 		if err != nil {
-			ch <- nil
+			sgpErrCh <- err
 			return
 		}
-		common.Logger.Infof("safe primes generated. took %s\n", time.Since(start))
-		ch <- sgps
-	}(sgpCh)
+		sgpCh <- sgps
+	}()
 
-	// this ticker will print a log statement while the generating is still in progress
-	logProgressTicker := time.NewTicker(logProgressTickInterval)
+	// this ticker reports the progress while the generating is still in progress
+	start := time.Now()
+	var state PreParamsProgress
+	report := func() {
+		state.Elapsed = time.Since(start)
+		progress(state)
+	}
+	progressTicker := time.NewTicker(logProgressTickInterval)
+	defer progressTicker.Stop()
 
-	// errors can be thrown in the following code; consume chans to end goroutines here
+	// errors can be thrown in the following code; the deferred cancel and wait end the goroutines
 	var sgps []*common.GermainSafePrime
 	var paiSK *paillier.PrivateKey
-consumer:
-	for {
+	for paiSK == nil || sgps == nil {
 		select {
-		case <-logProgressTicker.C:
-			common.Logger.Info("still generating primes...")
+		case <-progressTicker.C:
+			report()
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case err := <-sgpErrCh:
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return nil, fmt.Errorf("error while generating the safe primes: %v", err)
+		case err := <-paiErrCh:
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return nil, fmt.Errorf("error while generating the Paillier secret key: %v", err)
 		case sgps = <-sgpCh:
-			if sgps == nil ||
-				sgps[0] == nil || sgps[1] == nil ||
+			if sgps[0] == nil || sgps[1] == nil ||
 				!sgps[0].Prime().ProbablyPrime(30) || !sgps[1].Prime().ProbablyPrime(30) ||
 				!sgps[0].SafePrime().ProbablyPrime(30) || !sgps[1].SafePrime().ProbablyPrime(30) {
-				return nil, errors.New("timeout or error while generating the safe primes")
-			}
-			if paiSK != nil {
-				break consumer
+				return nil, errors.New("error while generating the safe primes")
 			}
+			state.SafePrimesDone = true
+			report()
 		case paiSK = <-paiCh:
-			if paiSK == nil {
-				return nil, errors.New("timeout or error while generating the Paillier secret key")
-			}
-			if sgps != nil {
-				break consumer
-			}
+			state.PaillierDone = true
+			report()
 		}
 	}
-	logProgressTicker.Stop()
 
 	P, Q := sgps[0].SafePrime(), sgps[1].SafePrime()
 	NTildei := new(big.Int).Mul(P, Q)
@@ -116,7 +220,7 @@ consumer:
 	alpha := common.GetRandomPositiveRelativelyPrimeInt(NTildei)
 	beta := modPQ.ModInverse(alpha)
 	h1i := modNTildeI.Mul(f1, f1)
-	h2i := modNTildeI.Exp(h1i, alpha)
+	h2i := modNTildeI.ExpSecret(h1i, alpha)
 
 	preParams := &LocalPreParams{
 		PaillierSK: paiSK,
@@ -130,3 +234,16 @@ consumer:
 	}
 	return preParams, nil
 }
+
+// logPreParamsProgress is the progress callback of GeneratePreParams
+func logPreParamsProgress(progress PreParamsProgress) {
+	switch {
+	case progress.PaillierDone && progress.SafePrimesDone:
+		common.Logger.Infof("pre-params generated. took %s", progress.Elapsed)
+	case progress.PaillierDone || progress.SafePrimesDone:
+		common.Logger.Infof("paillier modulus generated: %v, safe primes generated: %v, %s elapsed",
+			progress.PaillierDone, progress.SafePrimesDone, progress.Elapsed)
+	default:
+		common.Logger.Info("still generating primes...")
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"context"
+	"math/big"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/binance-chain/tss-lib/crypto/paillier"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+func TestGeneratePreParamsContextCancel(t *testing.T) {
+	before := runtime.NumGoroutine()
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(200*time.Millisecond, cancel)
+	var reports []PreParamsProgress
+	start := time.Now()
+	preParams, err := GeneratePreParamsContext(ctx, func(p PreParamsProgress) {
+		reports = append(reports, p)
+	}, 3)
+	assert.Equal(t, context.Canceled, err)
+	assert.Nil(t, preParams)
+	assert.True(t, time.Since(start) < 5*time.Second, "took %s to cancel", time.Since(start))
+	assert.True(t, runtime.NumGoroutine() <= before, "the generator routines should have exited")
+	for _, p := range reports {
+		assert.False(t, p.PaillierDone && p.SafePrimesDone)
+	}
+}
+
+func TestGeneratePreParamsTimeout(t *testing.T) {
+	preParams, err := GeneratePreParams(100*time.Millisecond, 3)
+	assert.Error(t, err)
+	assert.Nil(t, preParams)
+}
+
+func TestCheckPeerModuli(t *testing.T) {
+	keys, _, err := LoadKeygenTestFixtures(2)
+	assert.NoError(t, err)
+	params := tss.NewParameters(nil, nil, 2, 1)
+	key := keys[0]
+	pk, NTilde, h1, h2 := key.PaillierPKs[1], key.NTildej[1], key.H1j[1], key.H2j[1]
+	assert.NoError(t, CheckPeerModuli(params, pk, NTilde, h1, h2))
+
+	small := &paillier.PublicKey{N: new(big.Int).Rsh(pk.N, 1024)}
+	small.N.SetBit(small.N, 0, 1)
+	assert.Error(t, CheckPeerModuli(params, small, NTilde, h1, h2), "1024-bit paillier modulus")
+	assert.Error(t, CheckPeerModuli(params, pk, new(big.Int).Rsh(NTilde, 1), h1, h2), "2047-bit NTilde")
+	assert.Error(t, CheckPeerModuli(params, &paillier.PublicKey{N: new(big.Int).Add(pk.N, big.NewInt(1))}, NTilde, h1, h2), "even modulus")
+	assert.Error(t, CheckPeerModuli(params, nil, NTilde, h1, h2))
+	assert.Error(t, CheckPeerModuli(params, pk, NTilde, nil, h2))
+	assert.Error(t, CheckPeerModuli(params, pk, NTilde, big.NewInt(1), h2))
+	assert.Error(t, CheckPeerModuli(params, pk, NTilde, h1, NTilde))
+
+	// the minimums are configurable
+	params.SetMinModulusBitLens(1024, 2048)
+	assert.NoError(t, CheckPeerModuli(params, small, NTilde, h1, h2))
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/binance-chain/tss-lib/crypto"
 	cmts "github.com/binance-chain/tss-lib/crypto/commitments"
 	"github.com/binance-chain/tss-lib/crypto/dlnproof"
+	"github.com/binance-chain/tss-lib/crypto/prmproof"
 	"github.com/binance-chain/tss-lib/crypto/vss"
 	"github.com/binance-chain/tss-lib/tss"
 )
@@ -39,6 +40,10 @@ func (round *round1) Start() *tss.Error {
 	Pi := round.PartyID()
 	i := Pi.Index
 
+	if err := CheckCurve(round.Params(), tss.EC()); err != nil {
+		return round.WrapError(err, Pi)
+	}
+
 	// 1. calculate "partial" key share ui
 	ui := common.GetRandomPositiveInt(tss.EC().Params().N)
 
@@ -67,6 +72,7 @@ func (round *round1) Start() *tss.Error {
 	// 5-7. generate safe primes for ZKPs used later on
 	// 9-11. compute ntilde, h1, h2 (uses safe primes)
 	// use the pre-params if they were provided to the LocalParty constructor
+	// otherwise take them from the default PreParamsPool, if set, or generate them now
 	var preParams *LocalPreParams
 	if round.save.LocalPreParams.Validate() && !round.save.LocalPreParams.ValidateWithProof() {
 		return round.WrapError(
@@ -74,7 +80,7 @@ func (round *round1) Start() *tss.Error {
 	} else if round.save.LocalPreParams.ValidateWithProof() {
 		preParams = &round.save.LocalPreParams
 	} else {
-		preParams, err = GeneratePreParams(round.SafePrimeGenTimeout(), 3)
+		preParams, err = GetPreParams(round.SafePrimeGenTimeout(), 3)
 		if err != nil {
 			return round.WrapError(errors.New("pre-params generation failed"), Pi)
 		}
@@ -83,7 +89,7 @@ func (round *round1) Start() *tss.Error {
 	round.save.NTildej[i] = preParams.NTildei
 	round.save.H1j[i], round.save.H2j[i] = preParams.H1i, preParams.H2i
 
-	// generate the dlnproofs for keygen
+	// generate the dlnproofs for keygen, or the ring-Pedersen parameter proof if that is preferred
 	h1i, h2i, alpha, beta, p, q, NTildei :=
 		preParams.H1i,
 		preParams.H2i,
@@ -92,8 +98,21 @@ func (round *round1) Start() *tss.Error {
 		preParams.P,
 		preParams.Q,
 		preParams.NTildei
-	dlnProof1 := dlnproof.NewDLNProof(h1i, h2i, alpha, p, q, NTildei)
-	dlnProof2 := dlnproof.NewDLNProof(h2i, h1i, beta, p, q, NTildei)
+	var dlnProof1, dlnProof2 *dlnproof.Proof
+	var prmProof *prmproof.Proof
+	if round.RingPedersenProofs()[0] == tss.PrmProof {
+		if prmProof, err = prmproof.NewProof(h2i, h1i, alpha, p, q, NTildei, Pi.KeyInt()); err != nil {
+			return round.WrapError(err, Pi)
+		}
+	} else {
+		dlnProof1 = dlnproof.NewDLNProof(h1i, h2i, alpha, p, q, NTildei)
+		dlnProof2 = dlnproof.NewDLNProof(h2i, h1i, beta, p, q, NTildei)
+	}
+	// prove that the Paillier modulus is a product of two primes = 3 mod 4 (Πmod)
+	modProof, err := preParams.PaillierSK.ModProof(Pi.KeyInt())
+	if err != nil {
+		return round.WrapError(err, Pi)
+	}
 
 	// for this P: SAVE
 	// - shareID
@@ -112,7 +131,7 @@ func (round *round1) Start() *tss.Error {
 	// BROADCAST commitments, paillier pk + proof; round 1 message
 	{
 		msg, err := NewKGRound1Message(
-			round.PartyID(), cmt.C, &preParams.PaillierSK.PublicKey, preParams.NTildei, preParams.H1i, preParams.H2i, dlnProof1, dlnProof2)
+			round.PartyID(), cmt.C, &preParams.PaillierSK.PublicKey, preParams.NTildei, preParams.H1i, preParams.H2i, dlnProof1, dlnProof2, prmProof, modProof)
 		if err != nil {
 			return round.WrapError(err, Pi)
 		}
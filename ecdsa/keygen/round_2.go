@@ -9,9 +9,10 @@ package keygen
 import (
 	"encoding/hex"
 	"errors"
-	"math/big"
+	"fmt"
 	"sync"
 
+	"github.com/binance-chain/tss-lib/crypto/paillier"
 	"github.com/binance-chain/tss-lib/tss"
 )
 
@@ -25,17 +26,26 @@ func (round *round2) Start() *tss.Error {
 
 	i := round.PartyID().Index
 
-	// 6. verify dln proofs, store r1 message pieces, ensure uniqueness of h1j, h2j
+	// 6. verify dln (or ring-Pedersen parameter) and Paillier modulus proofs, store r1 message pieces, ensure uniqueness of h1j, h2j
 	h1H2Map := make(map[string]struct{}, len(round.temp.kgRound1Messages)*2)
 	dlnProof1FailCulprits := make([]*tss.PartyID, len(round.temp.kgRound1Messages))
 	dlnProof2FailCulprits := make([]*tss.PartyID, len(round.temp.kgRound1Messages))
+	prmProofFailCulprits := make([]*tss.PartyID, len(round.temp.kgRound1Messages))
+	modProofFailCulprits := make([]*tss.PartyID, len(round.temp.kgRound1Messages))
 	wg := new(sync.WaitGroup)
+	verifiers := round.VerifierPool()
 	for j, msg := range round.temp.kgRound1Messages {
+		j, msg := j, msg
 		r1msg := msg.Content().(*KGRound1Message)
 		H1j, H2j, NTildej :=
 			r1msg.UnmarshalH1(),
 			r1msg.UnmarshalH2(),
 			r1msg.UnmarshalNTilde()
+		if j != i {
+			if err := CheckPeerModuli(round.Params(), r1msg.UnmarshalPaillierPK(), NTildej, H1j, H2j); err != nil {
+				return round.WrapError(err, msg.GetFrom())
+			}
+		}
 		if H1j.Cmp(H2j) == 0 {
 			return round.WrapError(errors.New("h1j and h2j were equal for this party"), msg.GetFrom())
 		}
@@ -47,19 +57,44 @@ func (round *round2) Start() *tss.Error {
 			return round.WrapError(errors.New("this h2j was already used by another party"), msg.GetFrom())
 		}
 		h1H2Map[h1JHex], h1H2Map[h2JHex] = struct{}{}, struct{}{}
-		wg.Add(2)
-		go func(j int, msg tss.ParsedMessage, r1msg *KGRound1Message, H1j, H2j, NTildej *big.Int) {
-			if dlnProof1, err := r1msg.UnmarshalDLNProof1(); err != nil || !dlnProof1.Verify(H1j, H2j, NTildej) {
-				dlnProof1FailCulprits[j] = msg.GetFrom()
-			}
-			wg.Done()
-		}(j, msg, r1msg, H1j, H2j, NTildej)
-		go func(j int, msg tss.ParsedMessage, r1msg *KGRound1Message, H1j, H2j, NTildej *big.Int) {
-			if dlnProof2, err := r1msg.UnmarshalDLNProof2(); err != nil || !dlnProof2.Verify(H2j, H1j, NTildej) {
-				dlnProof2FailCulprits[j] = msg.GetFrom()
+		proof := r1msg.RingPedersenProof()
+		if !round.AcceptsRingPedersenProof(proof) {
+			return round.WrapError(fmt.Errorf("ring-Pedersen proof system %s is not accepted", proof), msg.GetFrom())
+		}
+		if proof == tss.PrmProof {
+			wg.Add(1)
+			verifiers.Go(func() {
+				if prmProof, err := r1msg.UnmarshalPrmProof(); err != nil || !prmProof.Verify(H2j, H1j, NTildej, msg.GetFrom().KeyInt()) {
+					prmProofFailCulprits[j] = msg.GetFrom()
+				}
+				wg.Done()
+			})
+		} else {
+			wg.Add(2)
+			verifiers.Go(func() {
+				if dlnProof1, err := r1msg.UnmarshalDLNProof1(); err != nil || !dlnProof1.Verify(H1j, H2j, NTildej) {
+					dlnProof1FailCulprits[j] = msg.GetFrom()
+				}
+				wg.Done()
+			})
+			verifiers.Go(func() {
+				if dlnProof2, err := r1msg.UnmarshalDLNProof2(); err != nil || !dlnProof2.Verify(H2j, H1j, NTildej) {
+					dlnProof2FailCulprits[j] = msg.GetFrom()
+				}
+				wg.Done()
+			})
+		}
+		if j == i {
+			continue
+		}
+		wg.Add(1)
+		verifiers.Go(func() {
+			if modProof, err := r1msg.UnmarshalModProof(); err != nil ||
+				!modProof.Verify(r1msg.UnmarshalPaillierPK().N, msg.GetFrom().KeyInt()) {
+				modProofFailCulprits[j] = msg.GetFrom()
 			}
 			wg.Done()
-		}(j, msg, r1msg, H1j, H2j, NTildej)
+		})
 	}
 	wg.Wait()
 	for _, culprit := range append(dlnProof1FailCulprits, dlnProof2FailCulprits...) {
@@ -67,6 +102,16 @@ func (round *round2) Start() *tss.Error {
 			return round.WrapError(errors.New("dln proof verification failed"), culprit)
 		}
 	}
+	for _, culprit := range prmProofFailCulprits {
+		if culprit != nil {
+			return round.WrapError(errors.New("ring-Pedersen parameter proof verification failed"), culprit)
+		}
+	}
+	for _, culprit := range modProofFailCulprits {
+		if culprit != nil {
+			return round.WrapError(errors.New("paillier modulus proof verification failed"), culprit)
+		}
+	}
 	// save NTilde_j, h1_j, h2_j, ...
 	for j, msg := range round.temp.kgRound1Messages {
 		if j == i {
@@ -85,10 +130,31 @@ func (round *round2) Start() *tss.Error {
 		round.temp.KGCs[j] = KGC
 	}
 
+	// prove to each Pj that our Paillier modulus has no small factors (Πfac), with Pj's NTilde, h1, h2
+	facProofs := make([]*paillier.FacProof, len(round.Parties().IDs()))
+	facProofErrs := make([]error, len(facProofs))
+	for j := range facProofs {
+		if j == i {
+			continue
+		}
+		wg.Add(1)
+		go func(j int) {
+			defer wg.Done()
+			facProofs[j], facProofErrs[j] = round.save.PaillierSK.FacProof(
+				round.save.NTildej[j], round.save.H1j[j], round.save.H2j[j], tss.EC().Params().N, round.PartyID().KeyInt())
+		}(j)
+	}
+	wg.Wait()
+	for _, err := range facProofErrs {
+		if err != nil {
+			return round.WrapError(err, round.PartyID())
+		}
+	}
+
 	// 5. p2p send share ij to Pj
 	shares := round.temp.shares
 	for j, Pj := range round.Parties().IDs() {
-		r2msg1 := NewKGRound2Message1(Pj, round.PartyID(), shares[j])
+		r2msg1 := NewKGRound2Message1(Pj, round.PartyID(), shares[j], facProofs[j])
 		// do not send to this Pj, but store for round 3
 		if j == i {
 			round.temp.kgRound2Message1s[j] = r2msg1
@@ -59,14 +59,16 @@ func (round *round3) Start() *tss.Error {
 		if i == PIdx {
 			continue
 		}
-		chs[i] = make(chan vssOut)
+		chs[i] = make(chan vssOut, 1)
 	}
+	verifiers := round.VerifierPool()
 	for j := range Ps {
 		if j == PIdx {
 			continue
 		}
 		// 6-8.
-		go func(j int, ch chan<- vssOut) {
+		j, ch := j, chs[j]
+		verifiers.Go(func() {
 			// 4-9.
 			KGCj := round.temp.KGCs[j]
 			r2msg2 := round.temp.kgRound2Message2s[j].Content().(*KGRound2Message2)
@@ -83,6 +85,13 @@ func (round *round3) Start() *tss.Error {
 				return
 			}
 			r2msg1 := round.temp.kgRound2Message1s[j].Content().(*KGRound2Message1)
+			// Pj's Paillier modulus has no small factors; the proof is made with our NTilde, h1, h2
+			if facProof, err := r2msg1.UnmarshalFacProof(); err != nil || !facProof.Verify(
+				round.save.PaillierPKs[j].N, round.save.NTildej[PIdx], round.save.H1j[PIdx], round.save.H2j[PIdx],
+				tss.EC().Params().N, Ps[j].KeyInt()) {
+				ch <- vssOut{errors.New("paillier no small factor proof verification failed"), nil}
+				return
+			}
 			PjShare := vss.Share{
 				Threshold: round.Threshold(),
 				ID:        round.PartyID().KeyInt(),
@@ -94,10 +103,10 @@ func (round *round3) Start() *tss.Error {
 			}
 			// (9) handled above
 			ch <- vssOut{nil, PjVs}
-		}(j, chs[j])
+		})
 	}
 
-	// consume unbuffered channels (end the goroutines)
+	// consume the channels (end the goroutines)
 	vssResults := make([]vssOut, len(Ps))
 	{
 		culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
@@ -10,7 +10,6 @@ import (
 	"errors"
 
 	"github.com/binance-chain/tss-lib/common"
-	"github.com/binance-chain/tss-lib/crypto/paillier"
 	"github.com/binance-chain/tss-lib/tss"
 )
 
@@ -32,14 +31,15 @@ func (round *round4) Start() *tss.Error {
 	r3msgs := round.temp.kgRound3Messages
 	chs := make([]chan bool, len(r3msgs))
 	for i := range chs {
-		chs[i] = make(chan bool)
+		chs[i] = make(chan bool, 1)
 	}
+	verifiers := round.VerifierPool()
 	for j, msg := range round.temp.kgRound3Messages {
 		if j == i {
 			continue
 		}
-		r3msg := msg.Content().(*KGRound3Message)
-		go func(prf paillier.Proof, j int, ch chan<- bool) {
+		j, prf, ch := j, msg.Content().(*KGRound3Message).UnmarshalProofInts(), chs[j]
+		verifiers.Go(func() {
 			ppk := round.save.PaillierPKs[j]
 			ok, err := prf.Verify(ppk.N, PIDs[j], ecdsaPub)
 			if err != nil {
@@ -48,10 +48,10 @@ func (round *round4) Start() *tss.Error {
 				return
 			}
 			ch <- ok
-		}(r3msg.UnmarshalProofInts(), j, chs[j])
+		})
 	}
 
-	// consume unbuffered channels (end the goroutines)
+	// consume the channels (end the goroutines)
 	for j, ch := range chs {
 		if j == i {
 			round.ok[j] = true
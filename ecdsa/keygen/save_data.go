@@ -74,6 +74,24 @@ func (preParams LocalPreParams) ValidateWithProof() bool {
 		preParams.Q != nil
 }
 
+// Zeroize overwrites the secret pre-parameters: the Paillier private key and the factors of NTildei
+func (preParams LocalPreParams) Zeroize() {
+	preParams.PaillierSK.Zeroize()
+	common.Zeroize(preParams.Alpha, preParams.Beta, preParams.P, preParams.Q)
+}
+
+// Zeroize overwrites the key share
+func (secrets LocalSecrets) Zeroize() {
+	common.Zeroize(secrets.Xi)
+}
+
+// Zeroize overwrites all of the secrets in the save data. Call it once the data has been persisted or is no longer
+// needed; values that were copied from the save data, e.g. by `BuildLocalSaveDataSubset`, share the same memory.
+func (save LocalPartySaveData) Zeroize() {
+	save.LocalPreParams.Zeroize()
+	save.LocalSecrets.Zeroize()
+}
+
 // BuildLocalSaveDataSubset re-creates the LocalPartySaveData to contain data for only the list of signing parties.
 func BuildLocalSaveDataSubset(sourceData LocalPartySaveData, sortedIDs tss.SortedPartyIDs) LocalPartySaveData {
 	keysToIndices := make(map[string]int, len(sourceData.Ks))
@@ -7,6 +7,7 @@
 package keygen
 
 import (
+	"crypto/elliptic"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -29,8 +30,9 @@ const (
 	TestThreshold    = test.TestParticipants / 2
 )
 const (
-	testFixtureDirFormat  = "%s/../../test/_ecdsa_fixtures"
-	testFixtureFileFormat = "keygen_data_%d.json"
+	testFixtureDirFormat     = "%s/../../test/_ecdsa_fixtures"
+	testP256FixtureDirFormat = "%s/../../test/_ecdsa_p256_fixtures"
+	testFixtureFileFormat    = "keygen_data_%d.json"
 )
 
 func LoadKeygenTestFixtures(qty int, optionalStart ...int) ([]LocalPartySaveData, tss.SortedPartyIDs, error) {
@@ -112,9 +114,14 @@ func LoadNTildeH1H2FromTestFixture(idx int) (NTildei, h1i, h2i *big.Int, err err
 	return
 }
 
+// makeTestFixtureFilePath returns the path of the fixture for the curve that is currently set with `tss.SetCurve`
 func makeTestFixtureFilePath(partyIndex int) string {
 	_, callerFileName, _, _ := runtime.Caller(0)
 	srcDirName := filepath.Dir(callerFileName)
-	fixtureDirName := fmt.Sprintf(testFixtureDirFormat, srcDirName)
+	dirFormat := testFixtureDirFormat
+	if tss.EC() == elliptic.P256() {
+		dirFormat = testP256FixtureDirFormat
+	}
+	fixtureDirName := fmt.Sprintf(dirFormat, srcDirName)
 	return fmt.Sprintf("%s/"+testFixtureFileFormat, fixtureDirName, partyIndex)
 }
@@ -20,7 +20,6 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
-//
 // The Round 1 data is broadcast to peers of the New Committee in this message.
 type DGRound1Message struct {
 	EcdsaPubX            []byte   `protobuf:"bytes,1,opt,name=ecdsa_pub_x,json=ecdsaPubX,proto3" json:"ecdsa_pub_x,omitempty"`
@@ -77,7 +76,6 @@ func (m *DGRound1Message) GetVCommitment() []byte {
 	return nil
 }
 
-//
 // The Round 2 data is broadcast to other peers of the New Committee in this message.
 type DGRound2Message1 struct {
 	PaillierN            []byte   `protobuf:"bytes,1,opt,name=paillier_n,json=paillierN,proto3" json:"paillier_n,omitempty"`
@@ -87,6 +85,8 @@ type DGRound2Message1 struct {
 	H2                   []byte   `protobuf:"bytes,5,opt,name=h2,proto3" json:"h2,omitempty"`
 	Dlnproof_1           [][]byte `protobuf:"bytes,6,rep,name=dlnproof_1,json=dlnproof1,proto3" json:"dlnproof_1,omitempty"`
 	Dlnproof_2           [][]byte `protobuf:"bytes,7,rep,name=dlnproof_2,json=dlnproof2,proto3" json:"dlnproof_2,omitempty"`
+	ModProof             [][]byte `protobuf:"bytes,8,rep,name=mod_proof,json=modProof,proto3" json:"mod_proof,omitempty"`
+	PrmProof             [][]byte `protobuf:"bytes,9,rep,name=prm_proof,json=prmProof,proto3" json:"prm_proof,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -166,7 +166,20 @@ func (m *DGRound2Message1) GetDlnproof_2() [][]byte {
 	return nil
 }
 
-//
+func (m *DGRound2Message1) GetModProof() [][]byte {
+	if m != nil {
+		return m.ModProof
+	}
+	return nil
+}
+
+func (m *DGRound2Message1) GetPrmProof() [][]byte {
+	if m != nil {
+		return m.PrmProof
+	}
+	return nil
+}
+
 // The Round 2 "ACK" is broadcast to peers of the Old Committee in this message.
 type DGRound2Message2 struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -199,7 +212,6 @@ func (m *DGRound2Message2) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DGRound2Message2 proto.InternalMessageInfo
 
-//
 // The Round 3 data is sent to peers of the New Committee in this message.
 type DGRound3Message1 struct {
 	Share                []byte   `protobuf:"bytes,1,opt,name=share,proto3" json:"share,omitempty"`
@@ -240,7 +252,6 @@ func (m *DGRound3Message1) GetShare() []byte {
 	return nil
 }
 
-//
 // The Round 3 data is broadcast to peers of the New Committee in this message.
 type DGRound3Message2 struct {
 	VDecommitment        [][]byte `protobuf:"bytes,1,rep,name=v_decommitment,json=vDecommitment,proto3" json:"v_decommitment,omitempty"`
@@ -281,38 +292,77 @@ func (m *DGRound3Message2) GetVDecommitment() [][]byte {
 	return nil
 }
 
-//
-// The Round 4 "ACK" is broadcast to peers of the Old and New Committees from the New Committee in this message.
-type DGRound4Message struct {
+// The Round 4 data is sent to each other peer of the New Committee in this message.
+type DGRound4Message1 struct {
+	FacProof             [][]byte `protobuf:"bytes,1,rep,name=fac_proof,json=facProof,proto3" json:"fac_proof,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DGRound4Message) Reset()         { *m = DGRound4Message{} }
-func (m *DGRound4Message) String() string { return proto.CompactTextString(m) }
-func (*DGRound4Message) ProtoMessage()    {}
-func (*DGRound4Message) Descriptor() ([]byte, []int) {
+func (m *DGRound4Message1) Reset()         { *m = DGRound4Message1{} }
+func (m *DGRound4Message1) String() string { return proto.CompactTextString(m) }
+func (*DGRound4Message1) ProtoMessage()    {}
+func (*DGRound4Message1) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f7d3ae1dc68dc295, []int{5}
 }
 
-func (m *DGRound4Message) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DGRound4Message.Unmarshal(m, b)
+func (m *DGRound4Message1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DGRound4Message1.Unmarshal(m, b)
+}
+func (m *DGRound4Message1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DGRound4Message1.Marshal(b, m, deterministic)
+}
+func (m *DGRound4Message1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DGRound4Message1.Merge(m, src)
+}
+func (m *DGRound4Message1) XXX_Size() int {
+	return xxx_messageInfo_DGRound4Message1.Size(m)
+}
+func (m *DGRound4Message1) XXX_DiscardUnknown() {
+	xxx_messageInfo_DGRound4Message1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DGRound4Message1 proto.InternalMessageInfo
+
+func (m *DGRound4Message1) GetFacProof() [][]byte {
+	if m != nil {
+		return m.FacProof
+	}
+	return nil
+}
+
+// The Round 4 "ACK" is broadcast to peers of the Old and New Committees from the New Committee in this message.
+type DGRound4Message2 struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DGRound4Message2) Reset()         { *m = DGRound4Message2{} }
+func (m *DGRound4Message2) String() string { return proto.CompactTextString(m) }
+func (*DGRound4Message2) ProtoMessage()    {}
+func (*DGRound4Message2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7d3ae1dc68dc295, []int{6}
+}
+
+func (m *DGRound4Message2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DGRound4Message2.Unmarshal(m, b)
 }
-func (m *DGRound4Message) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DGRound4Message.Marshal(b, m, deterministic)
+func (m *DGRound4Message2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DGRound4Message2.Marshal(b, m, deterministic)
 }
-func (m *DGRound4Message) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DGRound4Message.Merge(m, src)
+func (m *DGRound4Message2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DGRound4Message2.Merge(m, src)
 }
-func (m *DGRound4Message) XXX_Size() int {
-	return xxx_messageInfo_DGRound4Message.Size(m)
+func (m *DGRound4Message2) XXX_Size() int {
+	return xxx_messageInfo_DGRound4Message2.Size(m)
 }
-func (m *DGRound4Message) XXX_DiscardUnknown() {
-	xxx_messageInfo_DGRound4Message.DiscardUnknown(m)
+func (m *DGRound4Message2) XXX_DiscardUnknown() {
+	xxx_messageInfo_DGRound4Message2.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DGRound4Message proto.InternalMessageInfo
+var xxx_messageInfo_DGRound4Message2 proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*DGRound1Message)(nil), "DGRound1Message")
@@ -320,30 +370,34 @@ func init() {
 	proto.RegisterType((*DGRound2Message2)(nil), "DGRound2Message2")
 	proto.RegisterType((*DGRound3Message1)(nil), "DGRound3Message1")
 	proto.RegisterType((*DGRound3Message2)(nil), "DGRound3Message2")
-	proto.RegisterType((*DGRound4Message)(nil), "DGRound4Message")
+	proto.RegisterType((*DGRound4Message1)(nil), "DGRound4Message1")
+	proto.RegisterType((*DGRound4Message2)(nil), "DGRound4Message2")
 }
 
 func init() { proto.RegisterFile("protob/ecdsa-resharing.proto", fileDescriptor_f7d3ae1dc68dc295) }
 
 var fileDescriptor_f7d3ae1dc68dc295 = []byte{
-	// 303 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xcf, 0x4b, 0xc3, 0x30,
-	0x1c, 0xc5, 0x69, 0xe7, 0x3a, 0x96, 0xd5, 0xcd, 0x05, 0xc1, 0x1c, 0x54, 0x66, 0x40, 0xe8, 0x45,
-	0x47, 0x32, 0x2f, 0x5e, 0x75, 0xe0, 0x49, 0x19, 0xc5, 0x83, 0x7a, 0x09, 0xed, 0x1a, 0xd7, 0x42,
-	0x9b, 0x94, 0xfe, 0x42, 0xff, 0x4a, 0xff, 0x25, 0x69, 0x96, 0x86, 0x95, 0x1d, 0xdf, 0xe7, 0x7d,
-	0x9b, 0xc7, 0xeb, 0x03, 0x97, 0x79, 0x21, 0x2b, 0x19, 0x2e, 0xf9, 0x36, 0x2a, 0x83, 0xbb, 0x82,
-	0x97, 0x71, 0x50, 0x24, 0x62, 0x77, 0xaf, 0x30, 0xae, 0xc0, 0x6c, 0xfd, 0xe2, 0xcb, 0x5a, 0x44,
-	0xe4, 0x95, 0x97, 0x65, 0xb0, 0xe3, 0xf0, 0x1a, 0x4c, 0xd4, 0x2d, 0xcb, 0xeb, 0x90, 0xfd, 0x20,
-	0x6b, 0x61, 0x79, 0xae, 0x3f, 0x56, 0x68, 0x53, 0x87, 0x1f, 0x7d, 0xff, 0x17, 0xd9, 0x7d, 0xff,
-	0x13, 0xde, 0x00, 0xb7, 0x61, 0x5b, 0x99, 0x65, 0x49, 0x95, 0x71, 0x51, 0xa1, 0x81, 0x3a, 0x98,
-	0x34, 0xcf, 0x06, 0xe1, 0x3f, 0x0b, 0x9c, 0xe9, 0x58, 0xaa, 0x63, 0x09, 0xbc, 0x02, 0x20, 0x0f,
-	0x92, 0x34, 0x4d, 0x78, 0xc1, 0x44, 0x17, 0xdb, 0x91, 0x37, 0x78, 0x0b, 0xa6, 0xc6, 0xce, 0x0b,
-	0x29, 0xbf, 0x91, 0xbd, 0x18, 0x78, 0xae, 0x7f, 0xda, 0xd1, 0x4d, 0x0b, 0xe1, 0x05, 0x18, 0x09,
-	0x56, 0x25, 0x69, 0xc4, 0x75, 0xb0, 0x23, 0xde, 0x5b, 0x05, 0xa7, 0xc0, 0x8e, 0x09, 0x3a, 0x51,
-	0xcc, 0x8e, 0x89, 0xd2, 0x14, 0x0d, 0xb5, 0xa6, 0x6d, 0x7c, 0x94, 0x0a, 0xf5, 0x32, 0x23, 0xc8,
-	0x51, 0x6f, 0x8f, 0x3b, 0x42, 0x7a, 0x36, 0x45, 0xa3, 0xbe, 0x4d, 0x31, 0x3c, 0x2a, 0x44, 0xb1,
-	0x67, 0xd8, 0xca, 0x94, 0x3c, 0x07, 0xc3, 0x76, 0x00, 0xae, 0xfb, 0xed, 0x05, 0x7e, 0x3c, 0xba,
-	0xa4, 0x6d, 0xdf, 0x86, 0x45, 0xfc, 0xe0, 0x47, 0x5a, 0xfb, 0xbe, 0xcd, 0xfa, 0x00, 0xe2, 0xb9,
-	0x19, 0xf0, 0x41, 0x7f, 0xfa, 0x34, 0xff, 0x9a, 0xa9, 0x35, 0x96, 0x66, 0xec, 0xd0, 0x51, 0x6b,
-	0xaf, 0xfe, 0x03, 0x00, 0x00, 0xff, 0xff, 0xe8, 0x14, 0x8e, 0x99, 0x0d, 0x02, 0x00, 0x00,
+	// 348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0x5d, 0x4b, 0xfb, 0x30,
+	0x14, 0xc6, 0x59, 0xf7, 0xdf, 0x4b, 0xcf, 0xf6, 0xdf, 0x34, 0x08, 0x06, 0xa6, 0x32, 0x0b, 0xc2,
+	0x6e, 0x74, 0x34, 0xf3, 0xc6, 0x5b, 0x1d, 0x78, 0xa5, 0x8c, 0xe1, 0x85, 0x7a, 0x53, 0xfa, 0x92,
+	0x6d, 0x85, 0x26, 0x29, 0x69, 0x57, 0xf4, 0x23, 0xf8, 0xad, 0xa5, 0x69, 0x1a, 0x56, 0x77, 0x79,
+	0x7e, 0xcf, 0x39, 0x79, 0x0e, 0xcf, 0x09, 0x5c, 0xa4, 0x52, 0xe4, 0x22, 0x98, 0xd3, 0x30, 0xca,
+	0xfc, 0x5b, 0x49, 0xb3, 0x9d, 0x2f, 0x63, 0xbe, 0xbd, 0x53, 0xd8, 0xc9, 0x61, 0xbc, 0x7c, 0x5e,
+	0x8b, 0x3d, 0x8f, 0xdc, 0x17, 0x9a, 0x65, 0xfe, 0x96, 0xa2, 0x2b, 0x18, 0xa8, 0x5e, 0x2f, 0xdd,
+	0x07, 0xde, 0x17, 0x6e, 0x4d, 0x5b, 0xb3, 0xe1, 0xda, 0x56, 0x68, 0xb5, 0x0f, 0xde, 0x9b, 0xfa,
+	0x37, 0xb6, 0x9a, 0xfa, 0x07, 0xba, 0x86, 0x61, 0xe1, 0x85, 0x82, 0xb1, 0x38, 0x67, 0x94, 0xe7,
+	0xb8, 0xad, 0x1a, 0x06, 0xc5, 0x93, 0x41, 0xce, 0x8f, 0x05, 0x27, 0xda, 0x96, 0x68, 0x5b, 0x17,
+	0x5d, 0x02, 0xa4, 0x7e, 0x9c, 0x24, 0x31, 0x95, 0x1e, 0xaf, 0x6d, 0x6b, 0xf2, 0x8a, 0x6e, 0x60,
+	0x64, 0xe4, 0x54, 0x0a, 0xb1, 0xc1, 0xd6, 0xb4, 0x3d, 0x1b, 0xae, 0xff, 0xd7, 0x74, 0x55, 0x42,
+	0x74, 0x0e, 0x3d, 0xee, 0xe5, 0x71, 0x12, 0x51, 0x6d, 0xdc, 0xe5, 0x6f, 0x65, 0x85, 0x46, 0x60,
+	0xed, 0x5c, 0xfc, 0x4f, 0x31, 0x6b, 0xe7, 0xaa, 0x9a, 0xe0, 0x8e, 0xae, 0x49, 0x69, 0x1f, 0x25,
+	0x5c, 0xbd, 0xec, 0xb9, 0xb8, 0xab, 0xde, 0xb6, 0x6b, 0xe2, 0x36, 0x64, 0x82, 0x7b, 0x4d, 0x99,
+	0xa0, 0x09, 0xd8, 0x4c, 0x44, 0x7a, 0xb1, 0xbe, 0x52, 0xfb, 0x4c, 0x44, 0xd5, 0x4e, 0x13, 0xb0,
+	0x53, 0xc9, 0xb4, 0x68, 0x57, 0x62, 0x2a, 0x99, 0x12, 0x1d, 0x74, 0x14, 0x05, 0x71, 0x66, 0x86,
+	0x2d, 0x4c, 0x3c, 0x67, 0xd0, 0x29, 0x4f, 0x47, 0x75, 0x32, 0x55, 0xe1, 0x3c, 0x1c, 0x75, 0x92,
+	0x32, 0xa9, 0xc2, 0x8b, 0xe8, 0xc1, 0x09, 0x5a, 0x55, 0x52, 0xc5, 0xf2, 0x00, 0x3a, 0x73, 0x33,
+	0x7a, 0x6f, 0x4c, 0x26, 0x60, 0x6f, 0xfc, 0x50, 0x6f, 0x5a, 0x4d, 0xf5, 0x37, 0x7e, 0xf8, 0x77,
+	0xd3, 0x7a, 0x80, 0x3c, 0x9e, 0x7e, 0x8e, 0xd5, 0xe5, 0xe7, 0xe6, 0x63, 0x05, 0x5d, 0xf5, 0xb3,
+	0x16, 0xbf, 0x03, 0x00, 0x84, 0xaf, 0x05, 0xc1, 0x79, 0x02, 0x00, 0x00,
 }
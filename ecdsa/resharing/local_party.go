@@ -21,6 +21,7 @@ import (
 // Implements Party
 // Implements Stringer
 var _ tss.Party = (*LocalParty)(nil)
+var _ tss.Zeroizer = (*LocalParty)(nil)
 var _ fmt.Stringer = (*LocalParty)(nil)
 
 type (
@@ -42,7 +43,8 @@ type (
 		dgRound2Message2s,
 		dgRound3Message1s,
 		dgRound3Message2s,
-		dgRound4Messages []tss.ParsedMessage
+		dgRound4Message1s,
+		dgRound4Message2s []tss.ParsedMessage
 	}
 
 	localTempData struct {
@@ -64,6 +66,7 @@ type (
 // The `key` is read from and/or written to depending on whether this party is part of the old or the new committee.
 // You may optionally generate and set the LocalPreParams if you would like to use pre-generated safe primes and Paillier secret.
 // (This is similar to providing the `optionalPreParams` to `keygen.LocalParty`).
+// Otherwise they are taken from the pool set with `keygen.SetDefaultPreParamsPool`, or generated in round 2.
 func NewLocalParty(
 	params *tss.ReSharingParameters,
 	key keygen.LocalPartySaveData,
@@ -90,7 +93,8 @@ func NewLocalParty(
 	p.temp.dgRound2Message2s = make([]tss.ParsedMessage, params.NewPartyCount()) // "
 	p.temp.dgRound3Message1s = make([]tss.ParsedMessage, oldPartyCount)          // from t+1 of Old Committee
 	p.temp.dgRound3Message2s = make([]tss.ParsedMessage, oldPartyCount)          // "
-	p.temp.dgRound4Messages = make([]tss.ParsedMessage, params.NewPartyCount())  // from n of New Committee
+	p.temp.dgRound4Message1s = make([]tss.ParsedMessage, params.NewPartyCount()) // from n of New Committee
+	p.temp.dgRound4Message2s = make([]tss.ParsedMessage, params.NewPartyCount()) // "
 	// save data init
 	if key.LocalPreParams.ValidateWithProof() {
 		p.save.LocalPreParams = key.LocalPreParams
@@ -122,17 +126,10 @@ func (p *LocalParty) ValidateMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 	if ok, err := p.BaseParty.ValidateMessage(msg); !ok || err != nil {
 		return ok, err
 	}
-	// check that the message's "from index" will fit into the array
-	var maxFromIdx int
-	switch msg.Content().(type) {
-	case *DGRound2Message1, *DGRound2Message2, *DGRound4Message:
-		maxFromIdx = len(p.params.NewParties().IDs()) - 1
-	default:
-		maxFromIdx = len(p.params.OldParties().IDs()) - 1
-	}
-	if maxFromIdx < msg.GetFrom().Index {
-		return false, p.WrapError(fmt.Errorf("received msg with a sender index too great (%d <= %d)",
-			maxFromIdx, msg.GetFrom().Index), msg.GetFrom())
+	// check that the sender is a member of the committee that sends this type of message
+	if p.committeeIndexOf(msg) < 0 {
+		return false, p.WrapError(fmt.Errorf("received msg from a party that is not a member of the sending committee (%s)",
+			msg.GetFrom()), msg.GetFrom())
 	}
 	return true, nil
 }
@@ -142,7 +139,9 @@ func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 	if ok, err := p.ValidateMessage(msg); !ok || err != nil {
 		return ok, err
 	}
-	fromPIdx := msg.GetFrom().Index
+	// a party that is a member of both committees has a different index in each of them,
+	// so the sender's position is looked up in the committee that sends this type of message.
+	fromPIdx := p.committeeIndexOf(msg)
 
 	// switch/case is necessary to store any messages beyond current round
 	// this does not handle message replays. we expect the caller to apply replay and spoofing protection.
@@ -157,8 +156,10 @@ func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 		p.temp.dgRound3Message1s[fromPIdx] = msg
 	case *DGRound3Message2:
 		p.temp.dgRound3Message2s[fromPIdx] = msg
-	case *DGRound4Message:
-		p.temp.dgRound4Messages[fromPIdx] = msg
+	case *DGRound4Message1:
+		p.temp.dgRound4Message1s[fromPIdx] = msg
+	case *DGRound4Message2:
+		p.temp.dgRound4Message2s[fromPIdx] = msg
 	default: // unrecognised message, just ignore!
 		common.Logger.Warningf("unrecognised message ignored: %v", msg)
 		return false, nil
@@ -166,6 +167,24 @@ func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 	return true, nil
 }
 
+// committeeIndexOf returns the sender's position in the committee that sends this type of message, or -1
+func (p *LocalParty) committeeIndexOf(msg tss.ParsedMessage) int {
+	switch msg.Content().(type) {
+	case *DGRound2Message1, *DGRound2Message2, *DGRound4Message1, *DGRound4Message2:
+		return p.params.NewParties().IDs().IndexOfKey(msg.GetFrom().KeyInt())
+	default:
+		return p.params.OldParties().IDs().IndexOfKey(msg.GetFrom().KeyInt())
+	}
+}
+
+// Zeroize overwrites the shares dealt to the new committee, as well as the new key share if re-sharing did not finish
+func (p *LocalParty) Zeroize() {
+	p.temp.NewShares.Zeroize()
+	if p.Running() {
+		common.Zeroize(p.temp.newXi)
+	}
+}
+
 func (p *LocalParty) PartyID() *tss.PartyID {
 	return p.params.PartyID()
 }
@@ -231,3 +231,143 @@ signing:
 		}
 	}
 }
+
+func TestE2EConcurrentSameParties(t *testing.T) {
+	setUp("info")
+
+	// the same parties form both committees; only the threshold changes
+	threshold, newThreshold := testThreshold, testThreshold+1
+
+	// PHASE: load keygen fixtures
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 2)
+	assert.NoError(t, err, "should load keygen fixtures")
+	p2pCtx := tss.NewPeerContext(pIDs)
+	pCount := len(pIDs)
+
+	// PHASE: resharing
+	parties := make([]*LocalParty, 0, pCount)
+
+	errCh := make(chan *tss.Error, pCount)
+	outCh := make(chan tss.Message, pCount)
+	endCh := make(chan keygen.LocalPartySaveData, pCount)
+
+	updater := test.SharedPartyUpdater
+
+	for j, pID := range pIDs {
+		params := tss.NewReSharingParameters(p2pCtx, p2pCtx, pID, pCount, threshold, pCount, newThreshold)
+		assert.True(t, params.IsOldCommittee() && params.IsNewCommittee(), "party should be in both committees")
+		if j%2 == 1 {
+			// some parties prove their ring-Pedersen parameters with Πprm; the others accept both proof systems
+			params.SetRingPedersenProofs(tss.PrmProof, tss.DLNProofs)
+		}
+		P := NewLocalParty(params, keys[j], outCh, endCh).(*LocalParty)
+		parties = append(parties, P)
+	}
+	for _, P := range parties {
+		go func(P *LocalParty) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+
+	newKeys := make([]keygen.LocalPartySaveData, pCount)
+	var reSharingEnded int32
+	for {
+		select {
+		case err := <-errCh:
+			common.Logger.Errorf("Error: %s", err)
+			assert.FailNow(t, err.Error())
+			return
+
+		case msg := <-outCh:
+			dest := msg.GetTo()
+			if dest == nil {
+				t.Fatal("did not expect a msg to have a nil destination during resharing")
+			}
+			// each party plays both roles, so every message is delivered to its recipients exactly once
+			for _, destP := range dest {
+				if destP.Index == msg.GetFrom().Index {
+					continue
+				}
+				go updater(parties[destP.Index], msg, errCh)
+			}
+
+		case save := <-endCh:
+			assert.NotNil(t, save.Xi, "a party in both committees should receive a new share")
+			index, err := save.OriginalIndex()
+			assert.NoErrorf(t, err, "should not be an error getting a party's index from save data")
+			newKeys[index] = save
+			atomic.AddInt32(&reSharingEnded, 1)
+			if atomic.LoadInt32(&reSharingEnded) == int32(pCount) {
+				t.Logf("Resharing done. Reshared %d participants", reSharingEnded)
+
+				for j, key := range newKeys {
+					// xj test: BigXj == xj*G
+					gXj := crypto.ScalarBaseMult(tss.EC(), key.Xi)
+					assert.True(t, key.BigXj[j].Equals(gXj), "ensure BigX_j == g^x_j")
+					// the old shares must have been discarded
+					assert.Zero(t, keys[j].Xi.Sign(), "old share should be zeroed")
+				}
+				goto signing
+			}
+		}
+	}
+
+signing:
+	// PHASE: signing with all of the parties under the new threshold
+	signParties := make([]*signing.LocalParty, 0, pCount)
+
+	signErrCh := make(chan *tss.Error, pCount)
+	signOutCh := make(chan tss.Message, pCount)
+	signEndCh := make(chan common.SignatureData, pCount)
+
+	for j, signPID := range pIDs {
+		params := tss.NewParameters(p2pCtx, signPID, pCount, newThreshold)
+		P := signing.NewLocalParty(big.NewInt(42), params, newKeys[j], signOutCh, signEndCh).(*signing.LocalParty)
+		signParties = append(signParties, P)
+		go func(P *signing.LocalParty) {
+			if err := P.Start(); err != nil {
+				signErrCh <- err
+			}
+		}(P)
+	}
+
+	var signEnded int32
+	for {
+		select {
+		case err := <-signErrCh:
+			common.Logger.Errorf("Error: %s", err)
+			assert.FailNow(t, err.Error())
+			return
+
+		case msg := <-signOutCh:
+			dest := msg.GetTo()
+			if dest == nil {
+				for _, P := range signParties {
+					if P.PartyID().Index == msg.GetFrom().Index {
+						continue
+					}
+					go updater(P, msg, signErrCh)
+				}
+			} else {
+				go updater(signParties[dest[0].Index], msg, signErrCh)
+			}
+
+		case signData := <-signEndCh:
+			atomic.AddInt32(&signEnded, 1)
+			if atomic.LoadInt32(&signEnded) == int32(pCount) {
+				pk := ecdsa.PublicKey{
+					Curve: tss.EC(),
+					X:     newKeys[0].ECDSAPub.X(),
+					Y:     newKeys[0].ECDSAPub.Y(),
+				}
+				ok := ecdsa.Verify(&pk, big.NewInt(42).Bytes(),
+					new(big.Int).SetBytes(signData.R),
+					new(big.Int).SetBytes(signData.S))
+				assert.True(t, ok, "ecdsa verify must pass")
+				return
+			}
+		}
+	}
+}
@@ -16,6 +16,7 @@ import (
 	cmt "github.com/binance-chain/tss-lib/crypto/commitments"
 	"github.com/binance-chain/tss-lib/crypto/dlnproof"
 	"github.com/binance-chain/tss-lib/crypto/paillier"
+	"github.com/binance-chain/tss-lib/crypto/prmproof"
 	"github.com/binance-chain/tss-lib/crypto/vss"
 	"github.com/binance-chain/tss-lib/tss"
 )
@@ -30,6 +31,8 @@ var (
 		(*DGRound2Message2)(nil),
 		(*DGRound3Message1)(nil),
 		(*DGRound3Message2)(nil),
+		(*DGRound4Message1)(nil),
+		(*DGRound4Message2)(nil),
 	}
 )
 
@@ -39,6 +42,8 @@ func init() {
 	proto.RegisterType((*DGRound2Message2)(nil), tss.ECDSAProtoNamePrefix+"resharing.DGRound2Message2")
 	proto.RegisterType((*DGRound3Message1)(nil), tss.ECDSAProtoNamePrefix+"resharing.DGRound3Message1")
 	proto.RegisterType((*DGRound3Message2)(nil), tss.ECDSAProtoNamePrefix+"resharing.DGRound3Message2")
+	proto.RegisterType((*DGRound4Message1)(nil), tss.ECDSAProtoNamePrefix+"resharing.DGRound4Message1")
+	proto.RegisterType((*DGRound4Message2)(nil), tss.ECDSAProtoNamePrefix+"resharing.DGRound4Message2")
 }
 
 // ----- //
@@ -84,6 +89,8 @@ func (m *DGRound1Message) UnmarshalVCommitment() *big.Int {
 
 // ----- //
 
+// NewDGRound2Message1 makes the message of the new committee; either both DLN proofs or the Πprm proof should be
+// given, depending on the proof system that is used for the ring-Pedersen parameters
 func NewDGRound2Message1(
 	to []*tss.PartyID,
 	from *tss.PartyID,
@@ -91,6 +98,8 @@ func NewDGRound2Message1(
 	paillierPf paillier.Proof,
 	NTildei, H1i, H2i *big.Int,
 	dlnProof1, dlnProof2 *dlnproof.Proof,
+	prmProof *prmproof.Proof,
+	modProof *paillier.ModProof,
 ) (tss.ParsedMessage, error) {
 	meta := tss.MessageRouting{
 		From:             from,
@@ -99,22 +108,26 @@ func NewDGRound2Message1(
 		IsToOldCommittee: false,
 	}
 	paiPfBzs := common.BigIntsToBytes(paillierPf[:])
-	dlnProof1Bz, err := dlnProof1.Serialize()
-	if err != nil {
-		return nil, err
-	}
-	dlnProof2Bz, err := dlnProof2.Serialize()
-	if err != nil {
-		return nil, err
-	}
 	content := &DGRound2Message1{
 		PaillierN:     paillierPK.N.Bytes(),
 		PaillierProof: paiPfBzs,
 		NTilde:        NTildei.Bytes(),
 		H1:            H1i.Bytes(),
 		H2:            H2i.Bytes(),
-		Dlnproof_1:    dlnProof1Bz,
-		Dlnproof_2:    dlnProof2Bz,
+		ModProof:      modProof.Serialize(),
+	}
+	if prmProof != nil {
+		content.PrmProof = prmProof.Serialize()
+	} else {
+		dlnProof1Bz, err := dlnProof1.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		dlnProof2Bz, err := dlnProof2.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		content.Dlnproof_1, content.Dlnproof_2 = dlnProof1Bz, dlnProof2Bz
 	}
 	msg := tss.NewMessageWrapper(meta, content)
 	return tss.NewMessage(meta, content, msg), nil
@@ -127,11 +140,28 @@ func (m *DGRound2Message1) ValidateBasic() bool {
 		common.NonEmptyBytes(m.NTilde) &&
 		common.NonEmptyBytes(m.H1) &&
 		common.NonEmptyBytes(m.H2) &&
-		// expected len of dln proof = sizeof(int64) + len(alpha) + len(t)
-		common.NonEmptyMultiBytes(m.GetDlnproof_1(), 2+(dlnproof.Iterations*2)) &&
+		m.validateRingPedersenProof() &&
+		common.NonEmptyMultiBytes(m.GetModProof(), paillier.ModProofBytesParts)
+}
+
+func (m *DGRound2Message1) validateRingPedersenProof() bool {
+	if m.RingPedersenProof() == tss.PrmProof {
+		return len(m.GetDlnproof_1()) == 0 && len(m.GetDlnproof_2()) == 0 &&
+			common.NonEmptyMultiBytes(m.GetPrmProof(), prmproof.ProofBytesParts)
+	}
+	// expected len of dln proof = sizeof(int64) + len(alpha) + len(t)
+	return common.NonEmptyMultiBytes(m.GetDlnproof_1(), 2+(dlnproof.Iterations*2)) &&
 		common.NonEmptyMultiBytes(m.GetDlnproof_2(), 2+(dlnproof.Iterations*2))
 }
 
+// RingPedersenProof returns the proof system that the sender used for its ring-Pedersen parameters
+func (m *DGRound2Message1) RingPedersenProof() tss.RingPedersenProof {
+	if len(m.GetPrmProof()) > 0 {
+		return tss.PrmProof
+	}
+	return tss.DLNProofs
+}
+
 func (m *DGRound2Message1) UnmarshalPaillierPK() *paillier.PublicKey {
 	return &paillier.PublicKey{
 		N: new(big.Int).SetBytes(m.PaillierN),
@@ -165,6 +195,14 @@ func (m *DGRound2Message1) UnmarshalDLNProof2() (*dlnproof.Proof, error) {
 	return dlnproof.UnmarshalDLNProof(m.GetDlnproof_2())
 }
 
+func (m *DGRound2Message1) UnmarshalPrmProof() (*prmproof.Proof, error) {
+	return prmproof.UnmarshalProof(m.GetPrmProof())
+}
+
+func (m *DGRound2Message1) UnmarshalModProof() (*paillier.ModProof, error) {
+	return paillier.UnmarshalModProof(m.GetModProof())
+}
+
 // ----- //
 
 func NewDGRound2Message2(
@@ -244,7 +282,36 @@ func (m *DGRound3Message2) UnmarshalVDeCommitment() cmt.HashDeCommitment {
 
 // ----- //
 
-func NewDGRound4Message(
+func NewDGRound4Message1(
+	to *tss.PartyID,
+	from *tss.PartyID,
+	facProof *paillier.FacProof,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:             from,
+		To:               []*tss.PartyID{to},
+		IsBroadcast:      false,
+		IsToOldCommittee: false,
+	}
+	content := &DGRound4Message1{
+		FacProof: facProof.Serialize(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *DGRound4Message1) ValidateBasic() bool {
+	return m != nil &&
+		common.NonEmptyMultiBytes(m.GetFacProof(), paillier.FacProofBytesParts)
+}
+
+func (m *DGRound4Message1) UnmarshalFacProof() (*paillier.FacProof, error) {
+	return paillier.UnmarshalFacProof(m.GetFacProof())
+}
+
+// ----- //
+
+func NewDGRound4Message2(
 	to []*tss.PartyID,
 	from *tss.PartyID,
 ) tss.ParsedMessage {
@@ -254,11 +321,11 @@ func NewDGRound4Message(
 		IsBroadcast:             true,
 		IsToOldAndNewCommittees: true,
 	}
-	content := &DGRound4Message{}
+	content := &DGRound4Message2{}
 	msg := tss.NewMessageWrapper(meta, content)
 	return tss.NewMessage(meta, content, msg)
 }
 
-func (m *DGRound4Message) ValidateBasic() bool {
+func (m *DGRound4Message2) ValidateBasic() bool {
 	return true
 }
@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/binance-chain/tss-lib/common"
 	"github.com/binance-chain/tss-lib/crypto"
 	"github.com/binance-chain/tss-lib/crypto/commitments"
 	"github.com/binance-chain/tss-lib/crypto/vss"
@@ -33,13 +34,19 @@ func (round *round1) Start() *tss.Error {
 	round.resetOK() // resets both round.oldOK and round.newOK
 	round.allNewOK()
 
+	if err := keygen.CheckCurve(round.Params(), tss.EC()); err != nil {
+		return round.WrapError(err, round.PartyID())
+	}
+
 	if !round.ReSharingParams().IsOldCommittee() {
 		return nil
 	}
-	round.allOldOK()
+	// a party that is also a member of the new committee must still receive from the rest of the old committee
+	if !round.ReSharingParams().IsNewCommittee() {
+		round.allOldOK()
+	}
 
-	Pi := round.PartyID()
-	i := Pi.Index
+	i := round.OldPartyIndex()
 
 	// 1. PrepareForSigning() -> w_i
 	xi, ks, bigXj := round.input.Xi, round.input.Ks, round.input.BigXj
@@ -48,6 +55,7 @@ func (round *round1) Start() *tss.Error {
 	}
 	newKs := round.NewParties().IDs().Keys()
 	wi, _ := signing.PrepareForSigning(i, len(round.OldParties().IDs()), xi, ks, bigXj)
+	defer common.Zeroize(wi)
 
 	// 2.
 	vi, shares, err := vss.Create(round.NewThreshold(), wi, newKs)
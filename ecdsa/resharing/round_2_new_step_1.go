@@ -10,6 +10,7 @@ import (
 	"errors"
 
 	"github.com/binance-chain/tss-lib/crypto/dlnproof"
+	"github.com/binance-chain/tss-lib/crypto/prmproof"
 	"github.com/binance-chain/tss-lib/ecdsa/keygen"
 	"github.com/binance-chain/tss-lib/tss"
 )
@@ -28,7 +29,7 @@ func (round *round2) Start() *tss.Error {
 	}
 
 	Pi := round.PartyID()
-	i := Pi.Index
+	i := round.NewPartyIndex()
 
 	// 2. "broadcast" "ACK" members of the OLD committee
 	r2msg1 := NewDGRound2Message2(
@@ -41,6 +42,7 @@ func (round *round2) Start() *tss.Error {
 	// generate safe primes for ZKPs later on
 	// compute ntilde, h1, h2 (uses safe primes)
 	// use the pre-params if they were provided to the LocalParty constructor
+	// otherwise take them from the default PreParamsPool, if set, or generate them now
 	var preParams *keygen.LocalPreParams
 	if round.save.LocalPreParams.Validate() && !round.save.LocalPreParams.ValidateWithProof() {
 		return round.WrapError(
@@ -49,7 +51,7 @@ func (round *round2) Start() *tss.Error {
 		preParams = &round.save.LocalPreParams
 	} else {
 		var err error
-		preParams, err = keygen.GeneratePreParams(round.SafePrimeGenTimeout())
+		preParams, err = keygen.GetPreParams(round.SafePrimeGenTimeout())
 		if err != nil {
 			return round.WrapError(errors.New("pre-params generation failed"), Pi)
 		}
@@ -58,7 +60,7 @@ func (round *round2) Start() *tss.Error {
 	round.save.NTildej[i] = preParams.NTildei
 	round.save.H1j[i], round.save.H2j[i] = preParams.H1i, preParams.H2i
 
-	// generate the dlnproofs for resharing
+	// generate the dlnproofs for resharing, or the ring-Pedersen parameter proof if that is preferred
 	h1i, h2i, alpha, beta, p, q, NTildei :=
 		preParams.H1i,
 		preParams.H2i,
@@ -67,13 +69,27 @@ func (round *round2) Start() *tss.Error {
 		preParams.P,
 		preParams.Q,
 		preParams.NTildei
-	dlnProof1 := dlnproof.NewDLNProof(h1i, h2i, alpha, p, q, NTildei)
-	dlnProof2 := dlnproof.NewDLNProof(h2i, h1i, beta, p, q, NTildei)
+	var dlnProof1, dlnProof2 *dlnproof.Proof
+	var prmProof *prmproof.Proof
+	if round.RingPedersenProofs()[0] == tss.PrmProof {
+		var err error
+		if prmProof, err = prmproof.NewProof(h2i, h1i, alpha, p, q, NTildei, Pi.KeyInt()); err != nil {
+			return round.WrapError(err, Pi)
+		}
+	} else {
+		dlnProof1 = dlnproof.NewDLNProof(h1i, h2i, alpha, p, q, NTildei)
+		dlnProof2 = dlnproof.NewDLNProof(h2i, h1i, beta, p, q, NTildei)
+	}
+	// prove that the Paillier modulus is a product of two primes = 3 mod 4 (Πmod)
+	modProof, err := preParams.PaillierSK.ModProof(Pi.KeyInt())
+	if err != nil {
+		return round.WrapError(err, Pi)
+	}
 
 	paillierPf := preParams.PaillierSK.Proof(Pi.KeyInt(), round.save.ECDSAPub)
 	r2msg2, err := NewDGRound2Message1(
 		round.NewParties().IDs().Exclude(round.PartyID()), round.PartyID(),
-		&preParams.PaillierSK.PublicKey, paillierPf, preParams.NTildei, preParams.H1i, preParams.H2i, dlnProof1, dlnProof2)
+		&preParams.PaillierSK.PublicKey, paillierPf, preParams.NTildei, preParams.H1i, preParams.H2i, dlnProof1, dlnProof2, prmProof, modProof)
 	if err != nil {
 		return round.WrapError(err, Pi)
 	}
@@ -9,6 +9,7 @@ package resharing
 import (
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"math/big"
 	"sync"
 
@@ -17,7 +18,9 @@ import (
 	"github.com/binance-chain/tss-lib/common"
 	"github.com/binance-chain/tss-lib/crypto"
 	"github.com/binance-chain/tss-lib/crypto/commitments"
+	"github.com/binance-chain/tss-lib/crypto/paillier"
 	"github.com/binance-chain/tss-lib/crypto/vss"
+	"github.com/binance-chain/tss-lib/ecdsa/keygen"
 	"github.com/binance-chain/tss-lib/tss"
 )
 
@@ -37,21 +40,30 @@ func (round *round4) Start() *tss.Error {
 	}
 
 	Pi := round.PartyID()
-	i := Pi.Index
+	i := round.NewPartyIndex()
 
-	// 1-3. verify paillier & dln proofs, store message pieces, ensure uniqueness of h1j, h2j
+	// 1-3. verify paillier, modulus & dln (or ring-Pedersen parameter) proofs, store message pieces, ensure uniqueness of h1j, h2j
 	h1H2Map := make(map[string]struct{}, len(round.temp.dgRound2Message1s)*2)
 	paiProofCulprits := make([]*tss.PartyID, len(round.temp.dgRound2Message1s)) // who caused the error(s)
+	prmProofFailCulprits := make([]*tss.PartyID, len(round.temp.dgRound2Message1s))
+	modProofCulprits := make([]*tss.PartyID, len(round.temp.dgRound2Message1s))
 	dlnProof1FailCulprits := make([]*tss.PartyID, len(round.temp.dgRound2Message1s))
 	dlnProof2FailCulprits := make([]*tss.PartyID, len(round.temp.dgRound2Message1s))
 	wg := new(sync.WaitGroup)
+	verifiers := round.VerifierPool()
 	for j, msg := range round.temp.dgRound2Message1s {
+		j, msg := j, msg
 		r2msg1 := msg.Content().(*DGRound2Message1)
 		paiPK, NTildej, H1j, H2j :=
 			r2msg1.UnmarshalPaillierPK(),
 			r2msg1.UnmarshalNTilde(),
 			r2msg1.UnmarshalH1(),
 			r2msg1.UnmarshalH2()
+		if j != i {
+			if err := keygen.CheckPeerModuli(round.Params(), paiPK, NTildej, H1j, H2j); err != nil {
+				return round.WrapError(err, msg.GetFrom())
+			}
+		}
 		if H1j.Cmp(H2j) == 0 {
 			return round.WrapError(errors.New("h1j and h2j were equal for this party"), msg.GetFrom())
 		}
@@ -63,28 +75,54 @@ func (round *round4) Start() *tss.Error {
 			return round.WrapError(errors.New("this h2j was already used by another party"), msg.GetFrom())
 		}
 		h1H2Map[h1JHex], h1H2Map[h2JHex] = struct{}{}, struct{}{}
-		wg.Add(3)
-		go func(j int, msg tss.ParsedMessage, r2msg1 *DGRound2Message1) {
+		proof := r2msg1.RingPedersenProof()
+		if !round.AcceptsRingPedersenProof(proof) {
+			return round.WrapError(fmt.Errorf("ring-Pedersen proof system %s is not accepted", proof), msg.GetFrom())
+		}
+		if j != i {
+			wg.Add(1)
+			verifiers.Go(func() {
+				if modProof, err := r2msg1.UnmarshalModProof(); err != nil || !modProof.Verify(paiPK.N, msg.GetFrom().KeyInt()) {
+					modProofCulprits[j] = msg.GetFrom()
+					common.Logger.Warningf("paillier modulus proof verify failed for party %s", msg.GetFrom(), err)
+				}
+				wg.Done()
+			})
+		}
+		wg.Add(1)
+		verifiers.Go(func() {
 			if ok, err := r2msg1.UnmarshalPaillierProof().Verify(paiPK.N, msg.GetFrom().KeyInt(), round.save.ECDSAPub); err != nil || !ok {
 				paiProofCulprits[j] = msg.GetFrom()
 				common.Logger.Warningf("paillier verify failed for party %s", msg.GetFrom(), err)
 			}
 			wg.Done()
-		}(j, msg, r2msg1)
-		go func(j int, msg tss.ParsedMessage, r2msg1 *DGRound2Message1, H1j, H2j, NTildej *big.Int) {
+		})
+		if proof == tss.PrmProof {
+			wg.Add(1)
+			verifiers.Go(func() {
+				if prmProof, err := r2msg1.UnmarshalPrmProof(); err != nil || !prmProof.Verify(H2j, H1j, NTildej, msg.GetFrom().KeyInt()) {
+					prmProofFailCulprits[j] = msg.GetFrom()
+					common.Logger.Warningf("ring-Pedersen parameter proof verify failed for party %s", msg.GetFrom(), err)
+				}
+				wg.Done()
+			})
+			continue
+		}
+		wg.Add(2)
+		verifiers.Go(func() {
 			if dlnProof1, err := r2msg1.UnmarshalDLNProof1(); err != nil || !dlnProof1.Verify(H1j, H2j, NTildej) {
 				dlnProof1FailCulprits[j] = msg.GetFrom()
 				common.Logger.Warningf("dln proof 1 verify failed for party %s", msg.GetFrom(), err)
 			}
 			wg.Done()
-		}(j, msg, r2msg1, H1j, H2j, NTildej)
-		go func(j int, msg tss.ParsedMessage, r2msg1 *DGRound2Message1, H1j, H2j, NTildej *big.Int) {
+		})
+		verifiers.Go(func() {
 			if dlnProof2, err := r2msg1.UnmarshalDLNProof2(); err != nil || !dlnProof2.Verify(H2j, H1j, NTildej) {
 				dlnProof2FailCulprits[j] = msg.GetFrom()
 				common.Logger.Warningf("dln proof 2 verify failed for party %s", msg.GetFrom(), err)
 			}
 			wg.Done()
-		}(j, msg, r2msg1, H1j, H2j, NTildej)
+		})
 	}
 	wg.Wait()
 	for _, culprit := range append(append(paiProofCulprits, dlnProof1FailCulprits...), dlnProof2FailCulprits...) {
@@ -92,6 +130,16 @@ func (round *round4) Start() *tss.Error {
 			return round.WrapError(errors.New("dln proof verification failed"), culprit)
 		}
 	}
+	for _, culprit := range prmProofFailCulprits {
+		if culprit != nil {
+			return round.WrapError(errors.New("ring-Pedersen parameter proof verification failed"), culprit)
+		}
+	}
+	for _, culprit := range modProofCulprits {
+		if culprit != nil {
+			return round.WrapError(errors.New("paillier modulus proof verification failed"), culprit)
+		}
+	}
 	// save NTilde_j, h1_j, h2_j received in NewCommitteeStep1 here
 	for j, msg := range round.temp.dgRound2Message1s {
 		if j == i {
@@ -103,6 +151,33 @@ func (round *round4) Start() *tss.Error {
 		round.save.H2j[j] = new(big.Int).SetBytes(r2msg1.H2)
 	}
 
+	// prove to each other member of the new committee that our Paillier modulus has no small factors (Πfac);
+	// each proof is made with the recipient's ring-Pedersen parameters, which we have only just received
+	facProofs := make([]*paillier.FacProof, round.NewPartyCount())
+	facProofErrs := make([]error, round.NewPartyCount())
+	for j := range facProofs {
+		if j == i {
+			continue
+		}
+		wg.Add(1)
+		go func(j int) {
+			defer wg.Done()
+			facProofs[j], facProofErrs[j] = round.save.PaillierSK.FacProof(
+				round.save.NTildej[j], round.save.H1j[j], round.save.H2j[j], tss.EC().Params().N, Pi.KeyInt())
+		}(j)
+	}
+	wg.Wait()
+	for j, facProof := range facProofs {
+		if j == i {
+			continue
+		}
+		if facProofErrs[j] != nil {
+			return round.WrapError(facProofErrs[j], Pi)
+		}
+		r4msg1 := NewDGRound4Message1(round.NewParties().IDs()[j], Pi, facProof)
+		round.out <- r4msg1
+	}
+
 	// 4.
 	newXi := big.NewInt(0)
 
@@ -191,15 +266,20 @@ func (round *round4) Start() *tss.Error {
 	round.temp.newBigXjs = newBigXjs
 
 	// Send an "ACK" message to both committees to signal that we're ready to save our data
-	r4msg := NewDGRound4Message(round.OldAndNewParties(), Pi)
-	round.temp.dgRound4Messages[i] = r4msg
-	round.out <- r4msg
+	r4msg2 := NewDGRound4Message2(round.OldAndNewParties(), Pi)
+	round.temp.dgRound4Message2s[i] = r4msg2
+	round.out <- r4msg2
 
 	return nil
 }
 
 func (round *round4) CanAccept(msg tss.ParsedMessage) bool {
-	if _, ok := msg.Content().(*DGRound4Message); ok {
+	if round.ReSharingParams().IsNewCommittee() {
+		if _, ok := msg.Content().(*DGRound4Message1); ok {
+			return !msg.IsBroadcast()
+		}
+	}
+	if _, ok := msg.Content().(*DGRound4Message2); ok {
 		return msg.IsBroadcast()
 	}
 	return false
@@ -207,13 +287,20 @@ func (round *round4) CanAccept(msg tss.ParsedMessage) bool {
 
 func (round *round4) Update() (bool, *tss.Error) {
 	// accept messages from new -> old&new committees
-	for j, msg := range round.temp.dgRound4Messages {
+	for j, msg := range round.temp.dgRound4Message2s {
 		if round.newOK[j] {
 			continue
 		}
 		if msg == nil || !round.CanAccept(msg) {
 			return false, nil
 		}
+		// accept the P2P Πfac messages from new -> new committee
+		if round.ReSharingParams().IsNewCommittee() && j != round.NewPartyIndex() {
+			msg1 := round.temp.dgRound4Message1s[j]
+			if msg1 == nil || !round.CanAccept(msg1) {
+				return false, nil
+			}
+		}
 		round.newOK[j] = true
 	}
 	return true, nil
@@ -8,7 +8,9 @@ package resharing
 
 import (
 	"errors"
+	"sync"
 
+	"github.com/binance-chain/tss-lib/common"
 	"github.com/binance-chain/tss-lib/tss"
 )
 
@@ -22,10 +24,38 @@ func (round *round5) Start() *tss.Error {
 	round.allOldOK()
 	round.allNewOK()
 
-	Pi := round.PartyID()
-	i := Pi.Index
+	i := round.NewPartyIndex()
 
 	if round.IsNewCommittee() {
+		// verify the no small factor proofs (Πfac) that were made with our ring-Pedersen parameters
+		facProofCulprits := make([]*tss.PartyID, len(round.temp.dgRound4Message1s))
+		wg := new(sync.WaitGroup)
+		verifiers := round.VerifierPool()
+		for j, msg := range round.temp.dgRound4Message1s {
+			if j == i {
+				continue
+			}
+			j, msg := j, msg
+			wg.Add(1)
+			verifiers.Go(func() {
+				defer wg.Done()
+				Pj := msg.GetFrom()
+				paiPK := round.temp.dgRound2Message1s[j].Content().(*DGRound2Message1).UnmarshalPaillierPK()
+				facProof, err := msg.Content().(*DGRound4Message1).UnmarshalFacProof()
+				if err != nil || !facProof.Verify(paiPK.N, round.save.NTildej[i], round.save.H1j[i], round.save.H2j[i],
+					tss.EC().Params().N, Pj.KeyInt()) {
+					facProofCulprits[j] = Pj
+					common.Logger.Warningf("paillier no small factor proof verify failed for party %s", Pj, err)
+				}
+			})
+		}
+		wg.Wait()
+		for _, culprit := range facProofCulprits {
+			if culprit != nil {
+				return round.WrapError(errors.New("paillier no small factor proof verification failed"), culprit)
+			}
+		}
+
 		// 21.
 		// for this P: SAVE data
 		round.save.BigXj = round.temp.newBigXjs
@@ -41,8 +71,10 @@ func (round *round5) Start() *tss.Error {
 			r2msg1 := msg.Content().(*DGRound2Message1)
 			round.save.PaillierPKs[j] = r2msg1.UnmarshalPaillierPK()
 		}
-	} else if round.IsOldCommittee() {
-		round.input.Xi.SetInt64(0)
+	}
+	if round.IsOldCommittee() {
+		// the old share is discarded, even by a party that is also a member of the new committee
+		round.input.LocalSecrets.Zeroize()
 	}
 
 	round.end <- *round.save
@@ -36,39 +36,48 @@ func (round *finalization) Start() *tss.Error {
 		sumS = modN.Add(sumS, r9msg.UnmarshalS())
 	}
 
+	// the signature's r is the x coordinate of R reduced mod N.
+	// the recovery ID encodes which of the (up to) four points with that r is R:
+	// bit 0 is set when R.Y is odd and bit 1 when R.X >= N, i.e. the reduction changed it.
+	N := tss.EC().Params().N
+	r := new(big.Int).Mod(round.temp.rx, N)
 	recid := 0
-	// byte v = if(R.X > curve.N) then 2 else 0) | (if R.Y.IsEven then 0 else 1);
-	if round.temp.rx.Cmp(tss.EC().Params().N) > 0 {
+	if round.temp.rx.Cmp(N) >= 0 {
 		recid = 2
 	}
 	if round.temp.ry.Bit(0) != 0 {
 		recid |= 1
 	}
 
-	// This is copied from:
-	// https://github.com/btcsuite/btcd/blob/c26ffa870fd817666a857af1bf6498fabba1ffe3/btcec/signature.go#L442-L444
-	// This is needed because of tendermint checks here:
+	// Low-S normalization is needed by Bitcoin and by the tendermint checks here:
 	// https://github.com/tendermint/tendermint/blob/d9481e3648450cb99e15c6a070c1fb69aa0c255b/crypto/secp256k1/secp256k1_nocgo.go#L43-L47
-	secp256k1halfN := new(big.Int).Rsh(tss.EC().Params().N, 1)
-	if sumS.Cmp(secp256k1halfN) > 0 {
-		sumS.Sub(tss.EC().Params().N, sumS)
-		recid ^= 1
+	// (N - s, r) is the signature for the nonce -k whose point -R has the opposite Y parity, so bit 0 of the recovery ID flips.
+	if round.SNormalization() == tss.LowS {
+		halfN := new(big.Int).Rsh(N, 1)
+		if sumS.Cmp(halfN) > 0 {
+			sumS = new(big.Int).Sub(N, sumS)
+			recid ^= 1
+		}
 	}
 
 	// save the signature for final output
-	bitSizeInBytes := tss.EC().Params().BitSize / 8
-	round.data.R = padToLengthBytesInPlace(round.temp.rx.Bytes(), bitSizeInBytes)
+	bitSizeInBytes := (N.BitLen() + 7) / 8
+	round.data.R = padToLengthBytesInPlace(r.Bytes(), bitSizeInBytes)
 	round.data.S = padToLengthBytesInPlace(sumS.Bytes(), bitSizeInBytes)
 	round.data.Signature = append(round.data.R, round.data.S...)
 	round.data.SignatureRecovery = []byte{byte(recid)}
 	round.data.M = round.temp.m.Bytes()
+	if round.temp.isRawMsg {
+		round.data.M = round.temp.digest
+	}
 
 	pk := ecdsa.PublicKey{
 		Curve: tss.EC(),
 		X:     round.key.ECDSAPub.X(),
 		Y:     round.key.ECDSAPub.Y(),
 	}
-	ok := ecdsa.Verify(&pk, round.temp.m.Bytes(), round.temp.rx, sumS)
+	// ecdsa.Verify applies the same FIPS 186-4 truncation to the digest
+	ok := ecdsa.Verify(&pk, round.data.M, r, sumS)
 	if !ok {
 		return round.WrapError(fmt.Errorf("signature verification failed"))
 	}
@@ -15,6 +15,7 @@ import (
 	"github.com/binance-chain/tss-lib/crypto"
 	cmt "github.com/binance-chain/tss-lib/crypto/commitments"
 	"github.com/binance-chain/tss-lib/crypto/mta"
+	"github.com/binance-chain/tss-lib/crypto/paillier"
 	"github.com/binance-chain/tss-lib/ecdsa/keygen"
 	"github.com/binance-chain/tss-lib/tss"
 )
@@ -22,6 +23,7 @@ import (
 // Implements Party
 // Implements Stringer
 var _ tss.Party = (*LocalParty)(nil)
+var _ tss.Zeroizer = (*LocalParty)(nil)
 var _ fmt.Stringer = (*LocalParty)(nil)
 
 type (
@@ -54,6 +56,27 @@ type (
 	localTempData struct {
 		localMessageStore
 
+		// why the parties cannot sign with the key, see CheckSigners; reported by prepare()
+		signersErr error
+
+		// optional BIP32 child key or additive tweak of the key to sign for
+		derivationPath *keygen.DerivationPath
+		tweak          *big.Int
+
+		// the raw message and its hash function when `NewLocalPartyWithMessage` is used; `digest` is computed in prepare()
+		rawMsg   []byte
+		hashFunc HashFunc
+		isRawMsg bool
+		digest   []byte
+
+		// the precomputed Paillier private key, and the optional pools of encryption randomness, see prepare()
+		paillierSK      *paillier.PrivateKey
+		randomnessPools []*paillier.RandomnessPool
+
+		// when set, k_i and gamma_i are derived from the share, the message and this session ID, see SetDeterministicNonces
+		nonceSessionID    []byte
+		nonceSessionStore SessionIDStore
+
 		// temp data (thrown away after sign) / round 1
 		w,
 		m,
@@ -93,12 +116,16 @@ type (
 	}
 )
 
+// Exported, used in `tss` client
+// You may optionally provide a non-hardened BIP32 `DerivationPath` to sign for a child key of `key.ECDSAPub`.
+// In that case the signature verifies under the child public key, see `keygen.LocalPartySaveData.DeriveChildKey`.
 func NewLocalParty(
 	msg *big.Int,
 	params *tss.Parameters,
 	key keygen.LocalPartySaveData,
 	out chan<- tss.Message,
 	end chan<- common.SignatureData,
+	optionalDerivationPath ...keygen.DerivationPath,
 ) tss.Party {
 	partyCount := len(params.Parties().IDs())
 	p := &LocalParty{
@@ -122,6 +149,13 @@ func NewLocalParty(
 	p.temp.signRound8Messages = make([]tss.ParsedMessage, partyCount)
 	p.temp.signRound9Messages = make([]tss.ParsedMessage, partyCount)
 	// temp data init
+	p.temp.signersErr = CheckSigners(key, params.Threshold(), params.Parties().IDs())
+	if 0 < len(optionalDerivationPath) {
+		if 1 < len(optionalDerivationPath) {
+			panic(errors.New("signing.NewLocalParty expected 0 or 1 item in `optionalDerivationPath`"))
+		}
+		p.temp.derivationPath = &optionalDerivationPath[0]
+	}
 	p.temp.m = msg
 	p.temp.cis = make([]*big.Int, partyCount)
 	p.temp.bigWs = make([]*crypto.ECPoint, partyCount)
@@ -134,6 +168,59 @@ func NewLocalParty(
 	return p
 }
 
+// NewLocalPartyWithTweak returns a party that signs under the tweaked public key `key.ECDSAPub + tweak*G`.
+// This is used for e.g. Taproot output keys and pay-to-contract; see `TweakPublicKey`. All signers must use the same tweak.
+// With a `DerivationPath` the tweak applies to the child key: the signature verifies under `child + tweak*G`.
+func NewLocalPartyWithTweak(
+	msg *big.Int,
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	tweak *big.Int,
+	out chan<- tss.Message,
+	end chan<- common.SignatureData,
+	optionalDerivationPath ...keygen.DerivationPath,
+) tss.Party {
+	p := NewLocalParty(msg, params, key, out, end, optionalDerivationPath...).(*LocalParty)
+	p.temp.tweak = tweak
+	return p
+}
+
+// NewLocalPartyWithMessage returns a party that hashes the raw message `msg` with `hashFunc` and signs the digest.
+// The digest is converted to an integer following FIPS 186-4 (truncated to the bit length of the curve order), and
+// the exact digest bytes are returned in `SignatureData.M`.
+func NewLocalPartyWithMessage(
+	msg []byte,
+	hashFunc HashFunc,
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	out chan<- tss.Message,
+	end chan<- common.SignatureData,
+	optionalDerivationPath ...keygen.DerivationPath,
+) tss.Party {
+	p := NewLocalParty(nil, params, key, out, end, optionalDerivationPath...).(*LocalParty)
+	p.temp.rawMsg = msg
+	p.temp.hashFunc = hashFunc
+	p.temp.isRawMsg = true
+	return p
+}
+
+// SetRandomnessPools gives the party pools of Paillier encryption randomness for its own key, which is used in
+// round 1, and for the keys of the other signers, which are used in round 2. Call it before Start.
+func (p *LocalParty) SetRandomnessPools(pools ...*paillier.RandomnessPool) {
+	p.temp.randomnessPools = pools
+}
+
+// SetDeterministicNonces makes the party derive k_i and gamma_i of round 1 with a PRF keyed by its share of the key,
+// over `sessionID`, the message, the signers and the public key, instead of drawing them at random. The same inputs
+// give the same R (and signature), e.g. for an auditor that recomputes a signature. Call it before Start, with a
+// non-empty session ID that every signer uses.
+// Start records the session ID in `store` and fails with ErrSessionIDReused if it was recorded before, so a session
+// that is aborted must be retried with a new session ID.
+func (p *LocalParty) SetDeterministicNonces(sessionID []byte, store SessionIDStore) {
+	p.temp.nonceSessionID = sessionID
+	p.temp.nonceSessionStore = store
+}
+
 func (p *LocalParty) FirstRound() tss.Round {
 	return newRound1(p.params, &p.keys, &p.data, &p.temp, p.out, p.end)
 }
@@ -144,10 +231,7 @@ func (p *LocalParty) Start() *tss.Error {
 		if !ok {
 			return round.WrapError(errors.New("unable to Start(). party is in an unexpected round"))
 		}
-		if err := round1.prepare(); err != nil {
-			return round.WrapError(err)
-		}
-		return nil
+		return round1.prepare()
 	})
 }
 
@@ -212,6 +296,15 @@ func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 	return true, nil
 }
 
+// Zeroize overwrites the secrets of the signing session: the additive share wi, the nonce shares k and gamma, the
+// MtA shares and the masks of the last phase. The partial signature si is not secret once it has been broadcast.
+func (p *LocalParty) Zeroize() {
+	common.Zeroize(p.temp.w, p.temp.k, p.temp.gamma, p.temp.sigma, p.temp.li, p.temp.roi)
+	common.Zeroize(p.temp.betas...)
+	common.Zeroize(p.temp.vs...)
+	p.temp.paillierSK.Zeroize()
+}
+
 func (p *LocalParty) PartyID() *tss.PartyID {
 	return p.params.PartyID()
 }
@@ -8,16 +8,22 @@ package signing
 
 import (
 	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"fmt"
 	"math/big"
-	"runtime"
-	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/btcsuite/btcd/btcec"
 	"github.com/ipfs/go-log"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+	"github.com/binance-chain/tss-lib/crypto/mta"
+	"github.com/binance-chain/tss-lib/crypto/paillier"
+	"github.com/binance-chain/tss-lib/crypto/schnorr"
 	"github.com/binance-chain/tss-lib/ecdsa/keygen"
 	"github.com/binance-chain/tss-lib/test"
 	"github.com/binance-chain/tss-lib/tss"
@@ -34,18 +40,18 @@ func setUp(level string) {
 	}
 }
 
-func TestE2EConcurrent(t *testing.T) {
-	setUp("info")
-	threshold := testThreshold
-
-	// PHASE: load keygen fixtures
-	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
-	assert.NoError(t, err, "should load keygen fixtures")
-	assert.Equal(t, testThreshold+1, len(keys))
-	assert.Equal(t, testThreshold+1, len(signPIDs))
+// newSigningParty creates the party of one signer of runSigning, which has already created its parameters
+type newSigningParty func(params *tss.Parameters, key keygen.LocalPartySaveData, out chan<- tss.Message, end chan<- common.SignatureData) tss.Party
 
-	// PHASE: signing
-	// use a shuffled selection of the list of parties for this test
+// runSigning runs a signing between the parties of `signPIDs`, creating each one with `newParty`, and delivers every
+// message to its recipients. Once every party has finished, `verify` is given the parties and the signature data.
+func runSigning(
+	t *testing.T,
+	keys []keygen.LocalPartySaveData,
+	signPIDs tss.SortedPartyIDs,
+	newParty newSigningParty,
+	verify func(parties []*LocalParty, data common.SignatureData),
+) {
 	p2pCtx := tss.NewPeerContext(signPIDs)
 	parties := make([]*LocalParty, 0, len(signPIDs))
 
@@ -57,9 +63,9 @@ func TestE2EConcurrent(t *testing.T) {
 
 	// init the parties
 	for i := 0; i < len(signPIDs); i++ {
-		params := tss.NewParameters(p2pCtx, signPIDs[i], len(signPIDs), threshold)
+		params := tss.NewParameters(p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
 
-		P := NewLocalParty(big.NewInt(42), params, keys[i], outCh, endCh).(*LocalParty)
+		P := newParty(params, keys[i], outCh, endCh).(*LocalParty)
 		parties = append(parties, P)
 		go func(P *LocalParty) {
 			if err := P.Start(); err != nil {
@@ -68,15 +74,11 @@ func TestE2EConcurrent(t *testing.T) {
 		}(P)
 	}
 
-	var ended int32
-signing:
-	for {
-		fmt.Printf("ACTIVE GOROUTINES: %d\n", runtime.NumGoroutine())
+	for ended := 0; ended < len(signPIDs); {
 		select {
 		case err := <-errCh:
 			common.Logger.Errorf("Error: %s", err)
 			assert.FailNow(t, err.Error())
-			break signing
 
 		case msg := <-outCh:
 			dest := msg.GetTo()
@@ -94,42 +96,339 @@ signing:
 				go updater(parties[dest[0].Index], msg, errCh)
 			}
 
-		case <-endCh:
-			atomic.AddInt32(&ended, 1)
-			if atomic.LoadInt32(&ended) == int32(len(signPIDs)) {
+		case data := <-endCh:
+			if ended++; ended == len(signPIDs) {
 				t.Logf("Done. Received signature data from %d participants", ended)
-				R := parties[0].temp.bigR
-				r := parties[0].temp.rx
-				fmt.Printf("sign result: R(%s, %s), r=%s\n", R.X().String(), R.Y().String(), r.String())
+				verify(parties, data)
+			}
+		}
+	}
+}
 
-				modN := common.ModInt(tss.EC().Params().N)
+func TestE2EConcurrent(t *testing.T) {
+	setUp("info")
 
-				// BEGIN check s correctness
-				sumS := big.NewInt(0)
-				for _, p := range parties {
-					sumS = modN.Add(sumS, p.temp.si)
-				}
-				fmt.Printf("S: %s\n", sumS.String())
-				// END check s correctness
-
-				// BEGIN ECDSA verify
-				pkX, pkY := keys[0].ECDSAPub.X(), keys[0].ECDSAPub.Y()
-				pk := ecdsa.PublicKey{
-					Curve: tss.EC(),
-					X:     pkX,
-					Y:     pkY,
-				}
-				ok := ecdsa.Verify(&pk, big.NewInt(42).Bytes(), R.X(), sumS)
-				assert.True(t, ok, "ecdsa verify must pass")
-				t.Log("ECDSA signing test done.")
-				// END ECDSA verify
+	// PHASE: load keygen fixtures
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	assert.Equal(t, testThreshold+1, len(keys))
+	assert.Equal(t, testThreshold+1, len(signPIDs))
+
+	// PHASE: signing
+	// use a shuffled selection of the list of parties for this test
+	newParty := func(params *tss.Parameters, key keygen.LocalPartySaveData, out chan<- tss.Message, end chan<- common.SignatureData) tss.Party {
+		return NewLocalParty(big.NewInt(42), params, key, out, end)
+	}
+	runSigning(t, keys, signPIDs, newParty, func(parties []*LocalParty, _ common.SignatureData) {
+		R := parties[0].temp.bigR
+		r := parties[0].temp.rx
+		fmt.Printf("sign result: R(%s, %s), r=%s\n", R.X().String(), R.Y().String(), r.String())
+
+		modN := common.ModInt(tss.EC().Params().N)
+
+		// BEGIN check s correctness
+		sumS := big.NewInt(0)
+		for _, p := range parties {
+			sumS = modN.Add(sumS, p.temp.si)
+		}
+		fmt.Printf("S: %s\n", sumS.String())
+		// END check s correctness
 
-				break signing
+		// BEGIN ECDSA verify
+		pkX, pkY := keys[0].ECDSAPub.X(), keys[0].ECDSAPub.Y()
+		pk := ecdsa.PublicKey{
+			Curve: tss.EC(),
+			X:     pkX,
+			Y:     pkY,
+		}
+		ok := ecdsa.Verify(&pk, big.NewInt(42).Bytes(), R.X(), sumS)
+		assert.True(t, ok, "ecdsa verify must pass")
+		t.Log("ECDSA signing test done.")
+		// END ECDSA verify
+	})
+}
+
+func TestInvalidCiphertextCulprit(t *testing.T) {
+	setUp("info")
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(2, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	errCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan common.SignatureData, len(signPIDs))
+
+	parties := make([]*LocalParty, 0, len(signPIDs))
+	for i := range signPIDs {
+		params := tss.NewParameters(p2pCtx, signPIDs[i], len(signPIDs), 1)
+		P := NewLocalParty(big.NewInt(42), params, keys[i], outCh, endCh).(*LocalParty)
+		parties = append(parties, P)
+		go func(P *LocalParty) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+	for {
+		select {
+		case err := <-errCh:
+			assert.Equal(t, 2, err.Round())
+			assert.Equal(t, signPIDs[0], err.Victim())
+			assert.Equal(t, []*tss.PartyID{signPIDs[1]}, err.Culprits())
+			assert.Contains(t, err.Error(), mta.ErrInvalidCiphertextA.Error())
+			return
+		case msg := <-outCh:
+			dest := msg.GetTo()
+			if dest == nil {
+				go test.SharedPartyUpdater(parties[1-msg.GetFrom().Index], msg, errCh)
+				continue
 			}
+			// party 1 sends a multiple of its Paillier modulus as cA, which is not a valid ciphertext
+			if r1msg, ok := msg.(tss.ParsedMessage).Content().(*SignRound1Message1); ok && msg.GetFrom().Index == 1 {
+				pf, err := r1msg.UnmarshalRangeProofAlice()
+				assert.NoError(t, err)
+				msg = NewSignRound1Message1(dest[0], msg.GetFrom(), keys[1].PaillierSK.N, pf)
+			}
+			go test.SharedPartyUpdater(parties[dest[0].Index], msg, errCh)
+		case <-endCh:
+			t.Fatal("signing should not complete")
+		}
+	}
+}
+
+func TestInvalidGammaProofCulprit(t *testing.T) {
+	setUp("info")
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(3, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	errCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan common.SignatureData, len(signPIDs))
+
+	parties := make([]*LocalParty, 0, len(signPIDs))
+	for i := range signPIDs {
+		params := tss.NewParameters(p2pCtx, signPIDs[i], len(signPIDs), 2)
+		// a single worker must be enough
+		params.SetVerifierPool(tss.NewVerifierPool(1))
+		P := NewLocalParty(big.NewInt(42), params, keys[i], outCh, endCh).(*LocalParty)
+		parties = append(parties, P)
+		go func(P *LocalParty) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+	for victims := 0; victims < 2; {
+		select {
+		case err := <-errCh:
+			// the batch of party 0 or 1 fails and the proofs are then verified one by one
+			assert.Equal(t, 5, err.Round())
+			assert.NotEqual(t, signPIDs[2], err.Victim())
+			assert.Equal(t, []*tss.PartyID{signPIDs[2]}, err.Culprits())
+			victims++
+		case msg := <-outCh:
+			// party 2 proves the knowledge of the discrete logarithm of another point than Gamma_2
+			if r4msg, ok := msg.(tss.ParsedMessage).Content().(*SignRound4Message); ok && msg.GetFrom().Index == 2 {
+				x := common.GetRandomPositiveInt(tss.EC().Params().N)
+				pf, err := schnorr.NewZKProof(x, crypto.ScalarBaseMult(tss.EC(), x))
+				assert.NoError(t, err)
+				msg = NewSignRound4Message(msg.GetFrom(), r4msg.UnmarshalDeCommitment(), pf)
+			}
+			dest := msg.GetTo()
+			if dest == nil {
+				for _, P := range parties {
+					if P.PartyID().Index != msg.GetFrom().Index {
+						go test.SharedPartyUpdater(P, msg, errCh)
+					}
+				}
+				continue
+			}
+			go test.SharedPartyUpdater(parties[dest[0].Index], msg, errCh)
+		case <-endCh:
+			t.Fatal("signing should not complete")
 		}
 	}
 }
 
+func TestE2EConcurrentWithDerivation(t *testing.T) {
+	setUp("info")
+
+	// PHASE: load keygen fixtures
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	path := testDerivationPath(t)
+	_, childKey, err := keys[0].DeriveChildKey(path)
+	assert.NoError(t, err, "should derive the child key")
+	assert.False(t, childKey.PublicKey.Equals(keys[0].ECDSAPub))
+	xi0 := new(big.Int).Set(keys[0].Xi)
+
+	// PHASE: signing
+	newParty := func(params *tss.Parameters, key keygen.LocalPartySaveData, out chan<- tss.Message, end chan<- common.SignatureData) tss.Party {
+		return NewLocalParty(big.NewInt(42), params, key, out, end, path)
+	}
+	runSigning(t, keys, signPIDs, newParty, func(_ []*LocalParty, data common.SignatureData) {
+		// the signature verifies under the child key only
+		assertVerifiesOnlyUnder(t, data, big.NewInt(42).Bytes(), childKey.PublicKey, keys[0].ECDSAPub)
+
+		// the caller's key data is left untouched
+		assert.Zero(t, xi0.Cmp(keys[0].Xi), "the share of the master key should not be modified")
+	})
+}
+
+func TestE2EConcurrentWithTweak(t *testing.T) {
+	setUp("info")
+
+	// PHASE: load keygen fixtures
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	tweak := common.GetRandomPositiveInt(tss.EC().Params().N)
+	tweakedPub, err := TweakPublicKey(keys[0].ECDSAPub, tweak)
+	assert.NoError(t, err)
+
+	// PHASE: signing
+	newParty := func(params *tss.Parameters, key keygen.LocalPartySaveData, out chan<- tss.Message, end chan<- common.SignatureData) tss.Party {
+		return NewLocalPartyWithTweak(big.NewInt(42), params, key, tweak, out, end)
+	}
+	runSigning(t, keys, signPIDs, newParty, func(_ []*LocalParty, data common.SignatureData) {
+		// the signature verifies under the tweaked key only
+		assertVerifiesOnlyUnder(t, data, big.NewInt(42).Bytes(), tweakedPub, keys[0].ECDSAPub)
+	})
+}
+
+func TestE2EConcurrentWithDerivationAndTweak(t *testing.T) {
+	setUp("info")
+
+	// PHASE: load keygen fixtures
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	path := testDerivationPath(t)
+	_, childKey, err := keys[0].DeriveChildKey(path)
+	assert.NoError(t, err, "should derive the child key")
+	tweak := common.GetRandomPositiveInt(tss.EC().Params().N)
+	tweakedChildPub, err := TweakPublicKey(childKey.PublicKey, tweak)
+	assert.NoError(t, err)
+
+	// PHASE: signing
+	newParty := func(params *tss.Parameters, key keygen.LocalPartySaveData, out chan<- tss.Message, end chan<- common.SignatureData) tss.Party {
+		return NewLocalPartyWithTweak(big.NewInt(42), params, key, tweak, out, end, path)
+	}
+	runSigning(t, keys, signPIDs, newParty, func(_ []*LocalParty, data common.SignatureData) {
+		// the tweak applies on top of the child key, and neither replaces the other
+		assertVerifiesOnlyUnder(t, data, big.NewInt(42).Bytes(), tweakedChildPub, childKey.PublicKey)
+		tweakedPub, err := TweakPublicKey(keys[0].ECDSAPub, tweak)
+		assert.NoError(t, err)
+		assertVerifiesOnlyUnder(t, data, big.NewInt(42).Bytes(), tweakedChildPub, tweakedPub)
+	})
+}
+
+func TestE2EConcurrentWithMessage(t *testing.T) {
+	setUp("info")
+
+	// PHASE: load keygen fixtures
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	msg := []byte("\x19Ethereum Signed Message:\n5hello")
+	digest, _ := HashKeccak256.Digest(msg)
+
+	// PHASE: signing
+	newParty := func(params *tss.Parameters, key keygen.LocalPartySaveData, out chan<- tss.Message, end chan<- common.SignatureData) tss.Party {
+		return NewLocalPartyWithMessage(msg, HashKeccak256, params, key, out, end)
+	}
+	runSigning(t, keys, signPIDs, newParty, func(_ []*LocalParty, data common.SignatureData) {
+		assert.Equal(t, digest, data.M, "M must be the exact digest")
+		pk := ecdsa.PublicKey{
+			Curve: tss.EC(),
+			X:     keys[0].ECDSAPub.X(),
+			Y:     keys[0].ECDSAPub.Y(),
+		}
+		r, s := new(big.Int).SetBytes(data.R), new(big.Int).SetBytes(data.S)
+		assert.True(t, ecdsa.Verify(&pk, digest, r, s), "ecdsa verify must pass")
+	})
+}
+
+func TestE2EConcurrentRawS(t *testing.T) {
+	setUp("info")
+
+	// PHASE: load keygen fixtures
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	msg := []byte("raw S")
+	digest, _ := HashSHA256.Digest(msg)
+
+	// PHASE: signing
+	newParty := func(params *tss.Parameters, key keygen.LocalPartySaveData, out chan<- tss.Message, end chan<- common.SignatureData) tss.Party {
+		params.SetSNormalization(tss.RawS)
+		return NewLocalPartyWithMessage(msg, HashSHA256, params, key, out, end)
+	}
+	runSigning(t, keys, signPIDs, newParty, func(_ []*LocalParty, data common.SignatureData) {
+		// S is either half with equal probability; the recovery ID must match it in both cases
+		pk := keys[0].ECDSAPub
+		compact := append([]byte{27 + data.SignatureRecovery[0]}, data.Signature...)
+		recovered, _, err := btcec.RecoverCompact(btcec.S256(), compact, digest)
+		assert.NoError(t, err)
+		assert.Equal(t, pk.X(), recovered.X)
+		assert.Equal(t, pk.Y(), recovered.Y)
+
+		r, s := new(big.Int).SetBytes(data.R), new(big.Int).SetBytes(data.S)
+		pub := ecdsa.PublicKey{Curve: tss.EC(), X: pk.X(), Y: pk.Y()}
+		assert.True(t, ecdsa.Verify(&pub, digest, r, s), "ecdsa verify must pass")
+		t.Logf("signed with a high S: %v", s.Cmp(new(big.Int).Rsh(tss.EC().Params().N, 1)) > 0)
+	})
+}
+
+func TestE2EConcurrentP256(t *testing.T) {
+	setUp("info")
+	tss.SetCurve(elliptic.P256())
+	defer tss.SetCurve(btcec.S256())
+
+	// PHASE: load keygen fixtures
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	msg := []byte("P-256")
+	digest, _ := HashSHA256.Digest(msg)
+
+	// PHASE: signing
+	newParty := func(params *tss.Parameters, key keygen.LocalPartySaveData, out chan<- tss.Message, end chan<- common.SignatureData) tss.Party {
+		return NewLocalPartyWithMessage(msg, HashSHA256, params, key, out, end)
+	}
+	runSigning(t, keys, signPIDs, newParty, func(_ []*LocalParty, data common.SignatureData) {
+		assert.Len(t, data.Signature, 64)
+		pk := ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     keys[0].ECDSAPub.X(),
+			Y:     keys[0].ECDSAPub.Y(),
+		}
+		r, s := new(big.Int).SetBytes(data.R), new(big.Int).SetBytes(data.S)
+		assert.True(t, ecdsa.Verify(&pk, digest, r, s), "ecdsa verify must pass")
+	})
+}
+
+// testDerivationPath returns a BIP32 path with a random chain code, on which the parties must agree
+func testDerivationPath(t *testing.T) keygen.DerivationPath {
+	chainCode := make([]byte, 32)
+	_, err := rand.Read(chainCode)
+	assert.NoError(t, err)
+	return keygen.DerivationPath{ChainCode: chainCode, Indices: []uint32{44, 60, 0, 0, 5}}
+}
+
+// assertVerifiesOnlyUnder checks that the signature of `digest` verifies under `pub` and not under `other`
+func assertVerifiesOnlyUnder(t *testing.T, data common.SignatureData, digest []byte, pub, other *crypto.ECPoint) {
+	pk := ecdsa.PublicKey{
+		Curve: tss.EC(),
+		X:     pub.X(),
+		Y:     pub.Y(),
+	}
+	r, s := new(big.Int).SetBytes(data.R), new(big.Int).SetBytes(data.S)
+	assert.True(t, ecdsa.Verify(&pk, digest, r, s), "ecdsa verify must pass for the expected key")
+	pk.X, pk.Y = other.X(), other.Y()
+	assert.False(t, ecdsa.Verify(&pk, digest, r, s), "ecdsa verify must fail for another key")
+}
+
 func TestFillTo32BytesInPlace(t *testing.T) {
 	s := big.NewInt(123456789)
 	normalizedS := padToLengthBytesInPlace(s.Bytes(), 32)
@@ -137,3 +436,73 @@ func TestFillTo32BytesInPlace(t *testing.T) {
 	assert.Equal(t, 32, len(normalizedS))
 	assert.NotEqual(t, 32, len(s.Bytes()))
 }
+
+// BenchmarkMtARounds2And3 measures the MtA work of rounds 2 and 3 for one pair of signers: Bob_mid and Bob_mid_wc
+// under Alice's key, then Alice_end and Alice_end_wc. A party does this 2(n-1) times per signature.
+func BenchmarkMtARounds2And3(b *testing.B) {
+	keys, _, err := keygen.LoadKeygenTestFixtures(2)
+	if err != nil {
+		b.Fatal(err)
+	}
+	alice, bob := keys[0], keys[1]
+	q := tss.EC().Params().N
+	k, gamma, w := common.GetRandomPositiveInt(q), common.GetRandomPositiveInt(q), common.GetRandomPositiveInt(q)
+	bigW := crypto.ScalarBaseMult(tss.EC(), w)
+	cA, pf, err := mta.AliceInit(alice.PaillierPKs[0], k, bob.NTildei, bob.H1i, bob.H2i)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	run := func(b *testing.B, pkA *paillier.PublicKey, skA *paillier.PrivateKey) {
+		for i := 0; i < b.N; i++ {
+			_, c1, _, pi1, err := mta.BobMid(pkA, pf, gamma, cA, alice.NTildei, alice.H1i, alice.H2i, bob.NTildei, bob.H1i, bob.H2i)
+			if err != nil {
+				b.Fatal(err)
+			}
+			_, c2, _, pi2, err := mta.BobMidWC(pkA, pf, w, cA, alice.NTildei, alice.H1i, alice.H2i, bob.NTildei, bob.H1i, bob.H2i, bigW)
+			if err != nil {
+				b.Fatal(err)
+			}
+			if _, err = mta.AliceEnd(pkA, pi1, alice.H1i, alice.H2i, cA, c1, alice.NTildei, skA); err != nil {
+				b.Fatal(err)
+			}
+			if _, err = mta.AliceEndWC(pkA, pi2, bigW, cA, c2, alice.NTildei, alice.H1i, alice.H2i, skA); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+	b.Run("LambdaN", func(b *testing.B) {
+		// decryption by LambdaN mod N^2, as before the CRT
+		sk := &paillier.PrivateKey{PublicKey: alice.PaillierSK.PublicKey, LambdaN: alice.PaillierSK.LambdaN}
+		run(b, alice.PaillierPKs[0], sk)
+	})
+	b.Run("CRT", func(b *testing.B) {
+		run(b, alice.PaillierPKs[0], alice.PaillierSK)
+	})
+	b.Run("Precomputed", func(b *testing.B) {
+		sk, err := alice.PaillierSK.Precomputed()
+		if err != nil {
+			b.Fatal(err)
+		}
+		run(b, alice.PaillierPKs[0].Precomputed(), sk)
+	})
+	b.Run("Pooled", func(b *testing.B) {
+		sk, err := alice.PaillierSK.Precomputed()
+		if err != nil {
+			b.Fatal(err)
+		}
+		// 4 encryptions with Alice's key per iteration: betaPrm and the proof of each of Bob_mid and Bob_mid_wc
+		pool := paillier.NewRandomnessPool(alice.PaillierPKs[0], 4*b.N)
+		pool.Start()
+		defer pool.Stop()
+		for pool.Ready() < 4*b.N {
+			time.Sleep(10 * time.Millisecond)
+		}
+		pk, err := alice.PaillierPKs[0].WithRandomnessPool(pool)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.ResetTimer()
+		run(b, pk, sk)
+	})
+}
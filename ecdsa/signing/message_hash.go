@@ -0,0 +1,84 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// HashFunc selects how a raw message passed to `NewLocalPartyWithMessage` is hashed before it is signed
+type HashFunc int
+
+const (
+	// HashNone signs the message as given; it must already be a digest
+	HashNone HashFunc = iota
+	// HashSHA256 is used by e.g. Cosmos SDK chains
+	HashSHA256
+	// HashKeccak256 is the original Keccak-256 (not FIPS 202 SHA3-256) used by Ethereum
+	HashKeccak256
+	// HashDoubleSHA256 is SHA-256(SHA-256(m)) used by Bitcoin
+	HashDoubleSHA256
+)
+
+func (h HashFunc) String() string {
+	switch h {
+	case HashNone:
+		return "none"
+	case HashSHA256:
+		return "SHA-256"
+	case HashKeccak256:
+		return "Keccak-256"
+	case HashDoubleSHA256:
+		return "double-SHA-256"
+	default:
+		return fmt.Sprintf("HashFunc(%d)", int(h))
+	}
+}
+
+// Digest returns the digest of `msg` that is signed
+func (h HashFunc) Digest(msg []byte) ([]byte, error) {
+	switch h {
+	case HashNone:
+		if len(msg) == 0 {
+			return nil, errors.New("the message digest is empty")
+		}
+		return msg, nil
+	case HashSHA256:
+		digest := sha256.Sum256(msg)
+		return digest[:], nil
+	case HashKeccak256:
+		keccak := sha3.NewLegacyKeccak256()
+		keccak.Write(msg)
+		return keccak.Sum(nil), nil
+	case HashDoubleSHA256:
+		first := sha256.Sum256(msg)
+		digest := sha256.Sum256(first[:])
+		return digest[:], nil
+	default:
+		return nil, fmt.Errorf("unsupported hash function: %s", h)
+	}
+}
+
+// hashToInt converts a digest to the integer that is signed, following FIPS 186-4 section 6.4:
+// the leftmost min(bitlen(N), bitlen(digest)) bits are used and the result is reduced mod N.
+func hashToInt(digest []byte, N *big.Int) *big.Int {
+	orderBits := N.BitLen()
+	orderBytes := (orderBits + 7) / 8
+	if len(digest) > orderBytes {
+		digest = digest[:orderBytes]
+	}
+	e := new(big.Int).SetBytes(digest)
+	if excess := len(digest)*8 - orderBits; excess > 0 {
+		e.Rsh(e, uint(excess))
+	}
+	return e.Mod(e, N)
+}
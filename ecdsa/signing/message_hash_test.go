@@ -0,0 +1,49 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashFuncDigest(t *testing.T) {
+	cases := []struct {
+		h         HashFunc
+		msg       string
+		expectHex string
+	}{
+		{HashSHA256, "abc", "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"},
+		{HashKeccak256, "", "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"},
+		{HashDoubleSHA256, "hello", "9595c9df90075148eb06860365df33584b75bff782a510c6cd4883a419833d50"},
+		{HashNone, "\x00\x01", "0001"},
+	}
+	for _, c := range cases {
+		digest, err := c.h.Digest([]byte(c.msg))
+		assert.NoError(t, err, c.h.String())
+		assert.Equal(t, c.expectHex, hex.EncodeToString(digest), c.h.String())
+	}
+	_, err := HashNone.Digest(nil)
+	assert.Error(t, err)
+	_, err = HashFunc(42).Digest([]byte("abc"))
+	assert.Error(t, err)
+}
+
+func TestHashToInt(t *testing.T) {
+	// a digest that is longer than the order is truncated to its leftmost bits
+	N := big.NewInt(1021) // 10 bits
+	e := hashToInt([]byte{0xff, 0xc0, 0x12}, N)
+	assert.Equal(t, int64(0x3ff%1021), e.Int64())
+
+	// a short digest keeps its leading zero bytes out of the integer but is otherwise unchanged
+	N, _ = new(big.Int).SetString("fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141", 16)
+	e = hashToInt([]byte{0x00, 0x00, 0x01}, N)
+	assert.Equal(t, int64(1), e.Int64())
+}
@@ -0,0 +1,108 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"hash"
+	"math/big"
+	"sync"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+const (
+	// separates the nonces from any other use of the share as an HMAC key
+	nonceDomain = "tss-lib/ecdsa/signing/nonce/v1"
+
+	noncePurposeK     = byte('k')
+	noncePurposeGamma = byte('g')
+)
+
+// ErrSessionIDReused is returned when deterministic nonces are requested for a session ID that the key has already
+// derived nonces for
+var ErrSessionIDReused = errors.New("the session ID has already been used with this key")
+
+type (
+	// SessionIDStore records the session IDs that a key has derived deterministic nonces for, so that no session ID is
+	// signed with twice. A session that fails still uses up its session ID: a co-signer that aborts and retries with
+	// other nonces of its own would otherwise get partial signatures with the same k_i under another R.
+	// An implementation should persist the session IDs, so that they are still refused after a restart.
+	SessionIDStore interface {
+		// Use records `sessionID` for the share `shareID` of the key `pub`, or returns ErrSessionIDReused if it has
+		// been recorded before
+		Use(pub *crypto.ECPoint, shareID *big.Int, sessionID []byte) error
+	}
+
+	// MemorySessionIDStore is a SessionIDStore that keeps the session IDs in memory, for the lifetime of the process
+	MemorySessionIDStore struct {
+		mtx  sync.Mutex
+		used map[string]struct{}
+	}
+)
+
+// NewMemorySessionIDStore returns an empty store
+func NewMemorySessionIDStore() *MemorySessionIDStore {
+	return &MemorySessionIDStore{used: make(map[string]struct{})}
+}
+
+func (store *MemorySessionIDStore) Use(pub *crypto.ECPoint, shareID *big.Int, sessionID []byte) error {
+	if pub == nil || shareID == nil {
+		return errors.New("MemorySessionIDStore.Use() received a nil key")
+	}
+	id := string(common.SHA512_256(pub.X().Bytes(), pub.Y().Bytes(), shareID.Bytes(), sessionID))
+	store.mtx.Lock()
+	defer store.mtx.Unlock()
+	if _, found := store.used[id]; found {
+		return ErrSessionIDReused
+	}
+	store.used[id] = struct{}{}
+	return nil
+}
+
+// ----- //
+
+// deriveNonce derives k_i or gamma_i of round 1 from the share x_i, in the manner of RFC 6979: it is HMAC-SHA512 keyed
+// by x_i over the session ID, the message and the public values that the signature depends on, i.e. the signers and
+// the (tweaked) public key. The 512-bit output is reduced mod N, with a negligible bias.
+// Any change to these inputs gives unrelated nonces, and the same inputs give the same nonces and thus the same R.
+func (round *round1) deriveNonce(purpose byte) *big.Int {
+	N := tss.EC().Params().N
+	byteLen := (N.BitLen() + 7) / 8
+	for counter := uint32(0); ; counter++ {
+		mac := hmac.New(sha512.New, padToLengthBytesInPlace(round.key.Xi.Bytes(), byteLen))
+		writeNonceInput(mac, []byte(nonceDomain))
+		writeNonceInput(mac, []byte{purpose})
+		writeNonceInput(mac, round.temp.nonceSessionID)
+		writeNonceInput(mac, padToLengthBytesInPlace(round.temp.m.Bytes(), byteLen))
+		for _, Pj := range round.Parties().IDs() {
+			writeNonceInput(mac, Pj.GetKey())
+		}
+		writeNonceInput(mac, padToLengthBytesInPlace(round.key.ECDSAPub.X().Bytes(), byteLen))
+		writeNonceInput(mac, padToLengthBytesInPlace(round.key.ECDSAPub.Y().Bytes(), byteLen))
+		var counterBz [4]byte
+		binary.BigEndian.PutUint32(counterBz[:], counter)
+		mac.Write(counterBz[:])
+		// zero has a probability of about 2^-256, but is not a valid nonce
+		if nonce := new(big.Int).Mod(new(big.Int).SetBytes(mac.Sum(nil)), N); nonce.Sign() != 0 {
+			return nonce
+		}
+	}
+}
+
+// writeNonceInput writes `bz` prefixed with its length, so that the inputs cannot be shifted into each other
+func writeNonceInput(mac hash.Hash, bz []byte) {
+	var lenBz [4]byte
+	binary.BigEndian.PutUint32(lenBz[:], uint32(len(bz)))
+	mac.Write(lenBz[:])
+	mac.Write(bz)
+}
@@ -0,0 +1,74 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/ecdsa/keygen"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+// signWithSessionID runs a signing of `msg` by the first threshold+1 fixtures with deterministic nonces. Each run
+// records the session ID in fresh stores, as an auditor that recomputes the signature would.
+func signWithSessionID(t *testing.T, msg *big.Int, sessionID []byte) common.SignatureData {
+	keys, signPIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 1)
+	assert.NoError(t, err, "should load keygen fixtures")
+	var data common.SignatureData
+	newParty := func(params *tss.Parameters, key keygen.LocalPartySaveData, out chan<- tss.Message, end chan<- common.SignatureData) tss.Party {
+		P := NewLocalParty(msg, params, key, out, end).(*LocalParty)
+		P.SetDeterministicNonces(sessionID, NewMemorySessionIDStore())
+		return P
+	}
+	runSigning(t, keys, signPIDs, newParty, func(_ []*LocalParty, last common.SignatureData) {
+		data = last
+	})
+	return data
+}
+
+func TestDeterministicNonces(t *testing.T) {
+	setUp("info")
+	msg := big.NewInt(42)
+
+	first := signWithSessionID(t, msg, []byte("session 1"))
+	again := signWithSessionID(t, msg, []byte("session 1"))
+	assert.Equal(t, first.R, again.R, "the same inputs must give the same R")
+	assert.Equal(t, first.Signature, again.Signature)
+
+	other := signWithSessionID(t, msg, []byte("session 2"))
+	assert.NotEqual(t, first.R, other.R, "another session ID must give another R")
+}
+
+func TestDeterministicNoncesSessionIDs(t *testing.T) {
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 1)
+	assert.NoError(t, err, "should load keygen fixtures")
+	params := tss.NewParameters(tss.NewPeerContext(pIDs), pIDs[0], len(pIDs), testThreshold)
+	start := func(sessionID []byte, store SessionIDStore) *tss.Error {
+		P := NewLocalParty(big.NewInt(42), params, keys[0], make(chan tss.Message, 2*len(pIDs)), make(chan common.SignatureData, 1)).(*LocalParty)
+		P.SetDeterministicNonces(sessionID, store)
+		return P.Start()
+	}
+
+	store := NewMemorySessionIDStore()
+	assert.Nil(t, start([]byte("session 1"), store))
+	// e.g. a retry after a co-signer aborted the session
+	tssErr := start([]byte("session 1"), store)
+	if assert.NotNil(t, tssErr) {
+		assert.Equal(t, ErrSessionIDReused, errors.Cause(tssErr.Cause()))
+	}
+	assert.Nil(t, start([]byte("session 2"), store))
+	// the session IDs are recorded per share, so another signer may use the same one
+	assert.NoError(t, store.Use(keys[1].ECDSAPub, keys[1].ShareID, []byte("session 1")))
+
+	assert.NotNil(t, start([]byte{}, NewMemorySessionIDStore()), "the session ID must not be empty")
+	assert.NotNil(t, start([]byte("session 3"), nil), "the store is required")
+}
@@ -16,7 +16,9 @@ import (
 )
 
 // PrepareForSigning(), GG18Spec (11) Fig. 14
-func PrepareForSigning(i, pax int, xi *big.Int, ks []*big.Int, bigXs []*crypto.ECPoint) (wi *big.Int, bigWs []*crypto.ECPoint) {
+// An optional additive `tweak` t may be provided to sign under the public key y + t*G (see `TweakPublicKey`).
+// It is added to xi and to every Xj; as the Lagrange coefficients sum to 1, the wi then sum to x + t.
+func PrepareForSigning(i, pax int, xi *big.Int, ks []*big.Int, bigXs []*crypto.ECPoint, optionalTweak ...*big.Int) (wi *big.Int, bigWs []*crypto.ECPoint) {
 	modQ := common.ModInt(tss.EC().Params().N)
 	if len(ks) != len(bigXs) {
 		panic(fmt.Errorf("PrepareForSigning: len(ks) != len(bigXs) (%d != %d)", len(ks), len(bigXs)))
@@ -27,9 +29,30 @@ func PrepareForSigning(i, pax int, xi *big.Int, ks []*big.Int, bigXs []*crypto.E
 	if len(ks) <= i {
 		panic(fmt.Errorf("PrepareForSigning: len(ks) <= i (%d <= %d)", len(ks), i))
 	}
+	if 1 < len(optionalTweak) {
+		panic(fmt.Errorf("PrepareForSigning: expected 0 or 1 item in `optionalTweak`"))
+	}
+
+	// 1. apply the tweak; a tweak of 0 mod N leaves the key unchanged
+	var tweak *big.Int
+	if 0 < len(optionalTweak) && optionalTweak[0] != nil {
+		tweak = new(big.Int).Mod(optionalTweak[0], tss.EC().Params().N)
+	}
+	if tweak != nil && tweak.Sign() != 0 {
+		gTweak := crypto.ScalarBaseMult(tss.EC(), tweak)
+		xi = modQ.Add(xi, tweak)
+		tweakedXs := make([]*crypto.ECPoint, len(bigXs))
+		for j, bigXj := range bigXs {
+			var err error
+			if tweakedXs[j], err = bigXj.Add(gTweak); err != nil {
+				panic(fmt.Errorf("PrepareForSigning: the tweaked Xj is not a valid point: %v", err))
+			}
+		}
+		bigXs = tweakedXs
+	}
 
 	// 2-4.
-	wi = xi
+	wi = new(big.Int).Set(xi) // not aliased, so that it may be zeroized
 	for j := 0; j < pax; j++ {
 		if j == i {
 			continue
@@ -60,3 +83,13 @@ func PrepareForSigning(i, pax int, xi *big.Int, ks []*big.Int, bigXs []*crypto.E
 	}
 	return
 }
+
+// TweakPublicKey returns y + t*G, the public key that a signature made with the additive `tweak` t verifies under
+func TweakPublicKey(pubKey *crypto.ECPoint, tweak *big.Int) (*crypto.ECPoint, error) {
+	tweak = new(big.Int).Mod(tweak, tss.EC().Params().N)
+	if tweak.Sign() == 0 {
+		// t*G is the point at infinity, which has no ECPoint
+		return pubKey, nil
+	}
+	return pubKey.Add(crypto.ScalarBaseMult(tss.EC(), tweak))
+}
@@ -0,0 +1,45 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/ecdsa/keygen"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+func TestZeroTweak(t *testing.T) {
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 1)
+	assert.NoError(t, err, "should load keygen fixtures")
+	ks := make([]*big.Int, len(keys))
+	for j, key := range keys {
+		ks[j] = key.ShareID
+	}
+	wi, bigWs := PrepareForSigning(0, len(ks), keys[0].Xi, ks, keys[0].BigXj[:len(ks)])
+
+	for _, tweak := range []*big.Int{big.NewInt(0), tss.EC().Params().N} {
+		tweakedPub, err := TweakPublicKey(keys[0].ECDSAPub, tweak)
+		if assert.NoError(t, err) {
+			assert.True(t, keys[0].ECDSAPub.Equals(tweakedPub), "a tweak of 0 mod N leaves the key unchanged")
+		}
+		tweakedWi, tweakedBigWs := PrepareForSigning(0, len(ks), keys[0].Xi, ks, keys[0].BigXj[:len(ks)], tweak)
+		assert.Equal(t, wi, tweakedWi)
+		for j := range bigWs {
+			assert.True(t, bigWs[j].Equals(tweakedBigWs[j]))
+		}
+
+		params := tss.NewParameters(tss.NewPeerContext(pIDs), pIDs[0], len(pIDs), testThreshold)
+		P := NewLocalPartyWithTweak(big.NewInt(42), params, keys[0], tweak,
+			make(chan tss.Message, 2*len(pIDs)), make(chan common.SignatureData, 1))
+		assert.Nil(t, P.Start())
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"time"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/ecdsa/keygen"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+// NewRobustParty returns a session that signs `msg` with threshold+1 of the parties of `params`, which are all the
+// parties of the key that are available, and that starts over without the offline or faulty ones until a signature
+// is produced; see tss.RobustSession. Every party of `params` must take part in the session with the same parties.
+func NewRobustParty(
+	msg *big.Int,
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	timeout time.Duration,
+	out chan<- *tss.RobustMessage,
+	end chan<- common.SignatureData,
+	errCh chan<- *tss.Error,
+) (*tss.RobustSession, error) {
+	if err := CheckSigners(key, params.Threshold(), params.Parties().IDs()); err != nil {
+		return nil, err
+	}
+	newParty := func(params *tss.Parameters, out chan<- tss.Message, end chan<- common.SignatureData) tss.Party {
+		return NewLocalParty(msg, params, key, out, end)
+	}
+	pk := ecdsa.PublicKey{
+		Curve: tss.EC(),
+		X:     key.ECDSAPub.X(),
+		Y:     key.ECDSAPub.Y(),
+	}
+	verify := func(data common.SignatureData) bool {
+		r, s := new(big.Int).SetBytes(data.R), new(big.Int).SetBytes(data.S)
+		return ecdsa.Verify(&pk, msg.Bytes(), r, s)
+	}
+	return tss.NewRobustSession(params, timeout, newParty, verify, out, end, errCh), nil
+}
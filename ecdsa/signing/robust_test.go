@@ -0,0 +1,92 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/ecdsa/keygen"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+func TestRobustSigningWithOfflineSigner(t *testing.T) {
+	setUp("info")
+	// the progress timeout must outlast the slowest round of the protocol on this machine
+	const timeout = time.Minute
+
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 2)
+	assert.NoError(t, err, "should load keygen fixtures")
+	msg := big.NewInt(42)
+
+	// the first party is offline, so it is in the quorum of the first attempt but not of the second one
+	offline := pIDs[0]
+	live := len(pIDs) - 1
+	p2pCtx := tss.NewPeerContext(pIDs)
+	outCh := make(chan *tss.RobustMessage, 4*len(pIDs))
+	endCh := make(chan common.SignatureData, live)
+	errCh := make(chan *tss.Error, live)
+	sessions := make(map[string]*tss.RobustSession, live)
+	for i := 1; i < len(pIDs); i++ {
+		params := tss.NewParameters(p2pCtx, pIDs[i], len(pIDs), testThreshold)
+		S, err := NewRobustParty(msg, params, keys[i], timeout, outCh, endCh, errCh)
+		assert.NoError(t, err)
+		sessions[string(pIDs[i].GetKey())] = S
+	}
+	for _, S := range sessions {
+		assert.Nil(t, S.Start())
+	}
+
+	pk := ecdsa.PublicKey{
+		Curve: tss.EC(),
+		X:     keys[0].ECDSAPub.X(),
+		Y:     keys[0].ECDSAPub.Y(),
+	}
+	ended := 0
+	for ended < live {
+		select {
+		case rMsg := <-outCh:
+			if rMsg.Message != nil {
+				// as received from the network
+				bz, _, err := rMsg.Message.WireBytes()
+				assert.NoError(t, err)
+				received := *rMsg
+				received.Message, received.WireBytes, received.IsBroadcast = nil, bz, rMsg.Message.IsBroadcast()
+				rMsg = &received
+			}
+			for _, Pj := range rMsg.To {
+				if Pj.KeyInt().Cmp(offline.KeyInt()) != 0 {
+					go sessions[string(Pj.GetKey())].Update(rMsg)
+				}
+			}
+		case sig := <-endCh:
+			ended++
+			r, s := new(big.Int).SetBytes(sig.R), new(big.Int).SetBytes(sig.S)
+			assert.True(t, ecdsa.Verify(&pk, msg.Bytes(), r, s), "ecdsa verify must pass")
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		}
+	}
+	for _, S := range sessions {
+		assert.Equal(t, 1, S.Attempt(), "the second attempt signs")
+		assert.Equal(t, []*tss.PartyID{offline}, S.Excluded(), "only the offline party is excluded")
+	}
+}
+
+func TestRobustPartyWithTooFewSigners(t *testing.T) {
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testThreshold)
+	assert.NoError(t, err, "should load keygen fixtures")
+	params := tss.NewParameters(tss.NewPeerContext(pIDs), pIDs[0], len(pIDs), testThreshold)
+	_, err = NewRobustParty(big.NewInt(42), params, keys[0], time.Minute, nil, nil, nil)
+	assert.Equal(t, ErrTooFewSigners, errors.Cause(err))
+}
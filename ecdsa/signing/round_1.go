@@ -11,6 +11,8 @@ import (
 	"fmt"
 	"math/big"
 
+	errorspkg "github.com/pkg/errors"
+
 	"github.com/binance-chain/tss-lib/common"
 	"github.com/binance-chain/tss-lib/crypto"
 	"github.com/binance-chain/tss-lib/crypto/commitments"
@@ -19,10 +21,6 @@ import (
 	"github.com/binance-chain/tss-lib/tss"
 )
 
-var (
-	zero = big.NewInt(0)
-)
-
 // round 1 represents round 1 of the signing part of the GG18 ECDSA TSS spec (Gennaro, Goldfeder; 2018)
 func newRound1(params *tss.Parameters, key *keygen.LocalPartySaveData, data *common.SignatureData, temp *localTempData, out chan<- tss.Message, end chan<- common.SignatureData) tss.Round {
 	return &round1{
@@ -33,6 +31,9 @@ func (round *round1) Start() *tss.Error {
 	if round.started {
 		return round.WrapError(errors.New("round already started"))
 	}
+	if err := keygen.CheckCurve(round.Params(), tss.EC()); err != nil {
+		return round.WrapError(err, round.PartyID())
+	}
 
 	// Spec requires calculate H(M) here,
 	// but considered different blockchain use different hash function we accept the converted big.Int
@@ -46,10 +47,15 @@ func (round *round1) Start() *tss.Error {
 	round.started = true
 	round.resetOK()
 
-	k := common.GetRandomPositiveInt(tss.EC().Params().N)
-	gamma := common.GetRandomPositiveInt(tss.EC().Params().N)
+	var k, gamma *big.Int
+	if round.temp.nonceSessionID != nil {
+		k, gamma = round.deriveNonce(noncePurposeK), round.deriveNonce(noncePurposeGamma)
+	} else {
+		k = common.GetRandomPositiveInt(tss.EC().Params().N)
+		gamma = common.GetRandomPositiveInt(tss.EC().Params().N)
+	}
 
-	pointGamma := crypto.ScalarBaseMult(tss.EC(), gamma)
+	pointGamma := crypto.ScalarBaseMultSecret(tss.EC(), gamma)
 	cmt := commitments.NewHashCommitment(pointGamma.X(), pointGamma.Y())
 	round.temp.k = k
 	round.temp.gamma = gamma
@@ -114,17 +120,85 @@ func (round *round1) NextRound() tss.Round {
 // ----- //
 
 // helper to call into PrepareForSigning()
-func (round *round1) prepare() error {
+func (round *round1) prepare() *tss.Error {
 	i := round.PartyID().Index
 
 	xi := round.key.Xi
 	ks := round.key.Ks
 	bigXs := round.key.BigXj
 
-	if round.Threshold()+1 > len(ks) {
-		return fmt.Errorf("t+1=%d is not satisfied by the key count of %d", round.Threshold()+1, len(ks))
+	if round.temp.signersErr != nil {
+		return round.WrapError(round.temp.signersErr)
+	}
+	if round.temp.nonceSessionID != nil {
+		if len(round.temp.nonceSessionID) == 0 {
+			return round.WrapError(errors.New("the session ID of the deterministic nonces is empty"))
+		}
+		if round.temp.nonceSessionStore == nil {
+			return round.WrapError(errors.New("deterministic nonces need a store of the used session IDs"))
+		}
+		// for the key itself rather than a tweaked child, which is applied below
+		if err := round.temp.nonceSessionStore.Use(round.key.ECDSAPub, round.key.ShareID, round.temp.nonceSessionID); err != nil {
+			return round.WrapError(errorspkg.Wrapf(err, "session ID %x", round.temp.nonceSessionID))
+		}
+	}
+	// the MtA range proofs with each peer are only sound if its moduli are large enough
+	for j, Pj := range round.Parties().IDs() {
+		if j == i {
+			continue
+		}
+		if err := keygen.CheckPeerModuli(round.Params(), round.key.PaillierPKs[j], round.key.NTildej[j], round.key.H1j[j], round.key.H2j[j]); err != nil {
+			return round.WrapError(err, Pj)
+		}
+	}
+	// N^2 and the CRT values are computed once for the 2(n-1) MtA encryptions and decryptions of rounds 1-3.
+	// key is a subset built for this session, so that its slices may be modified.
+	sk, err := round.key.PaillierSK.Precomputed()
+	if err != nil {
+		return round.WrapError(err, round.PartyID())
+	}
+	round.temp.paillierSK = sk
+	for j, pk := range round.key.PaillierPKs {
+		round.key.PaillierPKs[j] = pk.Precomputed()
+		for _, pool := range round.temp.randomnessPools {
+			if pooled, err := pk.WithRandomnessPool(pool); err == nil {
+				round.key.PaillierPKs[j] = pooled
+				break
+			}
+		}
+	}
+	if round.temp.isRawMsg {
+		digest, err := round.temp.hashFunc.Digest(round.temp.rawMsg)
+		if err != nil {
+			return round.WrapError(err)
+		}
+		round.temp.digest = digest
+		round.temp.m = hashToInt(digest, tss.EC().Params().N)
+	}
+	if round.temp.m == nil {
+		return round.WrapError(errors.New("the message to sign is nil"))
+	}
+	// a BIP32 child key is signed for by tweaking the key with the sum of the derivation tweaks, to which the tweak
+	// given to NewLocalPartyWithTweak, if any, is added
+	if round.temp.derivationPath != nil {
+		delta, _, err := round.key.DeriveChildKey(*round.temp.derivationPath)
+		if err != nil {
+			return round.WrapError(err)
+		}
+		if round.temp.tweak != nil {
+			delta = common.ModInt(tss.EC().Params().N).Add(delta, round.temp.tweak)
+		}
+		round.temp.tweak = delta
+	}
+	if round.temp.tweak != nil {
+		// finalize verifies the signature against the tweaked key
+		tweakedPub, err := TweakPublicKey(round.key.ECDSAPub, round.temp.tweak)
+		if err != nil {
+			return round.WrapError(fmt.Errorf("the tweaked public key is invalid: %v", err))
+		}
+		round.key.ECDSAPub = tweakedPub
 	}
-	wi, bigWs := PrepareForSigning(i, len(ks), xi, ks, bigXs)
+	wi, bigWs := PrepareForSigning(i, len(ks), xi, ks, bigXs, round.temp.tweak)
 
 	round.temp.w = wi
 	round.temp.bigWs = bigWs
@@ -30,12 +30,14 @@ func (round *round2) Start() *tss.Error {
 	errChs := make(chan *tss.Error, (len(round.Parties().IDs())-1)*2)
 	wg := sync.WaitGroup{}
 	wg.Add((len(round.Parties().IDs()) - 1) * 2)
+	verifiers := round.VerifierPool()
 	for j, Pj := range round.Parties().IDs() {
 		if j == i {
 			continue
 		}
+		j, Pj := j, Pj
 		// Bob_mid
-		go func(j int, Pj *tss.PartyID) {
+		verifiers.Go(func() {
 			defer wg.Done()
 			r1msg := round.temp.signRound1Message1s[j].Content().(*SignRound1Message1)
 			rangeProofAliceJ, err := r1msg.UnmarshalRangeProofAlice()
@@ -61,9 +63,9 @@ func (round *round2) Start() *tss.Error {
 			if err != nil {
 				errChs <- round.WrapError(err, Pj)
 			}
-		}(j, Pj)
+		})
 		// Bob_mid_wc
-		go func(j int, Pj *tss.PartyID) {
+		verifiers.Go(func() {
 			defer wg.Done()
 			r1msg := round.temp.signRound1Message1s[j].Content().(*SignRound1Message1)
 			rangeProofAliceJ, err := r1msg.UnmarshalRangeProofAlice()
@@ -89,17 +91,13 @@ func (round *round2) Start() *tss.Error {
 			if err != nil {
 				errChs <- round.WrapError(err, Pj)
 			}
-		}(j, Pj)
+		})
 	}
 	// consume error channels; wait for goroutines
 	wg.Wait()
 	close(errChs)
-	culprits := make([]*tss.PartyID, 0, len(round.Parties().IDs()))
-	for err := range errChs {
-		culprits = append(culprits, err.Culprits()...)
-	}
-	if len(culprits) > 0 {
-		return round.WrapError(errors.New("failed to calculate Bob_mid or Bob_mid_wc"), culprits...)
+	if err := round.collectMtAErrors(errChs, "failed to calculate Bob_mid or Bob_mid_wc"); err != nil {
+		return err
 	}
 	// create and send messages
 	for j, Pj := range round.Parties().IDs() {
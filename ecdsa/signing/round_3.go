@@ -34,12 +34,14 @@ func (round *round3) Start() *tss.Error {
 	errChs := make(chan *tss.Error, (len(round.Parties().IDs())-1)*2)
 	wg := sync.WaitGroup{}
 	wg.Add((len(round.Parties().IDs()) - 1) * 2)
+	verifiers := round.VerifierPool()
 	for j, Pj := range round.Parties().IDs() {
 		if j == i {
 			continue
 		}
+		j, Pj := j, Pj
 		// Alice_end
-		go func(j int, Pj *tss.PartyID) {
+		verifiers.Go(func() {
 			defer wg.Done()
 			r2msg := round.temp.signRound2Messages[j].Content().(*SignRound2Message)
 			proofBob, err := r2msg.UnmarshalProofBob()
@@ -55,14 +57,14 @@ func (round *round3) Start() *tss.Error {
 				round.temp.cis[j],
 				new(big.Int).SetBytes(r2msg.GetC1()),
 				round.key.NTildej[i],
-				round.key.PaillierSK)
+				round.temp.paillierSK)
 			alphas[j] = alphaIj
 			if err != nil {
 				errChs <- round.WrapError(err, Pj)
 			}
-		}(j, Pj)
+		})
 		// Alice_end_wc
-		go func(j int, Pj *tss.PartyID) {
+		verifiers.Go(func() {
 			defer wg.Done()
 			r2msg := round.temp.signRound2Messages[j].Content().(*SignRound2Message)
 			proofBobWC, err := r2msg.UnmarshalProofBobWC()
@@ -79,23 +81,19 @@ func (round *round3) Start() *tss.Error {
 				round.key.NTildej[i],
 				round.key.H1j[i],
 				round.key.H2j[i],
-				round.key.PaillierSK)
+				round.temp.paillierSK)
 			us[j] = uIj
 			if err != nil {
 				errChs <- round.WrapError(err, Pj)
 			}
-		}(j, Pj)
+		})
 	}
 
 	// consume error channels; wait for goroutines
 	wg.Wait()
 	close(errChs)
-	culprits := make([]*tss.PartyID, 0, len(round.Parties().IDs()))
-	for err := range errChs {
-		culprits = append(culprits, err.Culprits()...)
-	}
-	if len(culprits) > 0 {
-		return round.WrapError(errors.New("failed to calculate Alice_end or Alice_end_wc"), culprits...)
+	if err := round.collectMtAErrors(errChs, "failed to calculate Alice_end or Alice_end_wc"); err != nil {
+		return err
 	}
 
 	modN := common.ModInt(tss.EC().Params().N)
@@ -109,6 +107,8 @@ func (round *round3) Start() *tss.Error {
 		thelta = modN.Add(thelta, alphas[j].Add(alphas[j], round.temp.betas[j]))
 		sigma = modN.Add(sigma, us[j].Add(us[j], round.temp.vs[j]))
 	}
+	common.Zeroize(alphas...)
+	common.Zeroize(us...)
 
 	round.temp.theta = thelta
 	round.temp.sigma = sigma
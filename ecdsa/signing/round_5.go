@@ -14,6 +14,7 @@ import (
 	"github.com/binance-chain/tss-lib/common"
 	"github.com/binance-chain/tss-lib/crypto"
 	"github.com/binance-chain/tss-lib/crypto/commitments"
+	"github.com/binance-chain/tss-lib/crypto/schnorr"
 	"github.com/binance-chain/tss-lib/tss"
 )
 
@@ -26,6 +27,10 @@ func (round *round5) Start() *tss.Error {
 	round.resetOK()
 
 	R := round.temp.pointGamma
+	// the proofs of knowledge of gamma_j are verified together once all of them are opened
+	proofs := make([]*schnorr.ZKProof, 0, len(round.Parties().IDs())-1)
+	bigGammaJs := make([]*crypto.ECPoint, 0, cap(proofs))
+	provers := make([]*tss.PartyID, 0, cap(proofs))
 	for j, Pj := range round.Parties().IDs() {
 		if j == round.PartyID().Index {
 			continue
@@ -46,15 +51,15 @@ func (round *round5) Start() *tss.Error {
 		if err != nil {
 			return round.WrapError(errors.New("failed to unmarshal bigGamma proof"), Pj)
 		}
-		ok = proof.Verify(bigGammaJPoint)
-		if !ok {
-			return round.WrapError(errors.New("failed to prove bigGamma"), Pj)
-		}
+		proofs, bigGammaJs, provers = append(proofs, proof), append(bigGammaJs, bigGammaJPoint), append(provers, Pj)
 		R, err = R.Add(bigGammaJPoint)
 		if err != nil {
 			return round.WrapError(errors2.Wrapf(err, "R.Add(bigGammaJ)"), Pj)
 		}
 	}
+	if invalid := schnorr.BatchVerifyZKProofs(proofs, bigGammaJs); 0 < len(invalid) {
+		return round.WrapError(errors.New("failed to prove bigGamma"), pickParties(provers, invalid)...)
+	}
 
 	R = R.ScalarMult(round.temp.thetaInverse)
 	N := tss.EC().Params().N
@@ -63,15 +68,14 @@ func (round *round5) Start() *tss.Error {
 	ry := R.Y()
 	si := modN.Add(modN.Mul(round.temp.m, round.temp.k), modN.Mul(rx, round.temp.sigma))
 
-	// clear temp.w and temp.k from memory, lint ignore
-	round.temp.w = zero
-	round.temp.k = zero
+	// clear temp.w and temp.k from memory
+	common.Zeroize(round.temp.w, round.temp.k)
 
 	li := common.GetRandomPositiveInt(N)  // li
 	roI := common.GetRandomPositiveInt(N) // pi
-	rToSi := R.ScalarMult(si)
-	liPoint := crypto.ScalarBaseMult(tss.EC(), li)
-	bigAi := crypto.ScalarBaseMult(tss.EC(), roI)
+	rToSi := R.ScalarMultSecret(si)
+	liPoint := crypto.ScalarBaseMultSecret(tss.EC(), li)
+	bigAi := crypto.ScalarBaseMultSecret(tss.EC(), roI)
 	bigVi, err := rToSi.Add(liPoint)
 	if err != nil {
 		return round.WrapError(errors2.Wrapf(err, "rToSi.Add(li)"))
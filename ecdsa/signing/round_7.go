@@ -15,6 +15,7 @@ import (
 	"github.com/binance-chain/tss-lib/common"
 	"github.com/binance-chain/tss-lib/crypto"
 	"github.com/binance-chain/tss-lib/crypto/commitments"
+	"github.com/binance-chain/tss-lib/crypto/schnorr"
 	"github.com/binance-chain/tss-lib/tss"
 )
 
@@ -28,6 +29,11 @@ func (round *round7) Start() *tss.Error {
 
 	bigVjs := make([]*crypto.ECPoint, len(round.Parties().IDs()))
 	bigAjs := make([]*crypto.ECPoint, len(round.Parties().IDs()))
+	// the proofs for A_j and V_j are verified in two batches once all of them are opened
+	n := len(round.Parties().IDs()) - 1
+	proofsA, proofsV := make([]*schnorr.ZKProof, 0, n), make([]*schnorr.ZKVProof, 0, n)
+	openedAjs, openedVjs := make([]*crypto.ECPoint, 0, n), make([]*crypto.ECPoint, 0, n)
+	provers := make([]*tss.PartyID, 0, n)
 	for j, Pj := range round.Parties().IDs() {
 		if j == round.PartyID().Index {
 			continue
@@ -52,13 +58,22 @@ func (round *round7) Start() *tss.Error {
 		}
 		bigAjs[j] = bigAj
 		pijA, err := r6msg.UnmarshalZKProof()
-		if err != nil || !pijA.Verify(bigAj) {
+		if err != nil {
 			return round.WrapError(errors.New("schnorr verify for Aj failed"), Pj)
 		}
 		pijV, err := r6msg.UnmarshalZKVProof()
-		if err != nil || !pijV.Verify(bigVj, round.temp.bigR) {
+		if err != nil {
 			return round.WrapError(errors.New("vverify for Vj failed"), Pj)
 		}
+		proofsA, openedAjs = append(proofsA, pijA), append(openedAjs, bigAj)
+		proofsV, openedVjs = append(proofsV, pijV), append(openedVjs, bigVj)
+		provers = append(provers, Pj)
+	}
+	if invalid := schnorr.BatchVerifyZKProofs(proofsA, openedAjs); 0 < len(invalid) {
+		return round.WrapError(errors.New("schnorr verify for Aj failed"), pickParties(provers, invalid)...)
+	}
+	if invalid := schnorr.BatchVerifyZKVProofs(proofsV, openedVjs, round.temp.bigR); 0 < len(invalid) {
+		return round.WrapError(errors.New("vverify for Vj failed"), pickParties(provers, invalid)...)
 	}
 
 	modN := common.ModInt(tss.EC().Params().N)
@@ -78,11 +93,13 @@ func (round *round7) Start() *tss.Error {
 		AX, AY = tss.EC().Add(AX, AY, bigAjs[j].X(), bigAjs[j].Y())
 	}
 
-	UiX, UiY := tss.EC().ScalarMult(VX, VY, round.temp.roi.Bytes())
-	TiX, TiY := tss.EC().ScalarMult(AX, AY, round.temp.li.Bytes())
-	round.temp.Ui = crypto.NewECPointNoCurveCheck(tss.EC(), UiX, UiY)
-	round.temp.Ti = crypto.NewECPointNoCurveCheck(tss.EC(), TiX, TiY)
-	cmt := commitments.NewHashCommitment(UiX, UiY, TiX, TiY)
+	Ui := crypto.NewECPointNoCurveCheck(tss.EC(), VX, VY).ScalarMultSecret(round.temp.roi)
+	Ti := crypto.NewECPointNoCurveCheck(tss.EC(), AX, AY).ScalarMultSecret(round.temp.li)
+	if Ui == nil || Ti == nil {
+		return round.WrapError(errors.New("Ui or Ti is the point at infinity"))
+	}
+	round.temp.Ui, round.temp.Ti = Ui, Ti
+	cmt := commitments.NewHashCommitment(Ui.X(), Ui.Y(), Ti.X(), Ti.Y())
 	r7msg := NewSignRound7Message(round.PartyID(), cmt.C)
 	round.temp.signRound7Messages[round.PartyID().Index] = r7msg
 	round.out <- r7msg
@@ -7,6 +7,9 @@
 package signing
 
 import (
+	"github.com/hashicorp/go-multierror"
+	errorspkg "github.com/pkg/errors"
+
 	"github.com/binance-chain/tss-lib/common"
 	"github.com/binance-chain/tss-lib/ecdsa/keygen"
 	"github.com/binance-chain/tss-lib/tss"
@@ -121,3 +124,33 @@ func (round *base) resetOK() {
 		round.ok[j] = false
 	}
 }
+
+// collectMtAErrors drains the errors of the MtA routines of rounds 2 and 3, which name the party whose values failed,
+// e.g. with mta.ErrInvalidCiphertextA, and combines them into one error that keeps each cause
+func (round *base) collectMtAErrors(errChs <-chan *tss.Error, msg string) *tss.Error {
+	var multiErr error
+	culprits := make([]*tss.PartyID, 0, len(round.Parties().IDs()))
+	seen := make(map[*tss.PartyID]struct{}, len(round.Parties().IDs()))
+	for err := range errChs {
+		multiErr = multierror.Append(multiErr, err.Cause())
+		for _, culprit := range err.Culprits() {
+			if _, found := seen[culprit]; !found {
+				seen[culprit] = struct{}{}
+				culprits = append(culprits, culprit)
+			}
+		}
+	}
+	if multiErr == nil {
+		return nil
+	}
+	return round.WrapError(errorspkg.Wrap(multiErr, msg), culprits...)
+}
+
+// pickParties returns the parties at the given indexes, e.g. the provers of the proofs that failed a batch verification
+func pickParties(parties []*tss.PartyID, indexes []int) []*tss.PartyID {
+	picked := make([]*tss.PartyID, len(indexes))
+	for k, idx := range indexes {
+		picked[k] = parties[idx]
+	}
+	return picked
+}
@@ -0,0 +1,130 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"errors"
+	"math/big"
+
+	errorspkg "github.com/pkg/errors"
+
+	"github.com/binance-chain/tss-lib/ecdsa/keygen"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+// The causes of the errors of CheckSigners and SelectSigners; use `errors.Cause` from github.com/pkg/errors to get them
+var (
+	ErrTooFewSigners      = errors.New("fewer signers than threshold+1")
+	ErrUnknownSigner      = errors.New("the signer is not a party of the key")
+	ErrDuplicateSigner    = errors.New("the signer appears more than once")
+	ErrLocalPartyNotAmong = errors.New("the holder of the key is not among the signers")
+	ErrNoShare            = errors.New("the key has no share, e.g. it was not saved by a keygen or a re-sharing")
+)
+
+// CheckSigners reports why `signers` cannot sign with `key`, or returns nil if they can. A signing set needs at least
+// threshold+1 distinct parties whose keys are in `key.Ks`, i.e. that took part in the keygen or the last re-sharing of
+// the key, and the holder of `key` must be one of them.
+// Each party of the set runs the MtA protocol with every other one, so the cost of a signature grows with the square
+// of the size of the set; SelectSigners picks exactly threshold+1.
+func CheckSigners(key keygen.LocalPartySaveData, threshold int, signers []*tss.PartyID) error {
+	if key.ShareID == nil {
+		return ErrNoShare
+	}
+	ks := keyIndexes(key)
+	seen := make(map[string]struct{}, len(signers))
+	self := false
+	for _, Pj := range signers {
+		if Pj == nil {
+			return errorspkg.Wrap(ErrUnknownSigner, "nil party")
+		}
+		kj := string(Pj.GetKey())
+		if _, found := ks[kj]; !found {
+			return errorspkg.Wrapf(ErrUnknownSigner, "party %s", Pj)
+		}
+		if _, found := seen[kj]; found {
+			return errorspkg.Wrapf(ErrDuplicateSigner, "party %s", Pj)
+		}
+		seen[kj] = struct{}{}
+		if Pj.KeyInt().Cmp(key.ShareID) == 0 {
+			self = true
+		}
+	}
+	if len(signers) <= threshold {
+		return errorspkg.Wrapf(ErrTooFewSigners,
+			"%d signers with threshold %d, which needs %d", len(signers), threshold, threshold+1)
+	}
+	if !self {
+		return errorspkg.Wrapf(ErrLocalPartyNotAmong, "the share of the key belongs to the party with key %x",
+			key.ShareID.Bytes())
+	}
+	return nil
+}
+
+// SelectSigners picks threshold+1 signers for `key` out of `available`, which lists the parties that may sign in order
+// of preference, e.g. fastest first by their measured round-trip time. The holder of `key` is always picked and the
+// other parties are taken from the front of the list; parties that did not take part in the keygen of the key and
+// repeated parties are skipped. The signers are returned as new sorted IDs, ready for `tss.NewPeerContext`; the
+// party of the key signs with its ID from this list.
+// When fewer than threshold+1 of the parties can sign, the error says how many of them were usable and why the others
+// were not.
+func SelectSigners(key keygen.LocalPartySaveData, threshold int, available []*tss.PartyID) (tss.SortedPartyIDs, error) {
+	if key.ShareID == nil {
+		return nil, ErrNoShare
+	}
+	ks := keyIndexes(key)
+	picked := make(tss.UnSortedPartyIDs, 0, threshold+1)
+	seen := make(map[string]struct{}, len(available))
+	var self *tss.PartyID
+	unknown, duplicates := 0, 0
+	for _, Pj := range available {
+		if Pj == nil {
+			unknown++
+			continue
+		}
+		kj := string(Pj.GetKey())
+		if _, found := ks[kj]; !found {
+			unknown++
+			continue
+		}
+		if _, found := seen[kj]; found {
+			duplicates++
+			continue
+		}
+		seen[kj] = struct{}{}
+		if Pj.KeyInt().Cmp(key.ShareID) == 0 {
+			self = Pj
+			continue
+		}
+		picked = append(picked, Pj)
+	}
+	if self == nil {
+		return nil, errorspkg.Wrapf(ErrLocalPartyNotAmong, "the share of the key belongs to the party with key %x",
+			key.ShareID.Bytes())
+	}
+	if len(picked) < threshold {
+		return nil, errorspkg.Wrapf(ErrTooFewSigners,
+			"%d of the %d available parties can sign with threshold %d, which needs %d (%d are not parties of the key, %d are repeated)",
+			len(picked)+1, len(available), threshold, threshold+1, unknown, duplicates)
+	}
+	// sorting sets the Index of each ID, so the IDs of `available` are left alone
+	signers := make(tss.UnSortedPartyIDs, 0, threshold+1)
+	for _, Pj := range append(picked[:threshold], self) {
+		signers = append(signers, tss.NewPartyID(Pj.Id, Pj.Moniker, Pj.KeyInt()))
+	}
+	return tss.SortPartyIDs(signers), nil
+}
+
+// keyIndexes maps the big-endian bytes of the keys of the parties of `key` to their positions in `key.Ks`
+func keyIndexes(key keygen.LocalPartySaveData) map[string]int {
+	ks := make(map[string]int, len(key.Ks))
+	for j, kj := range key.Ks {
+		if kj != nil && kj.Cmp(big.NewInt(0)) != 0 {
+			ks[string(kj.Bytes())] = j
+		}
+	}
+	return ks
+}
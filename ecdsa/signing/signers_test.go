@@ -0,0 +1,98 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/ecdsa/keygen"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+func TestCheckSigners(t *testing.T) {
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	key := keys[0]
+
+	assert.NoError(t, CheckSigners(key, testThreshold, pIDs[:testThreshold+1]))
+	assert.NoError(t, CheckSigners(key, testThreshold, pIDs))
+
+	err = CheckSigners(key, testThreshold, pIDs[:testThreshold])
+	assert.Equal(t, ErrTooFewSigners, errors.Cause(err))
+	assert.Contains(t, err.Error(), "needs")
+
+	stranger := tss.NewPartyID("stranger", "stranger", big.NewInt(12345))
+	err = CheckSigners(key, testThreshold, append(tss.UnSortedPartyIDs{stranger}, pIDs[:testThreshold+1]...))
+	assert.Equal(t, ErrUnknownSigner, errors.Cause(err))
+	assert.Contains(t, err.Error(), "stranger")
+
+	err = CheckSigners(key, testThreshold, append(tss.UnSortedPartyIDs{pIDs[1]}, pIDs[:testThreshold+1]...))
+	assert.Equal(t, ErrDuplicateSigner, errors.Cause(err))
+
+	err = CheckSigners(key, testThreshold, pIDs[1:testThreshold+2])
+	assert.Equal(t, ErrLocalPartyNotAmong, errors.Cause(err))
+
+	assert.Equal(t, ErrNoShare, CheckSigners(keygen.LocalPartySaveData{}, testThreshold, pIDs))
+}
+
+func TestSelectSigners(t *testing.T) {
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	key := keys[2]
+	self := pIDs[2]
+
+	// the parties in order of preference: the last ones first, with a stranger and a repeated party in between
+	stranger := tss.NewPartyID("stranger", "stranger", big.NewInt(12345))
+	available := tss.UnSortedPartyIDs{pIDs[len(pIDs)-1], stranger, pIDs[len(pIDs)-1]}
+	for j := len(pIDs) - 2; 0 <= j; j-- {
+		available = append(available, pIDs[j])
+	}
+	signers, err := SelectSigners(key, testThreshold, available)
+	assert.NoError(t, err)
+	assert.Equal(t, testThreshold+1, len(signers))
+	assert.NoError(t, CheckSigners(key, testThreshold, signers))
+	assert.NotNil(t, signers.FindByKey(self.KeyInt()), "the holder of the key always signs")
+	preferred := make([]*tss.PartyID, 0, testThreshold)
+	for j := len(pIDs) - 1; len(preferred) < testThreshold; j-- {
+		if j != self.Index {
+			preferred = append(preferred, pIDs[j])
+		}
+	}
+	for _, Pj := range preferred {
+		assert.NotNil(t, signers.FindByKey(Pj.KeyInt()), "the most preferred parties sign")
+	}
+	for k := 1; k < len(signers); k++ {
+		assert.True(t, signers[k-1].KeyInt().Cmp(signers[k].KeyInt()) < 0, "the signers are sorted")
+	}
+	assert.Equal(t, 2, self.Index, "the given IDs keep their indexes")
+
+	_, err = SelectSigners(key, testThreshold, tss.UnSortedPartyIDs{self, stranger, pIDs[0]})
+	assert.Equal(t, ErrTooFewSigners, errors.Cause(err))
+	assert.Contains(t, err.Error(), "1 are not parties of the key")
+
+	_, err = SelectSigners(key, testThreshold, pIDs[3:])
+	assert.Equal(t, ErrLocalPartyNotAmong, errors.Cause(err))
+
+	_, err = SelectSigners(keygen.LocalPartySaveData{}, testThreshold, pIDs)
+	assert.Equal(t, ErrNoShare, err)
+}
+
+func TestSignWithTooFewSigners(t *testing.T) {
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testThreshold)
+	assert.NoError(t, err, "should load keygen fixtures")
+	params := tss.NewParameters(tss.NewPeerContext(pIDs), pIDs[0], len(pIDs), testThreshold)
+	P := NewLocalParty(big.NewInt(42), params, keys[0], make(chan tss.Message, len(pIDs)), make(chan common.SignatureData, 1))
+	tssErr := P.Start()
+	if assert.NotNil(t, tssErr) {
+		assert.Equal(t, ErrTooFewSigners, errors.Cause(tssErr.Cause()))
+	}
+}
@@ -0,0 +1,231 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package frost
+
+import (
+	"crypto/sha512"
+	"errors"
+	"math/big"
+	"sort"
+
+	"github.com/decred/dcrd/dcrec/edwards/v2"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+// The FROST(Ed25519, SHA-512) ciphersuite, RFC 9591 Section 6.1
+// https://www.rfc-editor.org/rfc/rfc9591.html
+
+const (
+	contextString = "FROST-ED25519-SHA512-v1"
+)
+
+type (
+	// SigningCommitment is the public (hiding, binding) nonce commitment pair (D_i, E_i) of a participant
+	SigningCommitment struct {
+		Hiding, Binding *crypto.ECPoint
+	}
+
+	// commitmentListItem is one entry of the commitment list, which is sorted by identifier
+	commitmentListItem struct {
+		id         *big.Int
+		commitment *SigningCommitment
+	}
+	commitmentList []commitmentListItem
+)
+
+// ----- //
+
+// identifier maps a party key to its FROST identifier, the non-zero scalar used as its share's x coordinate
+func identifier(k *big.Int) *big.Int {
+	return new(big.Int).Mod(k, tss.EC().Params().N)
+}
+
+// newCommitmentList builds the commitment list of the signers with keys `ks`, sorted by identifier
+func newCommitmentList(ks []*big.Int, commitments []*SigningCommitment) commitmentList {
+	list := make(commitmentList, len(ks))
+	for j, kj := range ks {
+		list[j] = commitmentListItem{identifier(kj), commitments[j]}
+	}
+	sort.Slice(list, func(a, b int) bool { return list[a].id.Cmp(list[b].id) < 0 })
+	return list
+}
+
+// encode implements encode_group_commitment_list
+func (list commitmentList) encode() []byte {
+	bz := make([]byte, 0, len(list)*96)
+	for _, item := range list {
+		bz = append(bz, serializeScalar(item.id)...)
+		bz = append(bz, serializeElement(item.commitment.Hiding)...)
+		bz = append(bz, serializeElement(item.commitment.Binding)...)
+	}
+	return bz
+}
+
+// bindingFactors implements compute_binding_factors; the result is keyed by the identifier's string representation
+func (list commitmentList) bindingFactors(groupPubKey *crypto.ECPoint, msg []byte) map[string]*big.Int {
+	rhoInputPrefix := make([]byte, 0, 32+64+64)
+	rhoInputPrefix = append(rhoInputPrefix, serializeElement(groupPubKey)...)
+	rhoInputPrefix = append(rhoInputPrefix, h4(msg)...)
+	rhoInputPrefix = append(rhoInputPrefix, h5(list.encode())...)
+
+	factors := make(map[string]*big.Int, len(list))
+	for _, item := range list {
+		factors[item.id.String()] = h1(rhoInputPrefix, serializeScalar(item.id))
+	}
+	return factors
+}
+
+// groupCommitment implements compute_group_commitment
+func (list commitmentList) groupCommitment(bindingFactors map[string]*big.Int) (*crypto.ECPoint, error) {
+	var R *crypto.ECPoint
+	for _, item := range list {
+		commitmentShare, err := item.commitment.share(bindingFactors[item.id.String()])
+		if err != nil {
+			return nil, err
+		}
+		if R == nil {
+			R = commitmentShare
+			continue
+		}
+		if R, err = R.Add(commitmentShare); err != nil {
+			return nil, err
+		}
+	}
+	return R, nil
+}
+
+// share returns the commitment share D_i + rho_i*E_i
+func (cmt *SigningCommitment) share(bindingFactor *big.Int) (*crypto.ECPoint, error) {
+	return cmt.Hiding.Add(cmt.Binding.ScalarMult(bindingFactor))
+}
+
+// ValidateBasic checks that both of the commitments are valid points other than the identity, as RFC 9591 Section 5.1
+// requires of the commitments that the coordinator receives
+func (cmt *SigningCommitment) ValidateBasic() bool {
+	return cmt != nil && isValidCommitment(cmt.Hiding) && isValidCommitment(cmt.Binding)
+}
+
+// isValidCommitment reports whether `p` is a point of the curve other than the identity (0, 1)
+func isValidCommitment(p *crypto.ECPoint) bool {
+	return p.ValidateBasic() && !(p.X().Sign() == 0 && p.Y().Cmp(big.NewInt(1)) == 0)
+}
+
+// ----- //
+
+// computeChallenge implements compute_challenge; it is the challenge of a standard Ed25519 signature
+func computeChallenge(R, groupPubKey *crypto.ECPoint, msg []byte) *big.Int {
+	return h2(serializeElement(R), serializeElement(groupPubKey), msg)
+}
+
+// interpolatingValue implements derive_interpolating_value: the lagrange coefficient of `k` over the signers `ks` at 0
+func interpolatingValue(ks []*big.Int, k *big.Int) (*big.Int, error) {
+	modQ := common.ModInt(tss.EC().Params().N)
+	x := identifier(k)
+	num, den := big.NewInt(1), big.NewInt(1)
+	found := false
+	for _, kj := range ks {
+		xj := identifier(kj)
+		if xj.Cmp(x) == 0 {
+			if found {
+				return nil, errors.New("duplicate identifier in the signer set")
+			}
+			found = true
+			continue
+		}
+		num = modQ.Mul(num, xj)
+		den = modQ.Mul(den, modQ.Sub(xj, x))
+	}
+	if !found {
+		return nil, errors.New("the identifier is not in the signer set")
+	}
+	return modQ.Mul(num, modQ.ModInverse(den)), nil
+}
+
+// VerifySignatureShare implements verify_signature_share: it checks the signature share z_i of the signer with the
+// public key share `pubKeyShare` (X_i) and the lagrange coefficient `lambda` against its commitment
+func VerifySignatureShare(z *big.Int, pubKeyShare *crypto.ECPoint, commitment *SigningCommitment, bindingFactor, lambda, challenge *big.Int) bool {
+	if z == nil || z.Cmp(tss.EC().Params().N) >= 0 {
+		return false
+	}
+	commitmentShare, err := commitment.share(bindingFactor)
+	if err != nil {
+		return false
+	}
+	l := common.ModInt(tss.EC().Params().N).Mul(challenge, lambda)
+	r, err := commitmentShare.Add(pubKeyShare.ScalarMult(l))
+	if err != nil {
+		return false
+	}
+	return crypto.ScalarBaseMult(tss.EC(), z).Equals(r)
+}
+
+// ----- //
+
+// h1 is used to derive the binding factors
+func h1(in ...[]byte) *big.Int {
+	return hashToScalar(append([][]byte{[]byte(contextString + "rho")}, in...)...)
+}
+
+// h2 is used to derive the challenge; it has no prefix so that the signatures are valid Ed25519 signatures
+func h2(in ...[]byte) *big.Int {
+	return hashToScalar(in...)
+}
+
+// h3 is used to derive the nonces
+func h3(in ...[]byte) *big.Int {
+	return hashToScalar(append([][]byte{[]byte(contextString + "nonce")}, in...)...)
+}
+
+// h4 is used to hash the message
+func h4(m []byte) []byte {
+	h := sha512.New()
+	h.Write([]byte(contextString + "msg"))
+	h.Write(m)
+	return h.Sum(nil)
+}
+
+// h5 is used to hash the encoded commitment list
+func h5(m []byte) []byte {
+	h := sha512.New()
+	h.Write([]byte(contextString + "com"))
+	h.Write(m)
+	return h.Sum(nil)
+}
+
+// hashToScalar interprets the SHA-512 digest of the input as a little-endian integer mod L
+func hashToScalar(in ...[]byte) *big.Int {
+	h := sha512.New()
+	for _, bz := range in {
+		h.Write(bz)
+	}
+	digest := h.Sum(nil)
+	reverse(digest)
+	return new(big.Int).Mod(new(big.Int).SetBytes(digest), tss.EC().Params().N)
+}
+
+// serializeElement returns the 32-byte Ed25519 encoding of a point
+func serializeElement(p *crypto.ECPoint) []byte {
+	return edwards.NewPublicKey(p.X(), p.Y()).Serialize()
+}
+
+// serializeScalar returns the 32-byte little-endian encoding of a scalar
+func serializeScalar(s *big.Int) []byte {
+	bz := make([]byte, 32)
+	sBz := s.Bytes()
+	copy(bz[32-len(sBz):], sBz)
+	reverse(bz)
+	return bz
+}
+
+func reverse(bz []byte) {
+	for i, j := 0, len(bz)-1; i < j; i, j = i+1, j-1 {
+		bz[i], bz[j] = bz[j], bz[i]
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package frost
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/edwards/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+// RFC 9591 Appendix E.1, FROST(Ed25519, SHA-512): 2-of-3, signed by participants 1 and 3
+// https://www.rfc-editor.org/rfc/rfc9591.html#appendix-E.1
+var rfc9591Vectors = struct {
+	groupSecretKey, groupPublicKey, message, coefficient1 string
+	participantShares                                     map[int64]string
+
+	hidingRandomness, bindingRandomness, hidingNonce, bindingNonce map[int64]string
+	hidingCommitment, bindingCommitment, bindingFactor, sigShare  map[int64]string
+
+	sig string
+}{
+	groupSecretKey: "7b1c33d3f5291d85de664833beb1ad469f7fb6025a0ec78b3a790c6e13a98304",
+	groupPublicKey: "15d21ccd7ee42959562fc8aa63224c8851fb3ec85a3faf66040d380fb9738673",
+	message:        "74657374",
+	coefficient1:   "178199860edd8c62f5212ee91eff1295d0d670ab4ed4506866bae57e7030b204",
+	participantShares: map[int64]string{
+		1: "929dcc590407aae7d388761cddb0c0db6f5627aea8e217f4a033f2ec83d93509",
+		2: "a91e66e012e4364ac9aaa405fcafd370402d9859f7b6685c07eed76bf409e80d",
+		3: "d3cb090a075eb154e82fdb4b3cb507f110040905468bb9c46da8bdea643a9a02",
+	},
+	hidingRandomness: map[int64]string{
+		1: "0fd2e39e111cdc266f6c0f4d0fd45c947761f1f5d3cb583dfcb9bbaf8d4c9fec",
+		3: "86d64a260059e495d0fb4fcc17ea3da7452391baa494d4b00321098ed2a0062f",
+	},
+	bindingRandomness: map[int64]string{
+		1: "69cd85f631d5f7f2721ed5e40519b1366f340a87c2f6856363dbdcda348a7501",
+		3: "13e6b25afb2eba51716a9a7d44130c0dbae0004a9ef8d7b5550c8a0e07c61775",
+	},
+	hidingNonce: map[int64]string{
+		1: "812d6104142944d5a55924de6d49940956206909f2acaeedecda2b726e630407",
+		3: "c256de65476204095ebdc01bd11dc10e57b36bc96284595b8215222374f99c0e",
+	},
+	bindingNonce: map[int64]string{
+		1: "b1110165fc2334149750b28dd813a39244f315cff14d4e89e6142f262ed83301",
+		3: "243d71944d929063bc51205714ae3c2218bd3451d0214dfb5aeec2a90c35180d",
+	},
+	hidingCommitment: map[int64]string{
+		1: "b5aa8ab305882a6fc69cbee9327e5a45e54c08af61ae77cb8207be3d2ce13de3",
+		3: "cfbdb165bd8aad6eb79deb8d287bcc0ab6658ae57fdcc98ed12c0669e90aec91",
+	},
+	bindingCommitment: map[int64]string{
+		1: "67e98ab55aa310c3120418e5050c9cf76cf387cb20ac9e4b6fdb6f82a469f932",
+		3: "7487bc41a6e712eea2f2af24681b58b1cf1da278ea11fe4e8b78398965f13552",
+	},
+	bindingFactor: map[int64]string{
+		1: "f2cb9d7dd9beff688da6fcc83fa89046b3479417f47f55600b106760eb3b5603",
+		3: "b087686bf35a13f3dc78e780a34b0fe8a77fef1b9938c563f5573d71d8d7890f",
+	},
+	sigShare: map[int64]string{
+		1: "001719ab5a53ee1a12095cd088fd149702c0720ce5fd2f29dbecf24b7281b603",
+		3: "bd86125de990acc5e1f13781d8e32c03a9bbd4c53539bbc106058bfd14326007",
+	},
+	sig: "36282629c383bb820a88b71cae937d41f2f2adfcc3d02e55507e2fb9e2dd3cbe" +
+		"bd9d2b0844e49ae0f3fa935161e1419aab7b47d21a37ebeae1f17d4987b3160b",
+}
+
+func decodeHex(t *testing.T, s string) []byte {
+	bz, err := hex.DecodeString(s)
+	assert.NoError(t, err)
+	return bz
+}
+
+// decodeScalar decodes a little-endian scalar of the vectors
+func decodeScalar(t *testing.T, s string) *big.Int {
+	bz := decodeHex(t, s)
+	reverse(bz)
+	return new(big.Int).SetBytes(bz)
+}
+
+func TestRFC9591Vectors(t *testing.T) {
+	tss.SetCurve(edwards.Edwards())
+	v := rfc9591Vectors
+	modQ := common.ModInt(tss.EC().Params().N)
+
+	// the key and its shares f(i) = s + a_1*i
+	secret := decodeScalar(t, v.groupSecretKey)
+	groupPubKey := crypto.ScalarBaseMult(tss.EC(), secret)
+	assert.Equal(t, v.groupPublicKey, hex.EncodeToString(serializeElement(groupPubKey)))
+	shares := make(map[int64]*big.Int, len(v.participantShares))
+	for i, share := range v.participantShares {
+		shares[i] = modQ.Add(secret, modQ.Mul(decodeScalar(t, v.coefficient1), big.NewInt(i)))
+		assert.Equal(t, share, hex.EncodeToString(serializeScalar(shares[i])), "participant %d", i)
+	}
+
+	// round one: nonce_generate and the commitments
+	signers := []int64{1, 3}
+	ks := make([]*big.Int, len(signers))
+	commitments := make([]*SigningCommitment, len(signers))
+	nonces := make(map[int64][2]*big.Int, len(signers))
+	for k, i := range signers {
+		share := serializeScalar(shares[i])
+		hiding := h3(decodeHex(t, v.hidingRandomness[i]), share)
+		binding := h3(decodeHex(t, v.bindingRandomness[i]), share)
+		assert.Equal(t, v.hidingNonce[i], hex.EncodeToString(serializeScalar(hiding)), "participant %d", i)
+		assert.Equal(t, v.bindingNonce[i], hex.EncodeToString(serializeScalar(binding)), "participant %d", i)
+		nonces[i] = [2]*big.Int{hiding, binding}
+		ks[k] = big.NewInt(i)
+		commitments[k] = &SigningCommitment{
+			Hiding:  crypto.ScalarBaseMult(tss.EC(), hiding),
+			Binding: crypto.ScalarBaseMult(tss.EC(), binding),
+		}
+		assert.Equal(t, v.hidingCommitment[i], hex.EncodeToString(serializeElement(commitments[k].Hiding)))
+		assert.Equal(t, v.bindingCommitment[i], hex.EncodeToString(serializeElement(commitments[k].Binding)))
+	}
+
+	// round two: the binding factors, the group commitment and the signature shares
+	msg := decodeHex(t, v.message)
+	list := newCommitmentList(ks, commitments)
+	bindingFactors := list.bindingFactors(groupPubKey, msg)
+	R, err := list.groupCommitment(bindingFactors)
+	assert.NoError(t, err)
+	assert.Equal(t, v.sig[:64], hex.EncodeToString(serializeElement(R)))
+	c := computeChallenge(R, groupPubKey, msg)
+	z := big.NewInt(0)
+	for k, i := range signers {
+		rho := bindingFactors[identifier(ks[k]).String()]
+		assert.Equal(t, v.bindingFactor[i], hex.EncodeToString(serializeScalar(rho)), "participant %d", i)
+		lambda, err := interpolatingValue(ks, ks[k])
+		assert.NoError(t, err)
+		zi := modQ.Add(nonces[i][0], modQ.Mul(nonces[i][1], rho))
+		zi = modQ.Add(zi, modQ.Mul(lambda, modQ.Mul(shares[i], c)))
+		assert.Equal(t, v.sigShare[i], hex.EncodeToString(serializeScalar(zi)), "participant %d", i)
+		assert.True(t, VerifySignatureShare(zi, crypto.ScalarBaseMult(tss.EC(), shares[i]), commitments[k], rho, lambda, c))
+		z = modQ.Add(z, zi)
+	}
+
+	sig := append(serializeElement(R), serializeScalar(z)...)
+	assert.Equal(t, v.sig, hex.EncodeToString(sig))
+	assert.True(t, ed25519.Verify(decodeHex(t, v.groupPublicKey), msg, sig))
+}
+
+func TestSigningCommitmentRejectsIdentity(t *testing.T) {
+	tss.SetCurve(edwards.Edwards())
+	identity, err := crypto.NewECPoint(tss.EC(), big.NewInt(0), big.NewInt(1))
+	assert.NoError(t, err)
+	G := crypto.ScalarBaseMult(tss.EC(), big.NewInt(1))
+
+	assert.True(t, (&SigningCommitment{Hiding: G, Binding: G}).ValidateBasic())
+	assert.False(t, (&SigningCommitment{Hiding: identity, Binding: G}).ValidateBasic())
+	assert.False(t, (&SigningCommitment{Hiding: G, Binding: identity}).ValidateBasic())
+
+	// a point of order 2 is the identity once its torsion has been cleared
+	order2, err := crypto.NewECPoint(tss.EC(), big.NewInt(0), new(big.Int).Sub(tss.EC().Params().P, big.NewInt(1)))
+	assert.NoError(t, err)
+	msg := NewSignRound1Message(tss.NewPartyID("1", "1", big.NewInt(1)), &SigningCommitment{Hiding: order2, Binding: G})
+	_, err = msg.Content().(*SignRound1Message).UnmarshalCommitment()
+	assert.Error(t, err)
+}
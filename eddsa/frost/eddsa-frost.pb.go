@@ -0,0 +1,148 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: protob/eddsa-frost.proto
+
+package frost
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// Represents a BROADCAST message sent to all parties during Round 1 of the FROST signing protocol.
+// It carries the hiding and binding nonce commitments (D_i, E_i) of the sender.
+type SignRound1Message struct {
+	HidingX              []byte   `protobuf:"bytes,1,opt,name=hiding_x,json=hidingX,proto3" json:"hiding_x,omitempty"`
+	HidingY              []byte   `protobuf:"bytes,2,opt,name=hiding_y,json=hidingY,proto3" json:"hiding_y,omitempty"`
+	BindingX             []byte   `protobuf:"bytes,3,opt,name=binding_x,json=bindingX,proto3" json:"binding_x,omitempty"`
+	BindingY             []byte   `protobuf:"bytes,4,opt,name=binding_y,json=bindingY,proto3" json:"binding_y,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignRound1Message) Reset()         { *m = SignRound1Message{} }
+func (m *SignRound1Message) String() string { return proto.CompactTextString(m) }
+func (*SignRound1Message) ProtoMessage()    {}
+func (*SignRound1Message) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc20aa2c49c388dc, []int{0}
+}
+
+func (m *SignRound1Message) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignRound1Message.Unmarshal(m, b)
+}
+func (m *SignRound1Message) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignRound1Message.Marshal(b, m, deterministic)
+}
+func (m *SignRound1Message) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignRound1Message.Merge(m, src)
+}
+func (m *SignRound1Message) XXX_Size() int {
+	return xxx_messageInfo_SignRound1Message.Size(m)
+}
+func (m *SignRound1Message) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignRound1Message.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignRound1Message proto.InternalMessageInfo
+
+func (m *SignRound1Message) GetHidingX() []byte {
+	if m != nil {
+		return m.HidingX
+	}
+	return nil
+}
+
+func (m *SignRound1Message) GetHidingY() []byte {
+	if m != nil {
+		return m.HidingY
+	}
+	return nil
+}
+
+func (m *SignRound1Message) GetBindingX() []byte {
+	if m != nil {
+		return m.BindingX
+	}
+	return nil
+}
+
+func (m *SignRound1Message) GetBindingY() []byte {
+	if m != nil {
+		return m.BindingY
+	}
+	return nil
+}
+
+// Represents a BROADCAST message sent to all parties during Round 2 of the FROST signing protocol.
+// It carries the signature share z_i of the sender.
+type SignRound2Message struct {
+	Z                    []byte   `protobuf:"bytes,1,opt,name=z,proto3" json:"z,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignRound2Message) Reset()         { *m = SignRound2Message{} }
+func (m *SignRound2Message) String() string { return proto.CompactTextString(m) }
+func (*SignRound2Message) ProtoMessage()    {}
+func (*SignRound2Message) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc20aa2c49c388dc, []int{1}
+}
+
+func (m *SignRound2Message) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignRound2Message.Unmarshal(m, b)
+}
+func (m *SignRound2Message) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignRound2Message.Marshal(b, m, deterministic)
+}
+func (m *SignRound2Message) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignRound2Message.Merge(m, src)
+}
+func (m *SignRound2Message) XXX_Size() int {
+	return xxx_messageInfo_SignRound2Message.Size(m)
+}
+func (m *SignRound2Message) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignRound2Message.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignRound2Message proto.InternalMessageInfo
+
+func (m *SignRound2Message) GetZ() []byte {
+	if m != nil {
+		return m.Z
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SignRound1Message)(nil), "SignRound1Message")
+	proto.RegisterType((*SignRound2Message)(nil), "SignRound2Message")
+}
+
+func init() { proto.RegisterFile("protob/eddsa-frost.proto", fileDescriptor_cc20aa2c49c388dc) }
+
+var fileDescriptor_cc20aa2c49c388dc = []byte{
+	// 151 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x28, 0x28, 0xca, 0x2f,
+	0xc9, 0x4f, 0xd2, 0x4f, 0x4d, 0x49, 0x29, 0x4e, 0xd4, 0x4d, 0x2b, 0xca, 0x2f, 0x2e, 0xd1, 0x03,
+	0x0b, 0x29, 0x35, 0x33, 0x72, 0x09, 0x06, 0x67, 0xa6, 0xe7, 0x05, 0xe5, 0x97, 0xe6, 0xa5, 0x18,
+	0xfa, 0xa6, 0x16, 0x17, 0x27, 0xa6, 0xa7, 0x0a, 0x49, 0x72, 0x71, 0x64, 0x64, 0xa6, 0x64, 0xe6,
+	0xa5, 0xc7, 0x57, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0xf0, 0x04, 0xb1, 0x43, 0xf8, 0x11, 0x48, 0x52,
+	0x95, 0x12, 0x4c, 0xc8, 0x52, 0x91, 0x42, 0xd2, 0x5c, 0x9c, 0x49, 0x99, 0x79, 0x50, 0x6d, 0xcc,
+	0x60, 0x39, 0x0e, 0xa8, 0x40, 0x04, 0xb2, 0x64, 0xa5, 0x04, 0x0b, 0x8a, 0x64, 0xa4, 0x92, 0x22,
+	0x92, 0x23, 0x8c, 0x60, 0x8e, 0xe0, 0xe1, 0x62, 0xac, 0x82, 0xda, 0xce, 0x58, 0xe5, 0xc4, 0x1b,
+	0xc5, 0x0d, 0x76, 0xbd, 0x3e, 0xd8, 0xf5, 0x49, 0x6c, 0x60, 0xe7, 0x1b, 0x03, 0x06, 0x00, 0x41,
+	0x18, 0x41, 0xa2, 0xda, 0x00, 0x00, 0x00,
+}
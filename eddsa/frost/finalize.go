@@ -0,0 +1,79 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package frost
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+func (round *finalization) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 3
+	round.started = true
+	round.resetOK()
+
+	// 1. verify each signature share so that a bad signer is identified instead of only failing the signature
+	modQ := common.ModInt(tss.EC().Params().N)
+	z := round.temp.zi
+	culprits := make([]*tss.PartyID, 0, len(round.Parties().IDs()))
+	for j, Pj := range round.Parties().IDs() {
+		round.ok[j] = true
+		if j == round.PartyID().Index {
+			continue
+		}
+		zj := round.temp.signRound2Messages[j].Content().(*SignRound2Message).UnmarshalZ()
+		lambda, err := interpolatingValue(round.key.Ks, round.key.Ks[j])
+		if err != nil {
+			return round.WrapError(err)
+		}
+		rho := round.temp.bindingFactors[identifier(round.key.Ks[j]).String()]
+		if !VerifySignatureShare(zj, round.key.BigXj[j], round.temp.commitments[j], rho, lambda, round.temp.c) {
+			culprits = append(culprits, Pj)
+			continue
+		}
+		z = modQ.Add(z, zj)
+	}
+	if len(culprits) > 0 {
+		return round.WrapError(errors.New("signature share verification failed"), culprits...)
+	}
+
+	// 2. aggregate; the signature is the standard 64-byte Ed25519 encoding R || z
+	rBz, sBz := serializeElement(round.temp.R), serializeScalar(z)
+	round.data.Signature = append(rBz, sBz...)
+	round.data.R = rBz
+	round.data.S = sBz
+	round.data.M = round.temp.m
+
+	pubKey := ed25519.PublicKey(serializeElement(round.key.EDDSAPub))
+	if ok := ed25519.Verify(pubKey, round.temp.m, round.data.Signature); !ok {
+		return round.WrapError(fmt.Errorf("signature verification failed"))
+	}
+	round.end <- *round.data
+
+	return nil
+}
+
+func (round *finalization) CanAccept(msg tss.ParsedMessage) bool {
+	// not expecting any incoming messages in this round
+	return false
+}
+
+func (round *finalization) Update() (bool, *tss.Error) {
+	// not expecting any incoming messages in this round
+	return false, nil
+}
+
+func (round *finalization) NextRound() tss.Round {
+	return nil // finished!
+}
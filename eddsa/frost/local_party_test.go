@@ -0,0 +1,214 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package frost
+
+import (
+	"crypto/ed25519"
+	"math/big"
+	"sync/atomic"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/edwards/v2"
+	"github.com/ipfs/go-log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/eddsa/keygen"
+	"github.com/binance-chain/tss-lib/test"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+const (
+	testParticipants = test.TestParticipants
+	testThreshold    = test.TestThreshold
+)
+
+func setUp(level string) {
+	if err := log.SetLogLevel("tss-lib", level); err != nil {
+		panic(err)
+	}
+}
+
+func TestE2EConcurrent(t *testing.T) {
+	setUp("info")
+
+	tss.SetCurve(edwards.Edwards())
+
+	threshold := testThreshold
+
+	// PHASE: load keygen fixtures
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	assert.Equal(t, testThreshold+1, len(keys))
+	assert.Equal(t, testThreshold+1, len(signPIDs))
+
+	// PHASE: preprocessing (half of the signers use preprocessed nonces)
+	nonces := make([]*SigningNonces, len(signPIDs))
+	for i := 0; i < len(signPIDs); i += 2 {
+		preprocessed, err := Preprocess(keys[i], 1)
+		assert.NoError(t, err)
+		nonces[i] = preprocessed[0]
+	}
+
+	// PHASE: signing
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	parties := make([]*LocalParty, 0, len(signPIDs))
+
+	errCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan common.SignatureData, len(signPIDs))
+
+	updater := test.SharedPartyUpdater
+
+	// a leading zero byte must be preserved
+	msg := []byte{0x00, 0x01, 0x02, 0x03}
+	// init the parties
+	for i := 0; i < len(signPIDs); i++ {
+		params := tss.NewParameters(p2pCtx, signPIDs[i], len(signPIDs), threshold)
+
+		var P *LocalParty
+		if nonces[i] != nil {
+			P = NewLocalParty(msg, params, keys[i], outCh, endCh, nonces[i]).(*LocalParty)
+		} else {
+			P = NewLocalParty(msg, params, keys[i], outCh, endCh).(*LocalParty)
+		}
+		parties = append(parties, P)
+		go func(P *LocalParty) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+
+	var ended int32
+signing:
+	for {
+		select {
+		case err := <-errCh:
+			common.Logger.Errorf("Error: %s", err)
+			assert.FailNow(t, err.Error())
+			break signing
+
+		case msg := <-outCh:
+			dest := msg.GetTo()
+			if dest == nil {
+				for _, P := range parties {
+					if P.PartyID().Index == msg.GetFrom().Index {
+						continue
+					}
+					go updater(P, msg, errCh)
+				}
+			} else {
+				if dest[0].Index == msg.GetFrom().Index {
+					t.Fatalf("party %d tried to send a message to itself (%d)", dest[0].Index, msg.GetFrom().Index)
+				}
+				go updater(parties[dest[0].Index], msg, errCh)
+			}
+
+		case data := <-endCh:
+			atomic.AddInt32(&ended, 1)
+			if atomic.LoadInt32(&ended) == int32(len(signPIDs)) {
+				t.Logf("Done. Received signature data from %d participants", ended)
+
+				// the nonces were cleared after use and cannot be used to sign again
+				for _, n := range nonces {
+					if n != nil {
+						assert.True(t, n.used())
+					}
+				}
+
+				pubKey := ed25519.PublicKey(serializeElement(keys[0].EDDSAPub))
+				assert.True(t, ed25519.Verify(pubKey, msg, data.Signature), "ed25519 verify must pass")
+				assert.Equal(t, msg, data.M)
+				t.Log("FROST signing test done.")
+
+				break signing
+			}
+		}
+	}
+}
+
+func TestE2EConcurrentBadSignatureShare(t *testing.T) {
+	setUp("info")
+
+	tss.SetCurve(edwards.Edwards())
+
+	threshold := testThreshold
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	parties := make([]*LocalParty, 0, len(signPIDs))
+
+	errCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan common.SignatureData, len(signPIDs))
+
+	updater := test.SharedPartyUpdater
+
+	msg := []byte("frost")
+	for i := 0; i < len(signPIDs); i++ {
+		params := tss.NewParameters(p2pCtx, signPIDs[i], len(signPIDs), threshold)
+		P := NewLocalParty(msg, params, keys[i], outCh, endCh).(*LocalParty)
+		parties = append(parties, P)
+		go func(P *LocalParty) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+
+	// the first signer sends a corrupted signature share to everyone
+	badParty := signPIDs[0]
+
+	var failed int32
+	for {
+		select {
+		case err := <-errCh:
+			assert.Equal(t, 3, err.Round())
+			if assert.Len(t, err.Culprits(), 1) {
+				assert.Equal(t, badParty.Id, err.Culprits()[0].Id)
+			}
+			// every honest signer identifies the bad signer
+			if atomic.AddInt32(&failed, 1) == int32(len(signPIDs)-1) {
+				return
+			}
+
+		case msg := <-outCh:
+			if r2msg, ok := msg.(tss.ParsedMessage).Content().(*SignRound2Message); ok && msg.GetFrom().Index == badParty.Index {
+				z := new(big.Int).Add(r2msg.UnmarshalZ(), big.NewInt(1))
+				msg = NewSignRound2Message(badParty, z)
+			}
+			for _, P := range parties {
+				if P.PartyID().Index == msg.GetFrom().Index {
+					continue
+				}
+				go updater(P, msg, errCh)
+			}
+
+		case <-endCh:
+			// the bad signer aggregates its own honest share and may finish
+		}
+	}
+}
+
+func TestPreprocessedNoncesCannotBeReused(t *testing.T) {
+	tss.SetCurve(edwards.Edwards())
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 1)
+	assert.NoError(t, err, "should load keygen fixtures")
+	nonces, err := Preprocess(keys[0], 2)
+	assert.NoError(t, err)
+	assert.False(t, nonces[0].Commitment.Hiding.Equals(nonces[1].Commitment.Hiding))
+
+	nonces[0].clear()
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	params := tss.NewParameters(p2pCtx, signPIDs[0], len(signPIDs), testThreshold)
+	P := NewLocalParty([]byte("frost"), params, keys[0], make(chan tss.Message, len(signPIDs)), make(chan common.SignatureData, 1), nonces[0])
+	assert.NotNil(t, P.Start(), "used nonces must be rejected")
+}
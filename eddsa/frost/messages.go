@@ -0,0 +1,113 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package frost
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+// These messages were generated from Protocol Buffers definitions into eddsa-frost.pb.go
+// The following messages are registered on the Protocol Buffers "wire"
+
+var (
+	// Ensure that signing messages implement ValidateBasic
+	_ = []tss.MessageContent{
+		(*SignRound1Message)(nil),
+		(*SignRound2Message)(nil),
+	}
+)
+
+func init() {
+	proto.RegisterType((*SignRound1Message)(nil), tss.EDDSAProtoNamePrefix+"frost.SignRound1Message")
+	proto.RegisterType((*SignRound2Message)(nil), tss.EDDSAProtoNamePrefix+"frost.SignRound2Message")
+}
+
+// ----- //
+
+func NewSignRound1Message(
+	from *tss.PartyID,
+	commitment *SigningCommitment,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &SignRound1Message{
+		HidingX:  commitment.Hiding.X().Bytes(),
+		HidingY:  commitment.Hiding.Y().Bytes(),
+		BindingX: commitment.Binding.X().Bytes(),
+		BindingY: commitment.Binding.Y().Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *SignRound1Message) ValidateBasic() bool {
+	// the x coordinate of a point may be zero
+	return m != nil &&
+		common.NonEmptyBytes(m.HidingY) &&
+		common.NonEmptyBytes(m.BindingY)
+}
+
+func (m *SignRound1Message) UnmarshalCommitment() (*SigningCommitment, error) {
+	hiding, err := crypto.NewECPoint(
+		tss.EC(),
+		new(big.Int).SetBytes(m.GetHidingX()),
+		new(big.Int).SetBytes(m.GetHidingY()))
+	if err != nil {
+		return nil, err
+	}
+	binding, err := crypto.NewECPoint(
+		tss.EC(),
+		new(big.Int).SetBytes(m.GetBindingX()),
+		new(big.Int).SetBytes(m.GetBindingY()))
+	if err != nil {
+		return nil, err
+	}
+	cmt := &SigningCommitment{
+		Hiding:  hiding.EightInvEight(),
+		Binding: binding.EightInvEight(),
+	}
+	// a point of small order is the identity once its torsion has been cleared
+	if !cmt.ValidateBasic() {
+		return nil, errors.New("a nonce commitment is the identity")
+	}
+	return cmt, nil
+}
+
+// ----- //
+
+func NewSignRound2Message(
+	from *tss.PartyID,
+	z *big.Int,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &SignRound2Message{
+		Z: z.Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *SignRound2Message) ValidateBasic() bool {
+	return m != nil &&
+		common.NonEmptyBytes(m.Z)
+}
+
+func (m *SignRound2Message) UnmarshalZ() *big.Int {
+	return new(big.Int).SetBytes(m.Z)
+}
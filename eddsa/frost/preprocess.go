@@ -0,0 +1,81 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package frost
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+	"github.com/binance-chain/tss-lib/eddsa/keygen"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+// SigningNonces are the secret (hiding, binding) nonces (d_i, e_i) of a participant for a single signing session.
+// They must never be used for more than one signature; a LocalParty clears them once they have been used.
+type SigningNonces struct {
+	Hiding, Binding *big.Int
+	Commitment      *SigningCommitment
+}
+
+// Preprocess runs the preprocessing phase: it generates `count` single-use nonce pairs bound to the key share.
+// The commitments may be published ahead of time; each pair is then passed to one `NewLocalParty`.
+func Preprocess(key keygen.LocalPartySaveData, count int) ([]*SigningNonces, error) {
+	if key.Xi == nil {
+		return nil, errors.New("Preprocess: the save data has no key share")
+	}
+	nonces := make([]*SigningNonces, count)
+	for i := range nonces {
+		n, err := newSigningNonces(key.Xi)
+		if err != nil {
+			return nil, err
+		}
+		nonces[i] = n
+	}
+	return nonces, nil
+}
+
+// newSigningNonces implements commit()
+func newSigningNonces(secret *big.Int) (*SigningNonces, error) {
+	hiding, err := nonceGenerate(secret)
+	if err != nil {
+		return nil, err
+	}
+	binding, err := nonceGenerate(secret)
+	if err != nil {
+		return nil, err
+	}
+	return &SigningNonces{
+		Hiding:  hiding,
+		Binding: binding,
+		Commitment: &SigningCommitment{
+			Hiding:  crypto.ScalarBaseMultSecret(tss.EC(), hiding),
+			Binding: crypto.ScalarBaseMultSecret(tss.EC(), binding),
+		},
+	}, nil
+}
+
+// nonceGenerate implements nonce_generate; the key share is mixed in so that a weak RNG alone does not leak it
+func nonceGenerate(secret *big.Int) (*big.Int, error) {
+	randomBytes := make([]byte, 32)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return nil, err
+	}
+	return h3(randomBytes, serializeScalar(new(big.Int).Mod(secret, tss.EC().Params().N))), nil
+}
+
+// clear zeroes the nonces so that they cannot be used again
+func (n *SigningNonces) clear() {
+	common.Zeroize(n.Hiding, n.Binding)
+}
+
+// used reports whether the nonces have been cleared
+func (n *SigningNonces) used() bool {
+	return n.Hiding.Sign() == 0 || n.Binding.Sign() == 0
+}
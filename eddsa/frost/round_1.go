@@ -0,0 +1,94 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package frost
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/eddsa/keygen"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+// round 1 represents round 1 of FROST signing: each signer broadcasts its nonce commitments
+func newRound1(params *tss.Parameters, key *keygen.LocalPartySaveData, data *common.SignatureData, temp *localTempData, out chan<- tss.Message, end chan<- common.SignatureData) tss.Round {
+	return &round1{
+		&base{params, key, data, temp, out, end, make([]bool, len(params.Parties().IDs())), false, 1}}
+}
+
+func (round *round1) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+
+	round.number = 1
+	round.started = true
+	round.resetOK()
+
+	// 1. use the preprocessed nonces or generate a fresh pair
+	if round.temp.nonces == nil {
+		nonces, err := newSigningNonces(round.key.Xi)
+		if err != nil {
+			return round.WrapError(err)
+		}
+		round.temp.nonces = nonces
+	}
+
+	i := round.PartyID().Index
+	round.ok[i] = true
+	round.temp.commitments[i] = round.temp.nonces.Commitment
+
+	// 2. broadcast the commitments (D_i, E_i)
+	r1msg := NewSignRound1Message(round.PartyID(), round.temp.nonces.Commitment)
+	round.temp.signRound1Messages[i] = r1msg
+	round.out <- r1msg
+
+	return nil
+}
+
+func (round *round1) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.signRound1Messages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round1) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*SignRound1Message); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round1) NextRound() tss.Round {
+	round.started = false
+	return &round2{round}
+}
+
+// ----- //
+
+func (round *round1) prepare() error {
+	if round.Threshold()+1 > len(round.key.Ks) {
+		return fmt.Errorf("t+1=%d is not satisfied by the key count of %d", round.Threshold()+1, len(round.key.Ks))
+	}
+	if nonces := round.temp.nonces; nonces != nil {
+		if nonces.Commitment == nil || nonces.Hiding == nil || nonces.Binding == nil {
+			return errors.New("the preprocessed nonces are incomplete")
+		}
+		if nonces.used() {
+			return errors.New("the preprocessed nonces have already been used")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package frost
+
+import (
+	"errors"
+
+	errors2 "github.com/pkg/errors"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+// round 2 represents round 2 of FROST signing: each signer broadcasts its signature share
+func (round *round2) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 2
+	round.started = true
+	round.resetOK()
+
+	i := round.PartyID().Index
+
+	// 1. store r1 message pieces
+	culprits := make([]*tss.PartyID, 0, len(round.Parties().IDs()))
+	for j, msg := range round.temp.signRound1Messages {
+		if j == i {
+			continue
+		}
+		commitment, err := msg.Content().(*SignRound1Message).UnmarshalCommitment()
+		if err != nil {
+			culprits = append(culprits, msg.GetFrom())
+			continue
+		}
+		round.temp.commitments[j] = commitment
+	}
+	if len(culprits) > 0 {
+		return round.WrapError(errors.New("failed to unmarshal the nonce commitments"), culprits...)
+	}
+
+	// 2. compute the binding factors, the group commitment R and the challenge c
+	list := newCommitmentList(round.key.Ks, round.temp.commitments)
+	round.temp.bindingFactors = list.bindingFactors(round.key.EDDSAPub, round.temp.m)
+	R, err := list.groupCommitment(round.temp.bindingFactors)
+	if err != nil {
+		return round.WrapError(errors2.Wrapf(err, "groupCommitment()"))
+	}
+	round.temp.R = R
+	round.temp.c = computeChallenge(R, round.key.EDDSAPub, round.temp.m)
+
+	// 3. compute z_i = d_i + e_i*rho_i + lambda_i*x_i*c
+	lambda, err := interpolatingValue(round.key.Ks, round.key.Ks[i])
+	if err != nil {
+		return round.WrapError(err)
+	}
+	modQ := common.ModInt(tss.EC().Params().N)
+	rho := round.temp.bindingFactors[identifier(round.key.Ks[i]).String()]
+	nonces := round.temp.nonces
+	zi := modQ.Add(nonces.Hiding, modQ.Mul(nonces.Binding, rho))
+	zi = modQ.Add(zi, modQ.Mul(lambda, modQ.Mul(round.key.Xi, round.temp.c)))
+	round.temp.zi = zi
+
+	// security: the nonces must never be used again
+	nonces.clear()
+
+	// 4. broadcast z_i
+	r2msg := NewSignRound2Message(round.PartyID(), zi)
+	round.temp.signRound2Messages[i] = r2msg
+	round.out <- r2msg
+
+	return nil
+}
+
+func (round *round2) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*SignRound2Message); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round2) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.signRound2Messages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round2) NextRound() tss.Round {
+	round.started = false
+	return &finalization{round}
+}
@@ -137,7 +137,6 @@ keygen:
 					assert.NoError(t, err, "vss.ReConstruct should not throw error")
 
 					// uG test: u*G[j] == V[0]
-					assert.Equal(t, uj, Pj.temp.ui)
 					uG := crypto.ScalarBaseMult(tss.EC(), uj)
 					assert.True(t, uG.Equals(Pj.temp.vs[0]), "ensure u*G[j] == V_0")
 
@@ -151,9 +150,10 @@ keygen:
 					{
 						badShares := pShares[:threshold]
 						badShares[len(badShares)-1].Share.Set(big.NewInt(0))
+						goodUj := uj
 						uj, err := pShares[:threshold].ReConstruct()
 						assert.NoError(t, err)
-						assert.NotEqual(t, parties[j].temp.ui, uj)
+						assert.NotEqual(t, goodUj, uj)
 						BigXjX, BigXjY := tss.EC().ScalarBaseMult(uj.Bytes())
 						assert.NotEqual(t, BigXjX, Pj.temp.vs[0].X())
 						assert.NotEqual(t, BigXjY, Pj.temp.vs[0].Y())
@@ -59,14 +59,16 @@ func (round *round3) Start() *tss.Error {
 		if i == PIdx {
 			continue
 		}
-		chs[i] = make(chan vssOut)
+		chs[i] = make(chan vssOut, 1)
 	}
+	verifiers := round.VerifierPool()
 	for j := range Ps {
 		if j == PIdx {
 			continue
 		}
 		// 6-9.
-		go func(j int, ch chan<- vssOut) {
+		j, ch := j, chs[j]
+		verifiers.Go(func() {
 			// 4-10.
 			KGCj := round.temp.KGCs[j]
 			r2msg2 := round.temp.kgRound2Message2s[j].Content().(*KGRound2Message2)
@@ -107,10 +109,10 @@ func (round *round3) Start() *tss.Error {
 			}
 			// (9) handled above
 			ch <- vssOut{nil, PjVs}
-		}(j, chs[j])
+		})
 	}
 
-	// consume unbuffered channels (end the goroutines)
+	// consume the channels (end the goroutines)
 	vssResults := make([]vssOut, len(Ps))
 	{
 		culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
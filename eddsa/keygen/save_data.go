@@ -42,6 +42,16 @@ func NewLocalPartySaveData(partyCount int) (saveData LocalPartySaveData) {
 	return
 }
 
+// Zeroize overwrites the key share
+func (secrets LocalSecrets) Zeroize() {
+	common.Zeroize(secrets.Xi)
+}
+
+// Zeroize overwrites the secrets in the save data, see `ecdsa/keygen.LocalPartySaveData.Zeroize`
+func (save LocalPartySaveData) Zeroize() {
+	save.LocalSecrets.Zeroize()
+}
+
 // BuildLocalSaveDataSubset re-creates the LocalPartySaveData to contain data for only the list of signing parties.
 func BuildLocalSaveDataSubset(sourceData LocalPartySaveData, sortedIDs tss.SortedPartyIDs) LocalPartySaveData {
 	keysToIndices := make(map[string]int, len(sourceData.Ks))
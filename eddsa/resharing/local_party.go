@@ -21,6 +21,7 @@ import (
 // Implements Party
 // Implements Stringer
 var _ tss.Party = (*LocalParty)(nil)
+var _ tss.Zeroizer = (*LocalParty)(nil)
 var _ fmt.Stringer = (*LocalParty)(nil)
 
 type (
@@ -117,17 +118,10 @@ func (p *LocalParty) ValidateMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 	if ok, err := p.BaseParty.ValidateMessage(msg); !ok || err != nil {
 		return ok, err
 	}
-	// check that the message's "from index" will fit into the array
-	var maxFromIdx int
-	switch msg.Content().(type) {
-	case *DGRound2Message, *DGRound4Message:
-		maxFromIdx = len(p.params.NewParties().IDs()) - 1
-	default:
-		maxFromIdx = len(p.params.OldParties().IDs()) - 1
-	}
-	if maxFromIdx < msg.GetFrom().Index {
-		return false, p.WrapError(fmt.Errorf("received msg with a sender index too great (%d <= %d)",
-			maxFromIdx, msg.GetFrom().Index), msg.GetFrom())
+	// check that the sender is a member of the committee that sends this type of message
+	if p.committeeIndexOf(msg) < 0 {
+		return false, p.WrapError(fmt.Errorf("received msg from a party that is not a member of the sending committee (%s)",
+			msg.GetFrom()), msg.GetFrom())
 	}
 	return true, nil
 }
@@ -137,7 +131,9 @@ func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 	if ok, err := p.ValidateMessage(msg); !ok || err != nil {
 		return ok, err
 	}
-	fromPIdx := msg.GetFrom().Index
+	// a party that is a member of both committees has a different index in each of them,
+	// so the sender's position is looked up in the committee that sends this type of message.
+	fromPIdx := p.committeeIndexOf(msg)
 
 	// switch/case is necessary to store any messages beyond current round
 	// this does not handle message replays. we expect the caller to apply replay and spoofing protection.
@@ -159,6 +155,24 @@ func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 	return true, nil
 }
 
+// committeeIndexOf returns the sender's position in the committee that sends this type of message, or -1
+func (p *LocalParty) committeeIndexOf(msg tss.ParsedMessage) int {
+	switch msg.Content().(type) {
+	case *DGRound2Message, *DGRound4Message:
+		return p.params.NewParties().IDs().IndexOfKey(msg.GetFrom().KeyInt())
+	default:
+		return p.params.OldParties().IDs().IndexOfKey(msg.GetFrom().KeyInt())
+	}
+}
+
+// Zeroize overwrites the shares dealt to the new committee, as well as the new key share if re-sharing did not finish
+func (p *LocalParty) Zeroize() {
+	p.temp.NewShares.Zeroize()
+	if p.Running() {
+		common.Zeroize(p.temp.newXi)
+	}
+}
+
 func (p *LocalParty) PartyID() *tss.PartyID {
 	return p.params.PartyID()
 }
@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/binance-chain/tss-lib/common"
 	"github.com/binance-chain/tss-lib/crypto"
 	"github.com/binance-chain/tss-lib/crypto/commitments"
 	"github.com/binance-chain/tss-lib/crypto/vss"
@@ -36,10 +37,12 @@ func (round *round1) Start() *tss.Error {
 	if !round.ReSharingParams().IsOldCommittee() {
 		return nil
 	}
-	round.allOldOK()
+	// a party that is also a member of the new committee must still receive from the rest of the old committee
+	if !round.ReSharingParams().IsNewCommittee() {
+		round.allOldOK()
+	}
 
-	Pi := round.PartyID()
-	i := Pi.Index
+	i := round.OldPartyIndex()
 
 	// 1. PrepareForSigning() -> w_i
 	xi, ks := round.input.Xi, round.input.Ks
@@ -48,6 +51,7 @@ func (round *round1) Start() *tss.Error {
 	}
 	newKs := round.NewParties().IDs().Keys()
 	wi := signing.PrepareForSigning(i, len(round.OldParties().IDs()), xi, ks)
+	defer common.Zeroize(wi)
 
 	// 2.
 	vi, shares, err := vss.Create(round.NewThreshold(), wi, newKs)
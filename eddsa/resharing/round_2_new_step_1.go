@@ -24,13 +24,16 @@ func (round *round2) Start() *tss.Error {
 	if !round.ReSharingParams().IsNewCommittee() {
 		return nil
 	}
-	round.allNewOK()
+	// a party that is also a member of the old committee must still receive from the rest of the new committee
+	if !round.ReSharingParams().IsOldCommittee() {
+		round.allNewOK()
+	}
 
 	Pi := round.PartyID()
-	i := Pi.Index
+	i := round.NewPartyIndex()
 
 	// 1. "broadcast" "ACK" members of the OLD committee
-	r2msg := NewDGRound2Message(round.OldParties().IDs(), Pi)
+	r2msg := NewDGRound2Message(round.OldParties().IDs().Exclude(Pi), Pi)
 	round.temp.dgRound2Messages[i] = r2msg
 	round.out <- r2msg
 
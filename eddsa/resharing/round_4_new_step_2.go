@@ -34,7 +34,7 @@ func (round *round4) Start() *tss.Error {
 	}
 
 	Pi := round.PartyID()
-	i := Pi.Index
+	i := round.NewPartyIndex()
 
 	// 1.
 	newXi := big.NewInt(0)
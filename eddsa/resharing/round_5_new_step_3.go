@@ -29,8 +29,10 @@ func (round *round5) Start() *tss.Error {
 		round.save.Xi = round.temp.newXi
 		round.save.Ks = round.temp.newKs
 
-	} else if round.IsOldCommittee() {
-		round.input.Xi.SetInt64(0)
+	}
+	if round.IsOldCommittee() {
+		// the old share is discarded, even by a party that is also a member of the new committee
+		round.input.LocalSecrets.Zeroize()
 	}
 
 	round.end <- *round.save
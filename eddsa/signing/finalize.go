@@ -43,7 +43,7 @@ func (round *finalization) Start() *tss.Error {
 	round.data.Signature = append(bigIntToEncodedBytes(round.temp.r)[:], sumS[:]...)
 	round.data.R = round.temp.r.Bytes()
 	round.data.S = s.Bytes()
-	round.data.M = round.temp.m.Bytes()
+	round.data.M = round.temp.m
 
 	pk := edwards.PublicKey{
 		Curve: tss.EC(),
@@ -51,7 +51,13 @@ func (round *finalization) Start() *tss.Error {
 		Y:     round.key.EDDSAPub.Y(),
 	}
 
-	ok := edwards.Verify(&pk, round.temp.m.Bytes(), round.temp.r, s)
+	var ok bool
+	if round.temp.opts.dom2() == nil {
+		ok = edwards.Verify(&pk, round.temp.m, round.temp.r, s)
+	} else {
+		// Ed25519ctx and Ed25519ph: check [s]B == R + [h]A with the challenge h of round 3
+		ok = verifyWithChallenge(&pk, bigIntToEncodedBytes(round.temp.r), s, round.temp.lambda)
+	}
 	if !ok {
 		return round.WrapError(fmt.Errorf("signature verification failed"))
 	}
@@ -60,6 +66,17 @@ func (round *finalization) Start() *tss.Error {
 	return nil
 }
 
+func verifyWithChallenge(pk *edwards.PublicKey, encodedR *[32]byte, s, h *big.Int) bool {
+	R, err := edwards.ParsePubKey(encodedR[:])
+	if err != nil || s.Cmp(tss.EC().Params().N) >= 0 {
+		return false
+	}
+	sBX, sBY := tss.EC().ScalarBaseMult(s.Bytes())
+	hAX, hAY := tss.EC().ScalarMult(pk.X, pk.Y, h.Bytes())
+	x, y := tss.EC().Add(R.X, R.Y, hAX, hAY)
+	return sBX.Cmp(x) == 0 && sBY.Cmp(y) == 0
+}
+
 func (round *finalization) CanAccept(msg tss.ParsedMessage) bool {
 	// not expecting any incoming messages in this round
 	return false
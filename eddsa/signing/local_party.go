@@ -21,6 +21,7 @@ import (
 // Implements Party
 // Implements Stringer
 var _ tss.Party = (*LocalParty)(nil)
+var _ tss.Zeroizer = (*LocalParty)(nil)
 var _ fmt.Stringer = (*LocalParty)(nil)
 
 type (
@@ -46,9 +47,13 @@ type (
 	localTempData struct {
 		localMessageStore
 
+		// optional additive tweak of the key to sign for
+		tweak *big.Int
+
 		// temp data (thrown away after sign) / round 1
+		m    []byte
+		opts *Options
 		wi,
-		m,
 		ri *big.Int
 		pointRi  *crypto.ECPoint
 		deCommit cmt.HashDeCommitment
@@ -58,16 +63,32 @@ type (
 		si  *[32]byte
 
 		// round 3
-		r *big.Int
+		r,
+		lambda *big.Int
 	}
 )
 
+// NewLocalParty returns a party that signs `msg` with Ed25519.
+// The message is encoded with msg.Bytes(), so leading zero bytes are dropped; use `NewLocalPartyWithOptions` to sign raw bytes.
 func NewLocalParty(
 	msg *big.Int,
 	params *tss.Parameters,
 	key keygen.LocalPartySaveData,
 	out chan<- tss.Message,
 	end chan<- common.SignatureData,
+) tss.Party {
+	return NewLocalPartyWithOptions(msg.Bytes(), params, key, nil, out, end)
+}
+
+// NewLocalPartyWithOptions returns a party that signs the raw message `msg` with the RFC 8032 variant selected by `opts`.
+// A nil `opts` selects plain Ed25519; see `Options` for Ed25519ctx and Ed25519ph.
+func NewLocalPartyWithOptions(
+	msg []byte,
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	opts *Options,
+	out chan<- tss.Message,
+	end chan<- common.SignatureData,
 ) tss.Party {
 	partyCount := len(params.Parties().IDs())
 	p := &LocalParty{
@@ -86,10 +107,26 @@ func NewLocalParty(
 
 	// temp data init
 	p.temp.m = msg
+	p.temp.opts = opts
 	p.temp.cjs = make([]*big.Int, partyCount)
 	return p
 }
 
+// NewLocalPartyWithTweak returns a party that signs under the tweaked public key `key.EDDSAPub + tweak*G`.
+// All signers must use the same tweak; see `TweakPublicKey`.
+func NewLocalPartyWithTweak(
+	msg *big.Int,
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	tweak *big.Int,
+	out chan<- tss.Message,
+	end chan<- common.SignatureData,
+) tss.Party {
+	p := NewLocalParty(msg, params, key, out, end).(*LocalParty)
+	p.temp.tweak = tweak
+	return p
+}
+
 func (p *LocalParty) FirstRound() tss.Round {
 	return newRound1(p.params, &p.keys, &p.data, &p.temp, p.out, p.end)
 }
@@ -157,6 +194,11 @@ func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 	return true, nil
 }
 
+// Zeroize overwrites the additive share wi and the nonce share ri
+func (p *LocalParty) Zeroize() {
+	common.Zeroize(p.temp.wi, p.temp.ri)
+}
+
 func (p *LocalParty) PartyID() *tss.PartyID {
 	return p.params.PartyID()
 }
@@ -7,6 +7,7 @@
 package signing
 
 import (
+	"crypto/sha512"
 	"fmt"
 	"math/big"
 	"sync/atomic"
@@ -141,3 +142,184 @@ signing:
 		}
 	}
 }
+
+func TestE2EConcurrentWithTweak(t *testing.T) {
+	setUp("info")
+
+	tss.SetCurve(edwards.Edwards())
+
+	threshold := testThreshold
+
+	// PHASE: load keygen fixtures
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	tweak := common.GetRandomPositiveInt(tss.EC().Params().N)
+	tweakedPub, err := TweakPublicKey(keys[0].EDDSAPub, tweak)
+	assert.NoError(t, err)
+
+	// PHASE: signing
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	parties := make([]*LocalParty, 0, len(signPIDs))
+
+	errCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan common.SignatureData, len(signPIDs))
+
+	updater := test.SharedPartyUpdater
+
+	msg := big.NewInt(200)
+	// init the parties
+	for i := 0; i < len(signPIDs); i++ {
+		params := tss.NewParameters(p2pCtx, signPIDs[i], len(signPIDs), threshold)
+
+		P := NewLocalPartyWithTweak(msg, params, keys[i], tweak, outCh, endCh).(*LocalParty)
+		parties = append(parties, P)
+		go func(P *LocalParty) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+
+	var ended int32
+signing:
+	for {
+		select {
+		case err := <-errCh:
+			common.Logger.Errorf("Error: %s", err)
+			assert.FailNow(t, err.Error())
+			break signing
+
+		case msg := <-outCh:
+			dest := msg.GetTo()
+			if dest == nil {
+				for _, P := range parties {
+					if P.PartyID().Index == msg.GetFrom().Index {
+						continue
+					}
+					go updater(P, msg, errCh)
+				}
+			} else {
+				go updater(parties[dest[0].Index], msg, errCh)
+			}
+
+		case data := <-endCh:
+			atomic.AddInt32(&ended, 1)
+			if atomic.LoadInt32(&ended) == int32(len(signPIDs)) {
+				sig, err := edwards.ParseSignature(data.Signature)
+				assert.NoError(t, err)
+
+				// the signature verifies under the tweaked key only
+				pk := edwards.PublicKey{
+					Curve: tss.EC(),
+					X:     tweakedPub.X(),
+					Y:     tweakedPub.Y(),
+				}
+				assert.True(t, edwards.Verify(&pk, msg.Bytes(), sig.R, sig.S), "eddsa verify must pass for the tweaked key")
+				pk.X, pk.Y = keys[0].EDDSAPub.X(), keys[0].EDDSAPub.Y()
+				assert.False(t, edwards.Verify(&pk, msg.Bytes(), sig.R, sig.S), "eddsa verify must fail for the untweaked key")
+				break signing
+			}
+		}
+	}
+}
+
+func TestE2EConcurrentWithOptions(t *testing.T) {
+	setUp("info")
+
+	tss.SetCurve(edwards.Edwards())
+
+	threshold := testThreshold
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	pk := &edwards.PublicKey{Curve: tss.EC(), X: keys[0].EDDSAPub.X(), Y: keys[0].EDDSAPub.Y()}
+
+	// RFC 8032 section 7.3 signs SHA-512("abc") with Ed25519ph
+	abcDigest := sha512.Sum512([]byte("abc"))
+	cases := []struct {
+		name      string
+		msg       []byte
+		opts      *Options
+		preHashed bool
+		context   string
+	}{
+		{"Ed25519", []byte{0x00, 0x00, 0x72}, nil, false, ""},
+		{"Ed25519ctx", []byte{0xf7, 0x26, 0x93, 0x6d}, &Options{Context: "foo"}, false, "foo"},
+		{"Ed25519ph", abcDigest[:], &Options{PreHashed: true}, true, ""},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			p2pCtx := tss.NewPeerContext(signPIDs)
+			parties := make([]*LocalParty, 0, len(signPIDs))
+
+			errCh := make(chan *tss.Error, len(signPIDs))
+			outCh := make(chan tss.Message, len(signPIDs))
+			endCh := make(chan common.SignatureData, len(signPIDs))
+
+			updater := test.SharedPartyUpdater
+
+			for i := 0; i < len(signPIDs); i++ {
+				params := tss.NewParameters(p2pCtx, signPIDs[i], len(signPIDs), threshold)
+				P := NewLocalPartyWithOptions(c.msg, params, keys[i], c.opts, outCh, endCh).(*LocalParty)
+				parties = append(parties, P)
+				go func(P *LocalParty) {
+					if err := P.Start(); err != nil {
+						errCh <- err
+					}
+				}(P)
+			}
+
+			var ended int32
+			for {
+				select {
+				case err := <-errCh:
+					assert.FailNow(t, err.Error())
+
+				case msg := <-outCh:
+					for _, P := range parties {
+						if P.PartyID().Index == msg.GetFrom().Index {
+							continue
+						}
+						go updater(P, msg, errCh)
+					}
+
+				case data := <-endCh:
+					if atomic.AddInt32(&ended, 1) < int32(len(signPIDs)) {
+						continue
+					}
+					assert.Equal(t, c.msg, data.M, "the message is kept byte for byte")
+					assert.True(t, verifyRFC8032(pk, c.msg, data.Signature, c.preHashed, c.context), "eddsa verify must pass")
+					assert.False(t, verifyRFC8032(pk, c.msg, data.Signature, c.preHashed, "bar"), "another context must not verify")
+					return
+				}
+			}
+		})
+	}
+}
+
+// verifyRFC8032 verifies an Ed25519, Ed25519ctx or Ed25519ph signature following RFC 8032 section 5.1.7, with the
+// challenge computed here rather than by the package; crypto/ed25519 only verifies the last two from Go 1.20
+func verifyRFC8032(pk *edwards.PublicKey, msg, sig []byte, preHashed bool, context string) bool {
+	if len(sig) != 64 {
+		return false
+	}
+	h := sha512.New()
+	if preHashed || context != "" {
+		phFlag := byte(0)
+		if preHashed {
+			phFlag = 1
+		}
+		h.Write([]byte("SigEd25519 no Ed25519 collisions"))
+		h.Write([]byte{phFlag, byte(len(context))})
+		h.Write([]byte(context))
+	}
+	h.Write(sig[:32])
+	h.Write(ecPointToEncodedBytes(pk.X, pk.Y)[:])
+	h.Write(msg)
+	var digest [64]byte
+	h.Sum(digest[:0])
+	var k [32]byte
+	edwards25519.ScReduce(&k, &digest)
+	return verifyWithChallenge(pk, copyBytes(sig[:32]), encodedBytesToBigInt(copyBytes(sig[32:])), encodedBytesToBigInt(&k))
+}
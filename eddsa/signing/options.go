@@ -0,0 +1,55 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"crypto/sha512"
+	"errors"
+)
+
+// Options selects the RFC 8032 signature variant: Ed25519 (the zero value), Ed25519ctx or Ed25519ph.
+// https://tools.ietf.org/html/rfc8032#section-5.1
+type Options struct {
+	// PreHashed selects Ed25519ph; the message to sign must then be the 64-byte SHA-512 digest of the original message
+	PreHashed bool
+
+	// Context is the context string of Ed25519ctx (when non-empty and not PreHashed) or Ed25519ph; at most 255 bytes
+	Context string
+}
+
+const (
+	dom2Prefix = "SigEd25519 no Ed25519 collisions"
+)
+
+// Validate checks the options against the message to sign
+func (opts *Options) Validate(msg []byte) error {
+	if opts == nil {
+		return nil
+	}
+	if len(opts.Context) > 255 {
+		return errors.New("the context string must be at most 255 bytes")
+	}
+	if opts.PreHashed && len(msg) != sha512.Size {
+		return errors.New("Ed25519ph expects the message to be its 64-byte SHA-512 digest")
+	}
+	return nil
+}
+
+// dom2 returns the domain separation prefix hashed before R || A || M; it is empty for plain Ed25519
+func (opts *Options) dom2() []byte {
+	if opts == nil || (!opts.PreHashed && opts.Context == "") {
+		return nil
+	}
+	phFlag := byte(0)
+	if opts.PreHashed {
+		phFlag = 1
+	}
+	dom := make([]byte, 0, len(dom2Prefix)+2+len(opts.Context))
+	dom = append(dom, dom2Prefix...)
+	dom = append(dom, phFlag, byte(len(opts.Context)))
+	return append(dom, opts.Context...)
+}
@@ -11,11 +11,13 @@ import (
 	"math/big"
 
 	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
 	"github.com/binance-chain/tss-lib/tss"
 )
 
 // PrepareForSigning(), Fig. 7
-func PrepareForSigning(i, pax int, xi *big.Int, ks []*big.Int) (wi *big.Int) {
+// An optional additive `tweak` t may be provided to sign under the public key A + t*G (see `TweakPublicKey`).
+func PrepareForSigning(i, pax int, xi *big.Int, ks []*big.Int, optionalTweak ...*big.Int) (wi *big.Int) {
 	modQ := common.ModInt(tss.EC().Params().N)
 	if len(ks) != pax {
 		panic(fmt.Errorf("PrepareForSigning: len(ks) != pax (%d != %d)", len(ks), pax))
@@ -23,9 +25,17 @@ func PrepareForSigning(i, pax int, xi *big.Int, ks []*big.Int) (wi *big.Int) {
 	if len(ks) <= i {
 		panic(fmt.Errorf("PrepareForSigning: len(ks) <= i (%d <= %d)", len(ks), i))
 	}
+	if 1 < len(optionalTweak) {
+		panic(fmt.Errorf("PrepareForSigning: expected 0 or 1 item in `optionalTweak`"))
+	}
+
+	// 0. apply the tweak; the lagrange coefficients sum to 1 so the wi then sum to x + t
+	if 0 < len(optionalTweak) && optionalTweak[0] != nil {
+		xi = modQ.Add(xi, optionalTweak[0])
+	}
 
 	// 1-4.
-	wi = xi
+	wi = new(big.Int).Set(xi) // not aliased, so that it may be zeroized
 	for j := 0; j < pax; j++ {
 		if j == i {
 			continue
@@ -37,3 +47,13 @@ func PrepareForSigning(i, pax int, xi *big.Int, ks []*big.Int) (wi *big.Int) {
 
 	return
 }
+
+// TweakPublicKey returns A + t*G, the public key that a signature made with the additive `tweak` t verifies under
+func TweakPublicKey(pubKey *crypto.ECPoint, tweak *big.Int) (*crypto.ECPoint, error) {
+	tweak = new(big.Int).Mod(tweak, tss.EC().Params().N)
+	if tweak.Sign() == 0 {
+		// t*G is the point at infinity, which has no ECPoint
+		return pubKey, nil
+	}
+	return pubKey.Add(crypto.ScalarBaseMult(tss.EC(), tweak))
+}
@@ -0,0 +1,36 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/edwards/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/eddsa/keygen"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+func TestZeroTweak(t *testing.T) {
+	tss.SetCurve(edwards.Edwards())
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 1)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	for _, tweak := range []*big.Int{big.NewInt(0), tss.EC().Params().N} {
+		tweakedPub, err := TweakPublicKey(keys[0].EDDSAPub, tweak)
+		if assert.NoError(t, err) {
+			assert.True(t, keys[0].EDDSAPub.Equals(tweakedPub), "a tweak of 0 mod N leaves the key unchanged")
+		}
+		params := tss.NewParameters(tss.NewPeerContext(pIDs), pIDs[0], len(pIDs), testThreshold)
+		P := NewLocalPartyWithTweak(big.NewInt(42), params, keys[0], tweak,
+			make(chan tss.Message, 2*len(pIDs)), make(chan common.SignatureData, 1))
+		assert.Nil(t, P.Start())
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/decred/dcrd/dcrec/edwards/v2"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/eddsa/keygen"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+// NewRobustParty returns a session that signs `msg` with threshold+1 of the parties of `params`, and that leaves out
+// the parties that are offline or misbehave in one attempt from the next one; see tss.RobustSession.
+// The parties of `params` are those of the key that are available, and they must be the same for every party;
+// CheckSigners tells why they cannot sign.
+func NewRobustParty(
+	msg *big.Int,
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	timeout time.Duration,
+	out chan<- *tss.RobustMessage,
+	end chan<- common.SignatureData,
+	errCh chan<- *tss.Error,
+) (*tss.RobustSession, error) {
+	if err := CheckSigners(key, params.Threshold(), params.Parties().IDs()); err != nil {
+		return nil, err
+	}
+	newParty := func(params *tss.Parameters, out chan<- tss.Message, end chan<- common.SignatureData) tss.Party {
+		return NewLocalParty(msg, params, key, out, end)
+	}
+	pk := edwards.PublicKey{
+		Curve: tss.EC(),
+		X:     key.EDDSAPub.X(),
+		Y:     key.EDDSAPub.Y(),
+	}
+	verify := func(data common.SignatureData) bool {
+		sig, err := edwards.ParseSignature(data.Signature)
+		return err == nil && edwards.Verify(&pk, msg.Bytes(), sig.R, sig.S)
+	}
+	return tss.NewRobustSession(params, timeout, newParty, verify, out, end, errCh), nil
+}
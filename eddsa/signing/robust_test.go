@@ -0,0 +1,315 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/dcrec/edwards/v2"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+	"github.com/binance-chain/tss-lib/crypto/schnorr"
+	"github.com/binance-chain/tss-lib/eddsa/keygen"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+const robustTestTimeout = 5 * time.Second
+
+// runRobustSigning runs a robust session for every party in `pIDs` except `offline`, if any. `tamper`, if any, is
+// given every message that is sent and returns what is delivered instead; nil drops the message.
+func runRobustSigning(
+	t *testing.T,
+	msg *big.Int,
+	keys []keygen.LocalPartySaveData,
+	pIDs tss.SortedPartyIDs,
+	offline *tss.PartyID,
+	tamper func(*tss.RobustMessage) *tss.RobustMessage,
+) (sessions []*tss.RobustSession, sigs []common.SignatureData, errs []*tss.Error) {
+	live := len(pIDs)
+	if offline != nil {
+		live--
+	}
+	p2pCtx := tss.NewPeerContext(pIDs)
+	outCh := make(chan *tss.RobustMessage, 4*len(pIDs))
+	endCh := make(chan common.SignatureData, live)
+	errCh := make(chan *tss.Error, live)
+	byKey := make(map[string]*tss.RobustSession, live)
+	for i, Pi := range pIDs {
+		if Pi == offline {
+			continue
+		}
+		params := tss.NewParameters(p2pCtx, Pi, len(pIDs), testThreshold)
+		S, err := NewRobustParty(msg, params, keys[i], robustTestTimeout, outCh, endCh, errCh)
+		if !assert.NoError(t, err) {
+			return
+		}
+		sessions = append(sessions, S)
+		byKey[string(Pi.GetKey())] = S
+	}
+	for _, S := range sessions {
+		if !assert.Nil(t, S.Start()) {
+			return
+		}
+	}
+
+	deadline := time.After(10 * robustTestTimeout)
+	for len(sigs)+len(errs) < live {
+		select {
+		case msg := <-outCh:
+			if tamper != nil {
+				if msg = tamper(msg); msg == nil {
+					continue
+				}
+			}
+			for _, Pj := range msg.To {
+				if S, found := byKey[string(Pj.GetKey())]; found {
+					go S.Update(overTheWire(t, msg))
+				}
+			}
+		case sig := <-endCh:
+			sigs = append(sigs, sig)
+		case err := <-errCh:
+			errs = append(errs, err)
+		case <-deadline:
+			assert.FailNow(t, "the robust session did not end")
+		}
+	}
+	return
+}
+
+// overTheWire returns the message as a party receives it from the network
+func overTheWire(t *testing.T, msg *tss.RobustMessage) *tss.RobustMessage {
+	if msg.Message == nil {
+		return msg
+	}
+	bz, _, err := msg.Message.WireBytes()
+	assert.NoError(t, err)
+	received := *msg
+	received.Message, received.WireBytes, received.IsBroadcast = nil, bz, msg.Message.IsBroadcast()
+	return &received
+}
+
+func verifySignatures(t *testing.T, msg *big.Int, key keygen.LocalPartySaveData, sigs []common.SignatureData) {
+	pk := edwards.PublicKey{
+		Curve: tss.EC(),
+		X:     key.EDDSAPub.X(),
+		Y:     key.EDDSAPub.Y(),
+	}
+	for _, sig := range sigs {
+		parsed, err := edwards.ParseSignature(sig.Signature)
+		if assert.NoError(t, err) {
+			assert.True(t, edwards.Verify(&pk, msg.Bytes(), parsed.R, parsed.S), "eddsa verify must pass")
+		}
+	}
+}
+
+func TestRobustSigningWithOfflineSigner(t *testing.T) {
+	setUp("info")
+	tss.SetCurve(edwards.Edwards())
+
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 3)
+	assert.NoError(t, err, "should load keygen fixtures")
+	msg := big.NewInt(200)
+
+	sessions, sigs, errs := runRobustSigning(t, msg, keys, pIDs, pIDs[0], nil)
+	assert.Empty(t, errs)
+	assert.Equal(t, len(pIDs)-1, len(sigs), "every online party gets the signature")
+
+	verifySignatures(t, msg, keys[0], sigs)
+	for _, S := range sessions {
+		assert.Equal(t, 1, S.Attempt(), "the second attempt signs")
+		assert.Equal(t, []*tss.PartyID{pIDs[0]}, S.Excluded(), "only the offline party is excluded")
+	}
+}
+
+func TestRobustSigningWithLostMessage(t *testing.T) {
+	setUp("info")
+	tss.SetCurve(edwards.Edwards())
+
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 3)
+	assert.NoError(t, err, "should load keygen fixtures")
+	msg := big.NewInt(200)
+
+	// the third party never gets the s_i of the first one, so it cannot finish the first attempt by itself
+	from, lost := pIDs[0], pIDs[2]
+	tamper := func(rMsg *tss.RobustMessage) *tss.RobustMessage {
+		if rMsg.From != from || rMsg.Message == nil {
+			return rMsg
+		}
+		if _, ok := rMsg.Message.Content().(*SignRound3Message); !ok {
+			return rMsg
+		}
+		received := *rMsg
+		received.To = nil
+		for _, Pj := range rMsg.To {
+			if Pj != lost {
+				received.To = append(received.To, Pj)
+			}
+		}
+		return &received
+	}
+	sessions, sigs, errs := runRobustSigning(t, msg, keys, pIDs, nil, tamper)
+	assert.Empty(t, errs)
+	assert.Equal(t, len(pIDs), len(sigs), "the party that was left waiting gets the signature from the others")
+
+	verifySignatures(t, msg, keys[0], sigs)
+	for _, S := range sessions {
+		assert.Equal(t, 0, S.Attempt(), "the first attempt signs")
+		assert.Empty(t, S.Excluded(), "the members that finished are not taken for silent")
+	}
+}
+
+func TestRobustSigningWithTooFewSigners(t *testing.T) {
+	setUp("info")
+	tss.SetCurve(edwards.Edwards())
+
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 1)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	_, sigs, errs := runRobustSigning(t, big.NewInt(200), keys, pIDs, pIDs[0], nil)
+	assert.Empty(t, sigs)
+	assert.Equal(t, len(pIDs)-1, len(errs), "every online party fails")
+	for _, err := range errs {
+		assert.Equal(t, []*tss.PartyID{pIDs[0]}, err.Culprits(), "the offline party is to blame")
+	}
+}
+
+func TestRobustSigningWithFaultySigner(t *testing.T) {
+	setUp("info")
+	tss.SetCurve(edwards.Edwards())
+
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 3)
+	assert.NoError(t, err, "should load keygen fixtures")
+	msg := big.NewInt(200)
+
+	// the second party proves the knowledge of the discrete logarithm of another point than R_i, which every other
+	// member of the first quorum detects
+	faulty := pIDs[1]
+	tamper := func(rMsg *tss.RobustMessage) *tss.RobustMessage {
+		if rMsg.From != faulty || rMsg.Message == nil {
+			return rMsg
+		}
+		if r2msg, ok := rMsg.Message.Content().(*SignRound2Message); ok {
+			x := common.GetRandomPositiveInt(tss.EC().Params().N)
+			pf, err := schnorr.NewZKProof(x, crypto.ScalarBaseMult(tss.EC(), x))
+			assert.NoError(t, err)
+			tampered := *rMsg
+			tampered.Message = NewSignRound2Message(faulty, r2msg.UnmarshalDeCommitment(), pf)
+			return &tampered
+		}
+		return rMsg
+	}
+	sessions, sigs, errs := runRobustSigning(t, msg, keys, pIDs, nil, tamper)
+	assert.Empty(t, errs)
+	assert.Equal(t, len(pIDs), len(sigs), "every party gets the signature")
+	verifySignatures(t, msg, keys[0], sigs)
+	for _, S := range sessions {
+		assert.Equal(t, 1, S.Attempt(), "the second attempt signs")
+		assert.Equal(t, []*tss.PartyID{faulty}, S.Excluded(), "every party excludes the faulty one")
+	}
+}
+
+func TestRobustSigningWithFalseAccusation(t *testing.T) {
+	setUp("info")
+	tss.SetCurve(edwards.Edwards())
+
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 3)
+	assert.NoError(t, err, "should load keygen fixtures")
+	msg := big.NewInt(200)
+
+	// instead of its first message, the second party aborts and accuses the third one, which is honest
+	faulty, accused := pIDs[1], pIDs[2]
+	accusing := true
+	tamper := func(rMsg *tss.RobustMessage) *tss.RobustMessage {
+		if rMsg.From != faulty || rMsg.Message == nil || !accusing {
+			return rMsg
+		}
+		accusing = false
+		others := make([]*tss.PartyID, 0, len(pIDs)-1)
+		for _, Pj := range pIDs {
+			if Pj != faulty {
+				others = append(others, Pj)
+			}
+		}
+		return &tss.RobustMessage{Attempt: rMsg.Attempt, From: faulty, To: others, IsAbort: true, Accused: []*tss.PartyID{accused}}
+	}
+	sessions, sigs, errs := runRobustSigning(t, msg, keys, pIDs, nil, tamper)
+	verifySignatures(t, msg, keys[0], sigs)
+	assert.Equal(t, len(pIDs)-1, len(sigs), "every honest party gets the signature")
+	// the faulty party does not know of its own accusation and finds nothing to blame
+	if assert.Equal(t, 1, len(errs)) {
+		assert.Equal(t, faulty, errs[0].Victim())
+	}
+	for i, S := range sessions {
+		if pIDs[i] != faulty {
+			assert.Equal(t, 1, S.Attempt(), "the second attempt signs")
+			assert.Empty(t, S.Excluded(), "a single accusation excludes no one")
+		}
+	}
+}
+
+func TestRobustSigningWithUnattributableFailure(t *testing.T) {
+	setUp("info")
+	tss.SetCurve(edwards.Edwards())
+
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 3)
+	assert.NoError(t, err, "should load keygen fixtures")
+	msg := big.NewInt(200)
+
+	// the second party sends a wrong s_i, so the signature of the first quorum does not verify for the other
+	// members, and the protocol cannot tell whose s_i is wrong. It keeps the valid signature that it gets to itself.
+	faulty := pIDs[1]
+	tamper := func(rMsg *tss.RobustMessage) *tss.RobustMessage {
+		if rMsg.From == faulty && rMsg.Signature != nil {
+			return nil
+		}
+		if rMsg.From != faulty || rMsg.Message == nil {
+			return rMsg
+		}
+		if _, ok := rMsg.Message.Content().(*SignRound3Message); ok {
+			tampered := *rMsg
+			tampered.Message = NewSignRound3Message(faulty, common.GetRandomPositiveInt(tss.EC().Params().N))
+			return &tampered
+		}
+		return rMsg
+	}
+	sessions, sigs, errs := runRobustSigning(t, msg, keys, pIDs, nil, tamper)
+	verifySignatures(t, msg, keys[0], sigs)
+
+	// the honest members of the first quorum cannot sign together again, and too few parties remain without them
+	failed := make(map[*tss.PartyID]bool, len(errs))
+	for _, err := range errs {
+		failed[err.Victim()] = true
+		for _, culprit := range err.Culprits() {
+			assert.Equal(t, faulty, culprit, "only the faulty party may be blamed")
+		}
+	}
+	for i := 0; i <= testThreshold; i++ {
+		if pIDs[i] != faulty {
+			assert.True(t, failed[pIDs[i]], "an honest member of the first quorum fails")
+		}
+	}
+	for _, S := range sessions {
+		for _, Pj := range S.Excluded() {
+			assert.Equal(t, faulty, Pj, "no honest party is excluded")
+		}
+	}
+}
+
+func TestRobustPartyWithTooFewSigners(t *testing.T) {
+	tss.SetCurve(edwards.Edwards())
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testThreshold)
+	assert.NoError(t, err, "should load keygen fixtures")
+	params := tss.NewParameters(tss.NewPeerContext(pIDs), pIDs[0], len(pIDs), testThreshold)
+	_, err = NewRobustParty(big.NewInt(42), params, keys[0], time.Minute, nil, nil, nil)
+	assert.Equal(t, ErrTooFewSigners, errors.Cause(err))
+}
@@ -36,7 +36,7 @@ func (round *round1) Start() *tss.Error {
 	ri := common.GetRandomPositiveInt(tss.EC().Params().N)
 
 	// 2. make commitment
-	pointRi := crypto.ScalarBaseMult(tss.EC(), ri)
+	pointRi := crypto.ScalarBaseMultSecret(tss.EC(), ri)
 	cmt := commitments.NewHashCommitment(pointRi.X(), pointRi.Y())
 
 	// 3. store r1 message pieces
@@ -92,7 +92,18 @@ func (round *round1) prepare() error {
 	if round.Threshold()+1 > len(ks) {
 		return fmt.Errorf("t+1=%d is not satisfied by the key count of %d", round.Threshold()+1, len(ks))
 	}
-	wi := PrepareForSigning(i, len(ks), xi, ks)
+	if err := round.temp.opts.Validate(round.temp.m); err != nil {
+		return err
+	}
+	if round.temp.tweak != nil {
+		// rounds 3 and finalize use the tweaked key
+		tweakedPub, err := TweakPublicKey(round.key.EDDSAPub, round.temp.tweak)
+		if err != nil {
+			return fmt.Errorf("the tweaked public key is invalid: %v", err)
+		}
+		round.key.EDDSAPub = tweakedPub
+	}
+	wi := PrepareForSigning(i, len(ks), xi, ks, round.temp.tweak)
 
 	round.temp.wi = wi
 	return nil
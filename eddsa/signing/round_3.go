@@ -14,6 +14,7 @@ import (
 
 	"github.com/binance-chain/tss-lib/crypto"
 	"github.com/binance-chain/tss-lib/crypto/commitments"
+	"github.com/binance-chain/tss-lib/crypto/group"
 	"github.com/binance-chain/tss-lib/tss"
 )
 
@@ -27,9 +28,9 @@ func (round *round3) Start() *tss.Error {
 	round.resetOK()
 
 	// 1. init R
-	var R edwards25519.ExtendedGroupElement
+	g := group.Ed25519()
+	R := g.ScalarBaseMultSecret(g.NewScalar(round.temp.ri))
 	riBytes := bigIntToEncodedBytes(round.temp.ri)
-	edwards25519.GeScalarMultBase(&R, riBytes)
 
 	// 2-6. compute R
 	i := round.PartyID().Index
@@ -43,17 +44,21 @@ func (round *round3) Start() *tss.Error {
 		cmtDeCmt := commitments.HashCommitDecommit{C: round.temp.cjs[j], D: r2msg.UnmarshalDeCommitment()}
 		ok, coordinates := cmtDeCmt.DeCommit()
 		if !ok {
-			return round.WrapError(errors.New("de-commitment verify failed"))
+			return round.WrapError(errors.New("de-commitment verify failed"), Pj)
 		}
 		if len(coordinates) != 2 {
-			return round.WrapError(errors.New("length of de-commitment should be 2"))
+			return round.WrapError(errors.New("length of de-commitment should be 2"), Pj)
 		}
 
 		Rj, err := crypto.NewECPoint(tss.EC(), coordinates[0], coordinates[1])
-		Rj = Rj.EightInvEight()
 		if err != nil {
 			return round.WrapError(errors.Wrapf(err, "NewECPoint(Rj)"), Pj)
 		}
+		// Rj must be in the prime-order subgroup
+		gRj, err := Rj.ToGroupPoint(g)
+		if err != nil {
+			return round.WrapError(errors.Wrapf(err, "FromECPoint(Rj)"), Pj)
+		}
 		proof, err := r2msg.UnmarshalZKProof()
 		if err != nil {
 			return round.WrapError(errors.New("failed to unmarshal Rj proof"), Pj)
@@ -63,21 +68,21 @@ func (round *round3) Start() *tss.Error {
 			return round.WrapError(errors.New("failed to prove Rj"), Pj)
 		}
 
-		extendedRj := ecPointToExtendedElement(Rj.X(), Rj.Y())
-		R = addExtendedElements(R, extendedRj)
+		R = R.Add(gRj)
 	}
 
 	// 7. compute lambda
 	var encodedR [32]byte
-	R.ToBytes(&encodedR)
+	copy(encodedR[:], R.Bytes())
 	encodedPubKey := ecPointToEncodedBytes(round.key.EDDSAPub.X(), round.key.EDDSAPub.Y())
 
-	// h = hash512(k || A || M)
+	// h = hash512(dom2(F, C) || R || A || M); dom2 is empty for plain Ed25519
 	h := sha512.New()
 	h.Reset()
+	h.Write(round.temp.opts.dom2())
 	h.Write(encodedR[:])
 	h.Write(encodedPubKey[:])
-	h.Write(round.temp.m.Bytes())
+	h.Write(round.temp.m)
 
 	var lambda [64]byte
 	h.Sum(lambda[:0])
@@ -91,6 +96,7 @@ func (round *round3) Start() *tss.Error {
 	// 9. store r3 message pieces
 	round.temp.si = &localS
 	round.temp.r = encodedBytesToBigInt(&encodedR)
+	round.temp.lambda = encodedBytesToBigInt(&lambdaReduced)
 
 	// 10. broadcast si to other parties
 	r3msg := NewSignRound3Message(round.PartyID(), encodedBytesToBigInt(&localS))
@@ -0,0 +1,67 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"errors"
+	"math/big"
+
+	errorspkg "github.com/pkg/errors"
+
+	"github.com/binance-chain/tss-lib/eddsa/keygen"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+// The causes of the errors of CheckSigners; use `errors.Cause` from github.com/pkg/errors to get them
+var (
+	ErrTooFewSigners      = errors.New("fewer signers than threshold+1")
+	ErrUnknownSigner      = errors.New("the signer is not a party of the key")
+	ErrDuplicateSigner    = errors.New("the signer appears more than once")
+	ErrLocalPartyNotAmong = errors.New("the holder of the key is not among the signers")
+	ErrNoShare            = errors.New("the key has no share, e.g. it was not saved by a keygen or a re-sharing")
+)
+
+// CheckSigners reports why `signers` cannot sign with `key`, or returns nil if they can. A signing set needs at least
+// threshold+1 distinct parties whose keys are in `key.Ks`, and the holder of `key` must be one of them.
+func CheckSigners(key keygen.LocalPartySaveData, threshold int, signers []*tss.PartyID) error {
+	if key.ShareID == nil {
+		return ErrNoShare
+	}
+	ks := make(map[string]struct{}, len(key.Ks))
+	for _, kj := range key.Ks {
+		if kj != nil && kj.Cmp(big.NewInt(0)) != 0 {
+			ks[string(kj.Bytes())] = struct{}{}
+		}
+	}
+	seen := make(map[string]struct{}, len(signers))
+	self := false
+	for _, Pj := range signers {
+		if Pj == nil {
+			return errorspkg.Wrap(ErrUnknownSigner, "nil party")
+		}
+		kj := string(Pj.GetKey())
+		if _, found := ks[kj]; !found {
+			return errorspkg.Wrapf(ErrUnknownSigner, "party %s", Pj)
+		}
+		if _, found := seen[kj]; found {
+			return errorspkg.Wrapf(ErrDuplicateSigner, "party %s", Pj)
+		}
+		seen[kj] = struct{}{}
+		if Pj.KeyInt().Cmp(key.ShareID) == 0 {
+			self = true
+		}
+	}
+	if len(signers) <= threshold {
+		return errorspkg.Wrapf(ErrTooFewSigners,
+			"%d signers with threshold %d, which needs %d", len(signers), threshold, threshold+1)
+	}
+	if !self {
+		return errorspkg.Wrapf(ErrLocalPartyNotAmong, "the share of the key belongs to the party with key %x",
+			key.ShareID.Bytes())
+	}
+	return nil
+}
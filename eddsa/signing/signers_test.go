@@ -0,0 +1,43 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/edwards/v2"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/binance-chain/tss-lib/eddsa/keygen"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+func TestCheckSigners(t *testing.T) {
+	tss.SetCurve(edwards.Edwards())
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 2)
+	assert.NoError(t, err, "should load keygen fixtures")
+	key := keys[0]
+
+	assert.NoError(t, CheckSigners(key, testThreshold, pIDs[:testThreshold+1]))
+
+	err = CheckSigners(key, testThreshold, pIDs[:testThreshold])
+	assert.Equal(t, ErrTooFewSigners, errors.Cause(err))
+
+	stranger := tss.NewPartyID("stranger", "stranger", big.NewInt(12345))
+	err = CheckSigners(key, testThreshold, append(tss.UnSortedPartyIDs{stranger}, pIDs[:testThreshold+1]...))
+	assert.Equal(t, ErrUnknownSigner, errors.Cause(err))
+
+	err = CheckSigners(key, testThreshold, append(tss.UnSortedPartyIDs{pIDs[1]}, pIDs[:testThreshold+1]...))
+	assert.Equal(t, ErrDuplicateSigner, errors.Cause(err))
+
+	err = CheckSigners(key, testThreshold, pIDs[1:testThreshold+2])
+	assert.Equal(t, ErrLocalPartyNotAmong, errors.Cause(err))
+
+	assert.Equal(t, ErrNoShare, CheckSigners(keygen.LocalPartySaveData{}, testThreshold, pIDs))
+}
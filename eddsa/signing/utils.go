@@ -10,9 +10,6 @@ import (
 	"math/big"
 
 	"github.com/agl/ed25519/edwards25519"
-
-	"github.com/binance-chain/tss-lib/common"
-	"github.com/binance-chain/tss-lib/tss"
 )
 
 func encodedBytesToBigInt(s *[32]byte) *big.Int {
@@ -89,40 +86,3 @@ func reverse(s *[32]byte) {
 		s[i], s[j] = s[j], s[i]
 	}
 }
-
-func addExtendedElements(p, q edwards25519.ExtendedGroupElement) edwards25519.ExtendedGroupElement {
-	var r edwards25519.CompletedGroupElement
-	var qCached edwards25519.CachedGroupElement
-	q.ToCached(&qCached)
-	edwards25519.GeAdd(&r, &p, &qCached)
-	var result edwards25519.ExtendedGroupElement
-	r.ToExtended(&result)
-	return result
-}
-
-func ecPointToExtendedElement(x *big.Int, y *big.Int) edwards25519.ExtendedGroupElement {
-	encodedXBytes := bigIntToEncodedBytes(x)
-	encodedYBytes := bigIntToEncodedBytes(y)
-
-	z := common.GetRandomPositiveInt(tss.EC().Params().N)
-	encodedZBytes := bigIntToEncodedBytes(z)
-
-	var fx, fy, fxy edwards25519.FieldElement
-	edwards25519.FeFromBytes(&fx, encodedXBytes)
-	edwards25519.FeFromBytes(&fy, encodedYBytes)
-
-	var X, Y, Z, T edwards25519.FieldElement
-	edwards25519.FeFromBytes(&Z, encodedZBytes)
-
-	edwards25519.FeMul(&X, &fx, &Z)
-	edwards25519.FeMul(&Y, &fy, &Z)
-	edwards25519.FeMul(&fxy, &fx, &fy)
-	edwards25519.FeMul(&T, &fxy, &Z)
-
-	return edwards25519.ExtendedGroupElement{
-		X: X,
-		Y: Y,
-		Z: Z,
-		T: T,
-	}
-}
@@ -0,0 +1,190 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: protob/schnorr-keygen.proto
+
+package keygen
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// Represents a BROADCAST message sent during Round 1 of the Schnorr (BIP-340) TSS keygen protocol.
+type KGRound1Message struct {
+	Commitment           []byte   `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KGRound1Message) Reset()         { *m = KGRound1Message{} }
+func (m *KGRound1Message) String() string { return proto.CompactTextString(m) }
+func (*KGRound1Message) ProtoMessage()    {}
+func (*KGRound1Message) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cddaf9661c3b4217, []int{0}
+}
+
+func (m *KGRound1Message) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KGRound1Message.Unmarshal(m, b)
+}
+func (m *KGRound1Message) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KGRound1Message.Marshal(b, m, deterministic)
+}
+func (m *KGRound1Message) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KGRound1Message.Merge(m, src)
+}
+func (m *KGRound1Message) XXX_Size() int {
+	return xxx_messageInfo_KGRound1Message.Size(m)
+}
+func (m *KGRound1Message) XXX_DiscardUnknown() {
+	xxx_messageInfo_KGRound1Message.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KGRound1Message proto.InternalMessageInfo
+
+func (m *KGRound1Message) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+// Represents a P2P message sent to each party during Round 2 of the Schnorr (BIP-340) TSS keygen protocol.
+type KGRound2Message1 struct {
+	Share                []byte   `protobuf:"bytes,1,opt,name=share,proto3" json:"share,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KGRound2Message1) Reset()         { *m = KGRound2Message1{} }
+func (m *KGRound2Message1) String() string { return proto.CompactTextString(m) }
+func (*KGRound2Message1) ProtoMessage()    {}
+func (*KGRound2Message1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cddaf9661c3b4217, []int{1}
+}
+
+func (m *KGRound2Message1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KGRound2Message1.Unmarshal(m, b)
+}
+func (m *KGRound2Message1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KGRound2Message1.Marshal(b, m, deterministic)
+}
+func (m *KGRound2Message1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KGRound2Message1.Merge(m, src)
+}
+func (m *KGRound2Message1) XXX_Size() int {
+	return xxx_messageInfo_KGRound2Message1.Size(m)
+}
+func (m *KGRound2Message1) XXX_DiscardUnknown() {
+	xxx_messageInfo_KGRound2Message1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KGRound2Message1 proto.InternalMessageInfo
+
+func (m *KGRound2Message1) GetShare() []byte {
+	if m != nil {
+		return m.Share
+	}
+	return nil
+}
+
+// Represents a BROADCAST message sent to each party during Round 2 of the Schnorr (BIP-340) TSS keygen protocol.
+type KGRound2Message2 struct {
+	DeCommitment         [][]byte `protobuf:"bytes,1,rep,name=de_commitment,json=deCommitment,proto3" json:"de_commitment,omitempty"`
+	ProofAlphaX          []byte   `protobuf:"bytes,2,opt,name=proof_alpha_x,json=proofAlphaX,proto3" json:"proof_alpha_x,omitempty"`
+	ProofAlphaY          []byte   `protobuf:"bytes,3,opt,name=proof_alpha_y,json=proofAlphaY,proto3" json:"proof_alpha_y,omitempty"`
+	ProofT               []byte   `protobuf:"bytes,4,opt,name=proof_t,json=proofT,proto3" json:"proof_t,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KGRound2Message2) Reset()         { *m = KGRound2Message2{} }
+func (m *KGRound2Message2) String() string { return proto.CompactTextString(m) }
+func (*KGRound2Message2) ProtoMessage()    {}
+func (*KGRound2Message2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cddaf9661c3b4217, []int{2}
+}
+
+func (m *KGRound2Message2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KGRound2Message2.Unmarshal(m, b)
+}
+func (m *KGRound2Message2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KGRound2Message2.Marshal(b, m, deterministic)
+}
+func (m *KGRound2Message2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KGRound2Message2.Merge(m, src)
+}
+func (m *KGRound2Message2) XXX_Size() int {
+	return xxx_messageInfo_KGRound2Message2.Size(m)
+}
+func (m *KGRound2Message2) XXX_DiscardUnknown() {
+	xxx_messageInfo_KGRound2Message2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KGRound2Message2 proto.InternalMessageInfo
+
+func (m *KGRound2Message2) GetDeCommitment() [][]byte {
+	if m != nil {
+		return m.DeCommitment
+	}
+	return nil
+}
+
+func (m *KGRound2Message2) GetProofAlphaX() []byte {
+	if m != nil {
+		return m.ProofAlphaX
+	}
+	return nil
+}
+
+func (m *KGRound2Message2) GetProofAlphaY() []byte {
+	if m != nil {
+		return m.ProofAlphaY
+	}
+	return nil
+}
+
+func (m *KGRound2Message2) GetProofT() []byte {
+	if m != nil {
+		return m.ProofT
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*KGRound1Message)(nil), "KGRound1Message")
+	proto.RegisterType((*KGRound2Message1)(nil), "KGRound2Message1")
+	proto.RegisterType((*KGRound2Message2)(nil), "KGRound2Message2")
+}
+
+func init() { proto.RegisterFile("protob/schnorr-keygen.proto", fileDescriptor_cddaf9661c3b4217) }
+
+var fileDescriptor_cddaf9661c3b4217 = []byte{
+	// 203 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2e, 0x28, 0xca, 0x2f,
+	0xc9, 0x4f, 0xd2, 0x2f, 0x4e, 0xce, 0xc8, 0xcb, 0x2f, 0x2a, 0xd2, 0xcd, 0x4e, 0xad, 0x4c, 0x4f,
+	0xcd, 0xd3, 0x03, 0x8b, 0x2a, 0x19, 0x72, 0xf1, 0x7b, 0xbb, 0x07, 0xe5, 0x97, 0xe6, 0xa5, 0x18,
+	0xfa, 0xa6, 0x16, 0x17, 0x27, 0xa6, 0xa7, 0x0a, 0xc9, 0x71, 0x71, 0x25, 0xe7, 0xe7, 0xe6, 0x66,
+	0x96, 0xe4, 0xa6, 0xe6, 0x95, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0xf0, 0x04, 0x21, 0x89, 0x28, 0x69,
+	0x70, 0x09, 0x40, 0xb5, 0x18, 0x41, 0xb5, 0x18, 0x0a, 0x89, 0x70, 0xb1, 0x16, 0x67, 0x24, 0x16,
+	0xa5, 0x42, 0x95, 0x43, 0x38, 0x4a, 0x33, 0x18, 0x31, 0x94, 0x1a, 0x09, 0x29, 0x73, 0xf1, 0xa6,
+	0xa4, 0xc6, 0xa3, 0xd8, 0xc0, 0xac, 0xc1, 0x13, 0xc4, 0x93, 0x92, 0xea, 0x0c, 0x17, 0x13, 0x52,
+	0xe2, 0xe2, 0x2d, 0x28, 0xca, 0xcf, 0x4f, 0x8b, 0x4f, 0xcc, 0x29, 0xc8, 0x48, 0x8c, 0xaf, 0x90,
+	0x60, 0x02, 0x9b, 0xcb, 0x0d, 0x16, 0x74, 0x04, 0x89, 0x45, 0xa0, 0xab, 0xa9, 0x94, 0x60, 0x46,
+	0x57, 0x13, 0x29, 0x24, 0xce, 0xc5, 0x0e, 0x51, 0x53, 0x22, 0xc1, 0x02, 0x96, 0x65, 0x03, 0x73,
+	0x43, 0x9c, 0x04, 0xa2, 0xf8, 0xa0, 0xe1, 0xa1, 0x0f, 0x09, 0x8f, 0x24, 0x36, 0x70, 0x80, 0x18,
+	0x03, 0x06, 0x00, 0xe8, 0xaf, 0x4d, 0x39, 0x2f, 0x01, 0x00, 0x00,
+}
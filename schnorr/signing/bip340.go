@@ -0,0 +1,107 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"crypto/sha256"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+
+	"github.com/binance-chain/tss-lib/crypto"
+)
+
+// BIP-340 helpers
+// https://github.com/bitcoin/bips/blob/master/bip-0340.mediawiki
+
+const (
+	challengeTag = "BIP0340/challenge"
+)
+
+var (
+	zero  = big.NewInt(0)
+	seven = big.NewInt(7)
+)
+
+// SerializePubKey returns the 32-byte x-only encoding of a public key, which is how BIP-340 identifies it
+func SerializePubKey(pk *crypto.ECPoint) []byte {
+	return padTo32Bytes(pk.X())
+}
+
+// Verify checks a 64-byte BIP-340 signature of `msg` against a 32-byte x-only public key
+func Verify(pubKey, msg, sig []byte) bool {
+	curve := btcec.S256()
+	if len(pubKey) != 32 || len(sig) != 64 {
+		return false
+	}
+	px, py, ok := liftX(new(big.Int).SetBytes(pubKey))
+	if !ok {
+		return false
+	}
+	r, s := new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:])
+	if r.Cmp(curve.Params().P) >= 0 || s.Cmp(curve.Params().N) >= 0 {
+		return false
+	}
+	e := challenge(r, px, msg)
+
+	// R = s*G - e*P
+	negE := new(big.Int).Sub(curve.Params().N, e)
+	sGx, sGy := curve.ScalarBaseMult(s.Bytes())
+	ePx, ePy := curve.ScalarMult(px, py, negE.Bytes())
+	rx, ry := curve.Add(sGx, sGy, ePx, ePy)
+	if rx.Sign() == 0 && ry.Sign() == 0 {
+		return false
+	}
+	return ry.Bit(0) == 0 && rx.Cmp(r) == 0
+}
+
+// ----- //
+
+// challenge returns int(hash_BIP0340/challenge(bytes(rx) || bytes(px) || m)) mod n
+func challenge(rx, px *big.Int, m []byte) *big.Int {
+	h := taggedHash(challengeTag, padTo32Bytes(rx), padTo32Bytes(px), m)
+	return new(big.Int).Mod(new(big.Int).SetBytes(h), btcec.S256().Params().N)
+}
+
+// taggedHash returns SHA256(SHA256(tag) || SHA256(tag) || x)
+func taggedHash(tag string, in ...[]byte) []byte {
+	tagHash := sha256.Sum256([]byte(tag))
+	h := sha256.New()
+	h.Write(tagHash[:])
+	h.Write(tagHash[:])
+	for _, bz := range in {
+		h.Write(bz)
+	}
+	return h.Sum(nil)
+}
+
+// liftX returns the point with the x coordinate `x` and an even y coordinate
+func liftX(x *big.Int) (*big.Int, *big.Int, bool) {
+	p := btcec.S256().Params().P
+	if x.Cmp(p) >= 0 {
+		return nil, nil, false
+	}
+	// y^2 = x^3 + 7
+	c := new(big.Int).Exp(x, big.NewInt(3), p)
+	c.Add(c, seven).Mod(c, p)
+	y := new(big.Int).ModSqrt(c, p)
+	if y == nil {
+		return nil, nil, false
+	}
+	if y.Bit(0) == 1 {
+		y.Sub(p, y)
+	}
+	return x, y, true
+}
+
+func padTo32Bytes(n *big.Int) []byte {
+	bz := n.Bytes()
+	if len(bz) >= 32 {
+		return bz
+	}
+	return append(make([]byte, 32-len(bz)), bz...)
+}
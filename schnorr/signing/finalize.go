@@ -0,0 +1,64 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+func (round *finalization) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 4
+	round.started = true
+	round.resetOK()
+
+	modN := common.ModInt(tss.EC().Params().N)
+	s := round.temp.si
+	for j := range round.Parties().IDs() {
+		round.ok[j] = true
+		if j == round.PartyID().Index {
+			continue
+		}
+		r3msg := round.temp.signRound3Messages[j].Content().(*SignRound3Message)
+		s = modN.Add(s, r3msg.UnmarshalS())
+	}
+
+	// save the signature for final output; the 64-byte BIP-340 signature is bytes(R) || bytes(s)
+	rBz, sBz := padTo32Bytes(round.temp.r), padTo32Bytes(s)
+	round.data.Signature = append(rBz, sBz...)
+	round.data.R = rBz
+	round.data.S = sBz
+	round.data.M = round.temp.m
+
+	ok := Verify(SerializePubKey(round.key.SchnorrPub), round.temp.m, round.data.Signature)
+	if !ok {
+		return round.WrapError(fmt.Errorf("signature verification failed"))
+	}
+	round.end <- *round.data
+
+	return nil
+}
+
+func (round *finalization) CanAccept(msg tss.ParsedMessage) bool {
+	// not expecting any incoming messages in this round
+	return false
+}
+
+func (round *finalization) Update() (bool, *tss.Error) {
+	// not expecting any incoming messages in this round
+	return false, nil
+}
+
+func (round *finalization) NextRound() tss.Round {
+	return nil // finished!
+}
@@ -0,0 +1,39 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+// PrepareForSigning(), Fig. 7
+func PrepareForSigning(i, pax int, xi *big.Int, ks []*big.Int) (wi *big.Int) {
+	modQ := common.ModInt(tss.EC().Params().N)
+	if len(ks) != pax {
+		panic(fmt.Errorf("PrepareForSigning: len(ks) != pax (%d != %d)", len(ks), pax))
+	}
+	if len(ks) <= i {
+		panic(fmt.Errorf("PrepareForSigning: len(ks) <= i (%d <= %d)", len(ks), i))
+	}
+
+	// 1-4.
+	wi = new(big.Int).Set(xi) // not aliased, so that it may be zeroized
+	for j := 0; j < pax; j++ {
+		if j == i {
+			continue
+		}
+		// big.Int Div is calculated as: a/b = a * modInv(b,q)
+		coef := modQ.Mul(ks[j], modQ.ModInverse(new(big.Int).Sub(ks[j], ks[i])))
+		wi = modQ.Mul(wi, coef)
+	}
+
+	return
+}
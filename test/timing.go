@@ -0,0 +1,94 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package test
+
+import (
+	"math"
+	"math/rand"
+	"runtime"
+	"sort"
+	"time"
+)
+
+// The timing harness follows dudect (Reparaz, Balasch and Verbauwhede, "Dude, is my code constant time?"): an
+// operation is timed on inputs of two classes picked at random for every sample, typically a fixed and a random
+// secret, and Welch's t-test tells whether the two timing distributions differ. Cropping the slowest samples at a few
+// percentiles removes most of the noise from the scheduler and the garbage collector.
+
+const (
+	// TimingLeakThreshold is the |t| above which the timings of the two classes are considered different.
+	// dudect uses 4.5; this is more conservative because the tests run on shared machines.
+	TimingLeakThreshold = 10.0
+
+	timingWarmUpRatio = 10 // one in timingWarmUpRatio samples is dropped at the start
+)
+
+var timingCropPercentiles = []float64{1, 0.9, 0.75, 0.5}
+
+// TimingLeak times the operations returned by `prepare` for `samples` classes (0 or 1) picked at random and returns
+// the largest |t| statistic between the two classes; `prepare` itself is not timed. A result above
+// TimingLeakThreshold indicates that the running time depends on the class.
+func TimingLeak(samples int, prepare func(class int) func()) float64 {
+	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
+	classes := make([]int, samples)
+	durations := make([]float64, samples)
+	runtime.GC()
+	for i := range classes {
+		classes[i] = rnd.Intn(2)
+		op := prepare(classes[i])
+		start := time.Now()
+		op()
+		durations[i] = float64(time.Since(start))
+	}
+	warmUp := samples / timingWarmUpRatio
+	classes, durations = classes[warmUp:], durations[warmUp:]
+
+	sorted := append([]float64(nil), durations...)
+	sort.Float64s(sorted)
+	maxT := 0.0
+	for _, pct := range timingCropPercentiles {
+		limit := sorted[int(pct*float64(len(sorted)-1))]
+		var stats [2]welford
+		for i, d := range durations {
+			if d <= limit {
+				stats[classes[i]].push(d)
+			}
+		}
+		if t := math.Abs(welchT(stats[0], stats[1])); t > maxT {
+			maxT = t
+		}
+	}
+	return maxT
+}
+
+// welford accumulates the mean and variance of a sample in one pass
+type welford struct {
+	n        float64
+	mean, m2 float64
+}
+
+func (w *welford) push(x float64) {
+	w.n++
+	delta := x - w.mean
+	w.mean += delta / w.n
+	w.m2 += delta * (x - w.mean)
+}
+
+func (w *welford) variance() float64 {
+	if w.n < 2 {
+		return 0
+	}
+	return w.m2 / (w.n - 1)
+}
+
+func welchT(a, b welford) float64 {
+	se := math.Sqrt(a.variance()/a.n + b.variance()/b.n)
+	if se == 0 || math.IsNaN(se) {
+		return 0
+	}
+	return (a.mean - b.mean) / se
+}
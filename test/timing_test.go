@@ -0,0 +1,43 @@
+//go:build timing
+// +build timing
+
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/binance-chain/tss-lib/common"
+)
+
+func TestTimingLeakDetectsVariableTime(t *testing.T) {
+	// the variable-time base point multiplication skips the zero bytes of the scalar
+	curve := btcec.S256()
+	tStat := TimingLeak(2000, func(class int) func() {
+		k := big.NewInt(1)
+		if class == 1 {
+			k = common.GetRandomPositiveInt(curve.N)
+		}
+		return func() { curve.ScalarBaseMult(k.Bytes()) }
+	})
+	t.Log(tStat)
+	assert.True(t, tStat > TimingLeakThreshold, "t = %f", tStat)
+}
+
+func TestTimingLeakSameOperation(t *testing.T) {
+	x := new(big.Int).Lsh(big.NewInt(1), 1024)
+	tStat := TimingLeak(2000, func(int) func() {
+		return func() { new(big.Int).Mul(x, x) }
+	})
+	t.Log(tStat)
+	assert.True(t, tStat < TimingLeakThreshold, "t = %f", tStat)
+}
@@ -131,7 +131,7 @@ func (mm *MessageImpl) IsToOldCommittee() bool {
 	return mm.wire.IsToOldCommittee
 }
 
-// only `true` in DGRound4Message (resharing)
+// only `true` in the round 4 "ACK" message (resharing)
 func (mm *MessageImpl) IsToOldAndNewCommittees() bool {
 	return mm.wire.IsToOldAndNewCommittees
 }
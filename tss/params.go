@@ -8,6 +8,7 @@ package tss
 
 import (
 	"errors"
+	"fmt"
 	"time"
 )
 
@@ -18,6 +19,11 @@ type (
 		partyCount          int
 		threshold           int
 		safePrimeGenTimeout time.Duration
+		sNormalization      SNormalization
+		ringPedersenProofs  []RingPedersenProof
+		minPaillierBitLen   int
+		minNTildeBitLen     int
+		verifierPool        *VerifierPool
 	}
 
 	ReSharingParameters struct {
@@ -28,10 +34,46 @@ type (
 	}
 )
 
+// SNormalization is the policy that ECDSA signing applies to the S value of a signature
+type SNormalization int
+
+const (
+	// LowS replaces S with N - S when S > N/2, as required by Bitcoin and tendermint. This is the default.
+	LowS SNormalization = iota
+	// RawS keeps the S value produced by the protocol, which is in the upper half of [1, N-1] half of the time
+	RawS
+)
+
+// RingPedersenProof is a proof system for the ring-Pedersen parameters (NTilde, h1, h2) of ECDSA keygen and re-sharing
+type RingPedersenProof int
+
+const (
+	// DLNProofs are the two 128-iteration discrete log proofs of GG18, h2 = h1^alpha and h1 = h2^beta
+	DLNProofs RingPedersenProof = iota
+	// PrmProof is the 80-iteration ring-Pedersen parameter proof of CGGMP21, with a proof that NTilde is a Blum integer
+	PrmProof
+)
+
+func (proof RingPedersenProof) String() string {
+	switch proof {
+	case DLNProofs:
+		return "dln"
+	case PrmProof:
+		return "prm"
+	default:
+		return fmt.Sprintf("RingPedersenProof(%d)", int(proof))
+	}
+}
+
 const (
 	defaultSafePrimeGenTimeout = 5 * time.Minute
+	// the q^3 and q^8 bounds of the MtA range proofs are only sound with moduli of at least this size
+	defaultMinModulusBitLen = 2048
 )
 
+// the peers of older versions only know the DLN proofs, so they come first
+var defaultRingPedersenProofs = []RingPedersenProof{DLNProofs, PrmProof}
+
 // Exported, used in `tss` client
 func NewParameters(ctx *PeerContext, partyID *PartyID, partyCount, threshold int, optionalSafePrimeGenTimeout ...time.Duration) *Parameters {
 	var safePrimeGenTimeout time.Duration
@@ -72,9 +114,92 @@ func (params *Parameters) SafePrimeGenTimeout() time.Duration {
 	return params.safePrimeGenTimeout
 }
 
+func (params *Parameters) SNormalization() SNormalization {
+	return params.sNormalization
+}
+
+// SetSNormalization sets the policy for the S value of ECDSA signatures; all signers should use the same policy
+func (params *Parameters) SetSNormalization(policy SNormalization) {
+	params.sNormalization = policy
+}
+
+// RingPedersenProofs returns the accepted proof systems for the ring-Pedersen parameters, in order of preference
+func (params *Parameters) RingPedersenProofs() []RingPedersenProof {
+	if len(params.ringPedersenProofs) == 0 {
+		return defaultRingPedersenProofs
+	}
+	return params.ringPedersenProofs
+}
+
+// SetRingPedersenProofs sets the proof systems that this party accepts for the ring-Pedersen parameters of its peers,
+// in order of preference. The party proves its own parameters with the first one, and the proof system of each
+// message is recognised by the receiver, so parties that share a first preference agree without an extra round.
+// A peer that uses a proof system which is not in the list is reported as the culprit.
+func (params *Parameters) SetRingPedersenProofs(proofs ...RingPedersenProof) {
+	params.ringPedersenProofs = proofs
+}
+
+// AcceptsRingPedersenProof reports whether `proof` is one of the accepted proof systems
+func (params *Parameters) AcceptsRingPedersenProof(proof RingPedersenProof) bool {
+	for _, accepted := range params.RingPedersenProofs() {
+		if accepted == proof {
+			return true
+		}
+	}
+	return false
+}
+
+// MinPaillierBitLen returns the minimum bit length of a peer's Paillier modulus N
+func (params *Parameters) MinPaillierBitLen() int {
+	if params.minPaillierBitLen == 0 {
+		return defaultMinModulusBitLen
+	}
+	return params.minPaillierBitLen
+}
+
+// MinNTildeBitLen returns the minimum bit length of a peer's ring-Pedersen modulus NTilde
+func (params *Parameters) MinNTildeBitLen() int {
+	if params.minNTildeBitLen == 0 {
+		return defaultMinModulusBitLen
+	}
+	return params.minNTildeBitLen
+}
+
+// SetMinModulusBitLens sets the minimum bit lengths of the Paillier and ring-Pedersen moduli of the peers, which
+// are 2048 by default. A peer whose modulus is shorter is reported as the culprit; lower values should only be
+// used in tests.
+func (params *Parameters) SetMinModulusBitLens(paillierBitLen, nTildeBitLen int) {
+	params.minPaillierBitLen = paillierBitLen
+	params.minNTildeBitLen = nTildeBitLen
+}
+
+// VerifierPool returns the pool that verifies the proofs of the peers. It is shared by all the parties of the process
+// unless one is set with SetVerifierPool.
+func (params *Parameters) VerifierPool() *VerifierPool {
+	if params.verifierPool == nil {
+		return defaultVerifierPool
+	}
+	return params.verifierPool
+}
+
+// SetVerifierPool sets the pool that verifies the proofs of the peers, e.g. to give a party more workers or to
+// share a smaller pool between the parties of a busy process
+func (params *Parameters) SetVerifierPool(pool *VerifierPool) {
+	params.verifierPool = pool
+}
+
+// withParties returns a copy of the parameters and their settings for the parties of `ctx`
+func (params *Parameters) withParties(ctx *PeerContext, partyID *PartyID) *Parameters {
+	copied := *params
+	copied.parties, copied.partyID, copied.partyCount = ctx, partyID, len(ctx.IDs())
+	return &copied
+}
+
 // ----- //
 
 // Exported, used in `tss` client
+// A party may be a member of both committees at once, e.g. to change the threshold while keeping the same set of parties.
+// In that case `ctx` and `newCtx` may contain the same keys (or even be the same PeerContext) and a single LocalParty plays both roles.
 func NewReSharingParameters(ctx, newCtx *PeerContext, partyID *PartyID, partyCount, threshold, newPartyCount, newThreshold int) *ReSharingParameters {
 	params := NewParameters(ctx, partyID, partyCount, threshold)
 	return &ReSharingParameters{
@@ -105,30 +230,38 @@ func (rgParams *ReSharingParameters) NewThreshold() int {
 	return rgParams.newThreshold
 }
 
+// OldAndNewParties returns the members of both committees. A party that is a member of both committees is listed once.
 func (rgParams *ReSharingParameters) OldAndNewParties() []*PartyID {
-	return append(rgParams.OldParties().IDs(), rgParams.NewParties().IDs()...)
+	ids := append([]*PartyID{}, rgParams.OldParties().IDs()...)
+	for _, Pj := range rgParams.NewParties().IDs() {
+		if rgParams.OldParties().IDs().FindByKey(Pj.KeyInt()) != nil {
+			continue
+		}
+		ids = append(ids, Pj)
+	}
+	return ids
 }
 
 func (rgParams *ReSharingParameters) OldAndNewPartyCount() int {
-	return rgParams.OldPartyCount() + rgParams.NewPartyCount()
+	return len(rgParams.OldAndNewParties())
 }
 
 func (rgParams *ReSharingParameters) IsOldCommittee() bool {
-	partyID := rgParams.partyID
-	for _, Pj := range rgParams.parties.IDs() {
-		if partyID.KeyInt().Cmp(Pj.KeyInt()) == 0 {
-			return true
-		}
-	}
-	return false
+	return rgParams.OldPartyIndex() >= 0
 }
 
 func (rgParams *ReSharingParameters) IsNewCommittee() bool {
-	partyID := rgParams.partyID
-	for _, Pj := range rgParams.newParties.IDs() {
-		if partyID.KeyInt().Cmp(Pj.KeyInt()) == 0 {
-			return true
-		}
-	}
-	return false
+	return rgParams.NewPartyIndex() >= 0
+}
+
+// OldPartyIndex returns the position of this party in the old committee, or -1 if it is not a member.
+// This may differ from `PartyID().Index` when the party is a member of both committees.
+func (rgParams *ReSharingParameters) OldPartyIndex() int {
+	return rgParams.OldParties().IDs().IndexOfKey(rgParams.partyID.KeyInt())
+}
+
+// NewPartyIndex returns the position of this party in the new committee, or -1 if it is not a member.
+// This may differ from `PartyID().Index` when the party is a member of both committees.
+func (rgParams *ReSharingParameters) NewPartyIndex() int {
+	return rgParams.NewParties().IDs().IndexOfKey(rgParams.partyID.KeyInt())
 }
@@ -38,6 +38,23 @@ type Party interface {
 	unlock()
 }
 
+// Zeroizer is implemented by the parties of this library, and optionally by other implementations of Party.
+//
+// Zeroize overwrites the secrets in the party's temporary state, and in the data it was building if it has not
+// finished. The save data handed to the `end` channel and the inputs given to the constructor are left to the
+// caller. It is called automatically when the party finishes or fails; call it yourself when abandoning a party,
+// e.g. after a timeout. It must not be called concurrently with `Start` or `Update`.
+type Zeroizer interface {
+	Zeroize()
+}
+
+// zeroize wipes the secrets of `p` if it implements Zeroizer
+func zeroize(p Party) {
+	if z, ok := p.(Zeroizer); ok {
+		z.Zeroize()
+	}
+}
+
 type BaseParty struct {
 	mtx        sync.Mutex
 	rnd        Round
@@ -129,6 +146,7 @@ func BaseStart(p Party, task string, prepare ...func(Round) *Error) *Error {
 	}
 	if len(prepare) == 1 {
 		if err := prepare[0](round); err != nil {
+			zeroize(p)
 			return err
 		}
 	}
@@ -136,7 +154,11 @@ func BaseStart(p Party, task string, prepare ...func(Round) *Error) *Error {
 	defer func() {
 		common.Logger.Debugf("party %s: %s round %d finished", p.round().Params().PartyID(), task, 1)
 	}()
-	return p.round().Start()
+	if err := p.round().Start(); err != nil {
+		zeroize(p)
+		return err
+	}
+	return nil
 }
 
 // an implementation of Update that is shared across the different types of parties (keygen, signing, dynamic groups)
@@ -161,11 +183,13 @@ func BaseUpdate(p Party, msg ParsedMessage, task string) (ok bool, err *Error) {
 	if p.round() != nil {
 		common.Logger.Debugf("party %s: %s round %d update", p.round().Params().PartyID(), task, p.round().RoundNumber())
 		if _, err := p.round().Update(); err != nil {
+			zeroize(p)
 			return r(false, err)
 		}
 		if p.round().CanProceed() {
 			if p.advance(); p.round() != nil {
 				if err := p.round().Start(); err != nil {
+					zeroize(p)
 					return r(false, err)
 				}
 				rndNum := p.round().RoundNumber()
@@ -173,6 +197,7 @@ func BaseUpdate(p Party, msg ParsedMessage, task string) (ok bool, err *Error) {
 			} else {
 				// finished! the round implementation will have sent the data through the `end` channel.
 				common.Logger.Infof("party %s: %s finished!", p.PartyID(), task)
+				zeroize(p)
 			}
 			p.unlock()                      // recursive so can't defer after return
 			return BaseUpdate(p, msg, task) // re-run round update or finish)
@@ -122,6 +122,16 @@ func (spids SortedPartyIDs) FindByKey(key *big.Int) *PartyID {
 	return nil
 }
 
+// IndexOfKey returns the position of the party with the given key in the list, or -1 if it is not found
+func (spids SortedPartyIDs) IndexOfKey(key *big.Int) int {
+	for i, pid := range spids {
+		if pid.KeyInt().Cmp(key) == 0 {
+			return i
+		}
+	}
+	return -1
+}
+
 func (spids SortedPartyIDs) Exclude(exclude *PartyID) SortedPartyIDs {
 	newSpIDs := make(SortedPartyIDs, 0, len(spids))
 	for _, pid := range spids {
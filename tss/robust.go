@@ -0,0 +1,591 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/binance-chain/tss-lib/common"
+)
+
+const robustTask = "robust signing"
+
+type (
+	// RobustMessage is a message of a RobustSession. It carries one of: a message of the signing protocol of an
+	// attempt, the abort of an attempt, or the signature that a member of a quorum sends to every other party.
+	// From and To are the IDs of the parties in the Parameters of the session.
+	RobustMessage struct {
+		Attempt int
+		From    *PartyID
+		To      []*PartyID
+
+		// a message of the signing protocol; a receiver that gets it from the wire sets WireBytes and IsBroadcast instead
+		Message     ParsedMessage
+		WireBytes   []byte
+		IsBroadcast bool
+
+		// the sender gave up on the attempt, blaming the culprits named by its protocol, if any, and the parties that
+		// it was waiting for when the attempt stalled. Unattributed is set when its protocol failed without a culprit.
+		IsAbort      bool
+		Accused      []*PartyID
+		Stalled      []*PartyID
+		Unattributed bool
+
+		Signature *common.SignatureData
+	}
+
+	// NewSigningParty creates the signing party of one attempt of a RobustSession
+	NewSigningParty func(params *Parameters, out chan<- Message, end chan<- common.SignatureData) Party
+
+	// RobustSession signs with threshold+1 of the parties in its Parameters and starts over with another quorum when
+	// an attempt fails. The quorum of an attempt is picked from the parties that have not been excluded, in the order
+	// of their keys with the parties in the fewest disputes first, leaving out any party in a dispute with one that
+	// was picked before it. It only depends on the aborts of the earlier attempts, so every party computes the same
+	// quorum without a coordinator.
+	//
+	// An attempt fails when a party of the quorum gets an error from the protocol, or when it has made no progress
+	// for `timeout`. That party then sends an abort to every party of the session and the others follow, and after
+	// the aborts of the quorum have been collected (or after another `timeout`) every party judges them:
+	//   - the members of the quorum that did not send an abort, e.g. because they are offline, are excluded,
+	//   - a party that is accused by more than half of the other members of the quorum, and by at least two of
+	//     them, is excluded. A party is accused by the members whose protocol named it as a culprit.
+	//   - a party that is accused by fewer members is not excluded on their word, but it is put in a dispute with
+	//     each of its accusers, so that it is never in a quorum with them again,
+	//   - a member whose protocol failed without naming a culprit cannot tell who is at fault, so it is put in a
+	//     dispute with every other member of the quorum and signs again with other parties, if there are enough,
+	//   - only when none of these applies, each member is put in a dispute with the parties that it was waiting for.
+	// A party never blames itself. The session fails once no threshold+1 parties are left that are not excluded nor
+	// in a dispute with each other, or when an attempt fails without any exclusion or new dispute.
+	// The members of the quorum that get the signature send it to every other party, and a party that receives a
+	// valid signature stops whatever attempt it is in, so that a member that finished is not taken for silent by one
+	// that is still waiting. The signature is delivered to the `end` channel of every party.
+	//
+	// The parties only agree on the exclusions and the disputes when the messages of a session are delivered to
+	// every recipient within `timeout`, as with the reliable broadcast that the protocols already rely on. A party
+	// that answers aborts but withholds its protocol messages only ends up in disputes with the parties that waited
+	// for it, which may leave too few parties to sign, and a majority of the quorum that colludes can exclude the
+	// other members.
+	RobustSession struct {
+		params   *Parameters
+		timeout  time.Duration
+		newParty NewSigningParty
+		verify   func(common.SignatureData) bool
+		out      chan<- *RobustMessage
+		end      chan<- common.SignatureData
+		errCh    chan<- *Error
+
+		mtx      sync.Mutex
+		attempt  int
+		excluded map[string]*PartyID
+		disputes map[string]map[string]bool // the pairs of parties that are kept out of the same quorum, by key
+		quorum   map[string]*PartyID        // the IDs of the session of the quorum of the attempt, by key
+		copies   map[string]*PartyID        // the IDs of the quorum in the parameters of the attempt, by key
+		party    Party                      // nil when this party is outside of the quorum
+		updates  *sync.WaitGroup            // the calls to party.Update in progress, made without the lock
+		stop     chan struct{}              // closed when the party of the attempt is abandoned
+		aborted  bool
+		aborts   map[string]*RobustMessage // the aborts of the quorum for the attempt, by the key of the sender
+		timer    *time.Timer
+		pending  []*RobustMessage // for later attempts
+		finished bool
+	}
+)
+
+// NewRobustSession creates a session for the parties of `params`, which must be more than its threshold.
+// `newParty` creates the signing party of each attempt and `verify` checks a signature that is received from
+// another party. A final failure is sent to `errCh`.
+func NewRobustSession(
+	params *Parameters,
+	timeout time.Duration,
+	newParty NewSigningParty,
+	verify func(common.SignatureData) bool,
+	out chan<- *RobustMessage,
+	end chan<- common.SignatureData,
+	errCh chan<- *Error,
+) *RobustSession {
+	return &RobustSession{
+		params:   params,
+		timeout:  timeout,
+		newParty: newParty,
+		verify:   verify,
+		out:      out,
+		end:      end,
+		errCh:    errCh,
+		excluded: make(map[string]*PartyID),
+		disputes: make(map[string]map[string]bool),
+	}
+}
+
+// Start starts the first attempt
+func (s *RobustSession) Start() *Error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.timeout <= 0 {
+		return NewError(errors.New("the timeout must be positive"), robustTask, -1, s.params.PartyID())
+	}
+	if s.params.PartyCount() <= s.params.Threshold() {
+		return NewError(fmt.Errorf("%d parties cannot sign with threshold %d", s.params.PartyCount(), s.params.Threshold()),
+			robustTask, -1, s.params.PartyID())
+	}
+	s.startAttempt(0)
+	return nil
+}
+
+// Update delivers a message of another party of the session
+func (s *RobustSession) Update(msg *RobustMessage) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if msg == nil || msg.From == nil || s.params.Parties().IDs().FindByKey(msg.From.KeyInt()) == nil {
+		return
+	}
+	s.handle(msg)
+}
+
+// Attempt returns the number of the current attempt, starting at 0
+func (s *RobustSession) Attempt() int {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.attempt
+}
+
+// Excluded returns the parties that have been excluded from the quorum
+func (s *RobustSession) Excluded() []*PartyID {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	excluded := make([]*PartyID, 0, len(s.excluded))
+	for _, Pj := range s.params.Parties().IDs() {
+		if _, found := s.excluded[partyKey(Pj)]; found {
+			excluded = append(excluded, Pj)
+		}
+	}
+	return excluded
+}
+
+// ----- //
+// the methods below are called with the lock held; handle releases it while the party of the attempt verifies a message
+
+func (s *RobustSession) startAttempt(attempt int) {
+	s.attempt, s.aborted, s.aborts = attempt, false, make(map[string]*RobustMessage)
+	remaining := make([]*PartyID, 0, s.params.PartyCount())
+	for _, Pj := range s.params.Parties().IDs() {
+		if _, found := s.excluded[partyKey(Pj)]; !found {
+			remaining = append(remaining, Pj)
+		}
+	}
+	threshold := s.params.Threshold()
+	if len(remaining) <= threshold {
+		s.fail(fmt.Errorf("%d parties remain after %d attempts, and threshold %d needs %d",
+			len(remaining), attempt, threshold, threshold+1))
+		return
+	}
+	members := s.pickQuorum(remaining)
+	if len(members) <= threshold {
+		s.fail(fmt.Errorf("no %d of the %d remaining parties are free of disputes with each other after %d attempts",
+			threshold+1, len(remaining), attempt))
+		return
+	}
+	s.quorum, s.copies = make(map[string]*PartyID, threshold+1), make(map[string]*PartyID, threshold+1)
+	copies := make(UnSortedPartyIDs, 0, threshold+1)
+	for _, Pj := range members {
+		// the IDs of an attempt are sorted, which sets their Index, so the IDs of the session are left alone
+		copied := NewPartyID(Pj.Id, Pj.Moniker, Pj.KeyInt())
+		s.quorum[partyKey(Pj)], s.copies[partyKey(Pj)] = Pj, copied
+		copies = append(copies, copied)
+	}
+	sorted := SortPartyIDs(copies)
+
+	s.party, s.updates, s.stop = nil, new(sync.WaitGroup), make(chan struct{})
+	if self, found := s.copies[partyKey(s.params.PartyID())]; found {
+		// every message of the protocol fits, so that the party never waits for the forwarding goroutine
+		out := make(chan Message, 4*len(sorted)+16)
+		end := make(chan common.SignatureData, 1)
+		s.party = s.newParty(s.params.withParties(NewPeerContext(sorted), self), out, end)
+		go s.forward(attempt, s.quorum, s.stop, out, end)
+		if err := s.party.Start(); err != nil {
+			s.abortOnError(attempt, err)
+		} else {
+			s.resetTimer(attempt)
+		}
+	}
+	pending := s.pending
+	s.pending = nil
+	for _, msg := range pending {
+		s.handle(msg)
+	}
+}
+
+func (s *RobustSession) handle(msg *RobustMessage) {
+	if s.finished {
+		return
+	}
+	if msg.Signature != nil {
+		// a valid signature ends the session whichever attempt it comes from
+		if s.verify(*msg.Signature) {
+			s.succeed(*msg.Signature)
+		}
+		return
+	}
+	switch {
+	case msg.Attempt < s.attempt:
+		return
+	case s.attempt < msg.Attempt:
+		s.pending = append(s.pending, msg)
+		return
+	}
+	key := partyKey(msg.From)
+	switch {
+	case msg.IsAbort:
+		if _, found := s.quorum[key]; !found {
+			return
+		}
+		if _, found := s.aborts[key]; found {
+			return
+		}
+		s.aborts[key] = msg
+		// follow the abort without blaming anyone; a party that is still waiting has not stalled for long
+		s.abort(s.attempt, nil, nil, false)
+		if len(s.aborts) == len(s.quorum) {
+			s.closeAttempt(s.attempt)
+		}
+	default:
+		if s.party == nil || s.aborted {
+			return
+		}
+		from, found := s.copies[key]
+		if !found {
+			return
+		}
+		parsed := msg.Message
+		if parsed == nil {
+			var err error
+			if parsed, err = ParseWireMessage(msg.WireBytes, from, msg.IsBroadcast); err != nil {
+				s.abort(s.attempt, []*PartyID{s.quorum[key]}, nil, false)
+				return
+			}
+		}
+		// the verification of a message may take a while, and must not hold up the aborts, the timers and the
+		// messages for other rounds
+		party, updates, attempt := s.party, s.updates, s.attempt
+		updates.Add(1)
+		s.mtx.Unlock()
+		_, err := party.Update(parsed)
+		updates.Done()
+		s.mtx.Lock()
+		if s.finished || attempt != s.attempt || s.aborted {
+			return
+		}
+		if err != nil {
+			s.abortOnError(attempt, err)
+			return
+		}
+		s.resetTimer(attempt)
+	}
+}
+
+// abortOnError gives up on the attempt after an error of the protocol
+func (s *RobustSession) abortOnError(attempt int, err *Error) {
+	accused := s.culprits(err)
+	s.abort(attempt, accused, nil, len(accused) == 0)
+}
+
+// abort gives up on the attempt once; the party tells the others if it is in the quorum, and waits for their aborts
+func (s *RobustSession) abort(attempt int, accused, stalled []*PartyID, unattributed bool) {
+	if s.finished || attempt != s.attempt || s.aborted {
+		return
+	}
+	s.aborted = true
+	s.stopTimer()
+	s.abandonParty()
+	if s.party != nil {
+		self := s.params.PartyID()
+		msg := &RobustMessage{
+			Attempt:      attempt,
+			From:         self,
+			To:           s.others(),
+			IsAbort:      true,
+			Accused:      accused,
+			Stalled:      stalled,
+			Unattributed: unattributed,
+		}
+		s.aborts[partyKey(self)] = msg
+		s.send(msg)
+	}
+	s.timer = time.AfterFunc(s.timeout, func() {
+		s.mtx.Lock()
+		defer s.mtx.Unlock()
+		s.closeAttempt(attempt)
+	})
+	if len(s.aborts) == len(s.quorum) {
+		s.closeAttempt(attempt)
+	}
+}
+
+// closeAttempt judges the aborts of the quorum and starts the next attempt
+func (s *RobustSession) closeAttempt(attempt int) {
+	if s.finished || attempt != s.attempt {
+		return
+	}
+	s.stopTimer()
+	progress := false
+	for key, Pj := range s.quorum {
+		msg, found := s.aborts[key]
+		if !found {
+			s.excluded[key] = Pj
+			progress = true
+			continue
+		}
+		if msg.Unattributed {
+			for other := range s.quorum {
+				progress = s.dispute(key, other) || progress
+			}
+		}
+	}
+	for accused, by := range s.accusers(func(msg *RobustMessage) []*PartyID { return msg.Accused }) {
+		// one accuser is not more credible than the party that it accuses
+		if 1 < len(by) && len(s.quorum)-1 < 2*len(by) {
+			s.excluded[accused] = s.quorum[accused]
+			progress = true
+			continue
+		}
+		for accuser := range by {
+			progress = s.dispute(accuser, accused) || progress
+		}
+	}
+	if !progress {
+		// a party that waits may wait for more parties than the ones at fault, as a round only checks the messages
+		// that it has received up to the first missing one
+		for accused, by := range s.accusers(func(msg *RobustMessage) []*PartyID { return msg.Stalled }) {
+			for accuser := range by {
+				progress = s.dispute(accuser, accused) || progress
+			}
+		}
+	}
+	if !progress {
+		s.fail(fmt.Errorf("attempt %d failed without any party to blame", attempt))
+		return
+	}
+	common.Logger.Warningf("robust signing: attempt %d failed, %d parties excluded so far", attempt, len(s.excluded))
+	s.startAttempt(attempt + 1)
+}
+
+// accusers returns the keys of the members of the quorum that blame each member in their aborts, by the key of the
+// accused member
+func (s *RobustSession) accusers(blame func(*RobustMessage) []*PartyID) map[string]map[string]bool {
+	accusers := make(map[string]map[string]bool)
+	for key, msg := range s.aborts {
+		for _, Pj := range blame(msg) {
+			if Pj == nil {
+				continue
+			}
+			accused := partyKey(Pj)
+			if _, found := s.quorum[accused]; !found || accused == key {
+				continue
+			}
+			if accusers[accused] == nil {
+				accusers[accused] = make(map[string]bool)
+			}
+			accusers[accused][key] = true
+		}
+	}
+	return accusers
+}
+
+// dispute keeps two parties out of the same quorum and returns whether they were not in a dispute already
+func (s *RobustSession) dispute(a, b string) bool {
+	if a == b || s.disputes[a][b] {
+		return false
+	}
+	for _, pair := range [][2]string{{a, b}, {b, a}} {
+		if s.disputes[pair[0]] == nil {
+			s.disputes[pair[0]] = make(map[string]bool)
+		}
+		s.disputes[pair[0]][pair[1]] = true
+	}
+	return true
+}
+
+// pickQuorum returns up to threshold+1 of the remaining parties, of which no two are in a dispute
+func (s *RobustSession) pickQuorum(remaining []*PartyID) []*PartyID {
+	count := make(map[string]int, len(remaining))
+	for _, Pj := range remaining {
+		for other := range s.disputes[partyKey(Pj)] {
+			if _, found := s.excluded[other]; !found {
+				count[partyKey(Pj)]++
+			}
+		}
+	}
+	candidates := append([]*PartyID(nil), remaining...)
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return count[partyKey(candidates[i])] < count[partyKey(candidates[j])]
+	})
+	members := make([]*PartyID, 0, s.params.Threshold()+1)
+next:
+	for _, Pj := range candidates {
+		if len(members) == s.params.Threshold()+1 {
+			break
+		}
+		for _, member := range members {
+			if s.disputes[partyKey(Pj)][partyKey(member)] {
+				continue next
+			}
+		}
+		members = append(members, Pj)
+	}
+	return members
+}
+
+func (s *RobustSession) succeed(signature common.SignatureData) {
+	if s.finished {
+		return
+	}
+	s.finished = true
+	s.stopTimer()
+	if !s.aborted {
+		s.abandonParty()
+	}
+	if s.party != nil {
+		// the other members may still be waiting for a message, and the parties outside of the quorum never get one
+		s.send(&RobustMessage{Attempt: s.attempt, From: s.params.PartyID(), To: s.others(), Signature: &signature})
+	}
+	go func() { s.end <- signature }()
+}
+
+// abandonParty stops forwarding the messages of the party of the attempt, and wipes its secrets once the calls to
+// its Update in progress have returned
+func (s *RobustSession) abandonParty() {
+	close(s.stop)
+	if party, updates := s.party, s.updates; party != nil {
+		go func() {
+			updates.Wait()
+			zeroize(party)
+		}()
+	}
+}
+
+func (s *RobustSession) fail(err error) {
+	s.finished = true
+	s.stopTimer()
+	excluded := make([]*PartyID, 0, len(s.excluded))
+	for _, Pj := range s.excluded {
+		excluded = append(excluded, Pj)
+	}
+	tssErr := NewError(err, robustTask, -1, s.params.PartyID(), excluded...)
+	go func() { s.errCh <- tssErr }()
+}
+
+// forward relays the messages and the signature of the party of an attempt
+func (s *RobustSession) forward(
+	attempt int,
+	quorum map[string]*PartyID,
+	stop <-chan struct{},
+	out <-chan Message,
+	end <-chan common.SignatureData,
+) {
+	self := s.params.PartyID()
+	for {
+		select {
+		case msg := <-out:
+			var to []*PartyID
+			if msg.GetTo() == nil {
+				for key, Pj := range quorum {
+					if key != partyKey(self) {
+						to = append(to, Pj)
+					}
+				}
+			} else {
+				for _, Pj := range msg.GetTo() {
+					to = append(to, quorum[partyKey(Pj)])
+				}
+			}
+			select {
+			case s.out <- &RobustMessage{Attempt: attempt, From: self, To: to, Message: msg.(ParsedMessage)}:
+			case <-stop:
+				return
+			}
+		case signature := <-end:
+			s.mtx.Lock()
+			if attempt == s.attempt {
+				s.succeed(signature)
+			}
+			s.mtx.Unlock()
+			return
+		case <-stop:
+			return
+		}
+	}
+}
+
+func (s *RobustSession) resetTimer(attempt int) {
+	s.stopTimer()
+	s.timer = time.AfterFunc(s.timeout, func() {
+		s.mtx.Lock()
+		party := s.party
+		if party == nil || attempt != s.attempt || s.aborted {
+			s.mtx.Unlock()
+			return
+		}
+		s.mtx.Unlock()
+		// WaitingFor waits for a call to Update in progress
+		waiting := party.WaitingFor()
+		s.mtx.Lock()
+		defer s.mtx.Unlock()
+		s.abort(attempt, nil, s.toSession(waiting), false)
+	})
+}
+
+func (s *RobustSession) stopTimer() {
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+}
+
+// culprits returns the IDs of the session of the culprits of an error of the protocol, other than this party
+func (s *RobustSession) culprits(err *Error) []*PartyID {
+	culprits := make([]*PartyID, 0, len(err.Culprits()))
+	for _, Pj := range s.toSession(err.Culprits()) {
+		if partyKey(Pj) != partyKey(s.params.PartyID()) {
+			culprits = append(culprits, Pj)
+		}
+	}
+	return culprits
+}
+
+func (s *RobustSession) toSession(ids []*PartyID) []*PartyID {
+	session := make([]*PartyID, 0, len(ids))
+	for _, Pj := range ids {
+		if Pj == nil {
+			continue
+		}
+		if sessionID, found := s.quorum[partyKey(Pj)]; found {
+			session = append(session, sessionID)
+		}
+	}
+	return session
+}
+
+func (s *RobustSession) others() []*PartyID {
+	others := make([]*PartyID, 0, s.params.PartyCount()-1)
+	for _, Pj := range s.params.Parties().IDs() {
+		if partyKey(Pj) != partyKey(s.params.PartyID()) {
+			others = append(others, Pj)
+		}
+	}
+	return others
+}
+
+func (s *RobustSession) send(msg *RobustMessage) {
+	go func() { s.out <- msg }()
+}
+
+func partyKey(Pj *PartyID) string {
+	return string(Pj.GetKey())
+}
@@ -0,0 +1,46 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss
+
+import (
+	"runtime"
+)
+
+// VerifierPool bounds the number of goroutines that verify proofs. The rounds hand it one verification per peer
+// and proof, so without a bound a party with many peers starts hundreds of CPU-heavy goroutines at once, and every
+// local party of a process does so at the same time.
+//
+// Go blocks until a worker is free, so a task must neither call Go itself nor wait for code that runs after the
+// call, e.g. by sending its result on an unbuffered channel that is only read once all the tasks are queued.
+type VerifierPool struct {
+	workers chan struct{}
+}
+
+// defaultVerifierPool is shared by all the parties that are not given a pool of their own
+var defaultVerifierPool = NewVerifierPool(runtime.NumCPU())
+
+// NewVerifierPool creates a pool of `workers` workers, at least one
+func NewVerifierPool(workers int) *VerifierPool {
+	if workers < 1 {
+		workers = 1
+	}
+	return &VerifierPool{workers: make(chan struct{}, workers)}
+}
+
+// Go runs `task` on a worker once one is free
+func (pool *VerifierPool) Go(task func()) {
+	pool.workers <- struct{}{}
+	go func() {
+		defer func() { <-pool.workers }()
+		task()
+	}()
+}
+
+// Workers returns the number of workers
+func (pool *VerifierPool) Workers() int {
+	return cap(pool.workers)
+}